@@ -0,0 +1,389 @@
+// Command employeectl is a command line client for the employee API.
+//
+//	employeectl [-addr host:port] [-token token] import [-dry-run] file.csv
+//	employeectl [-addr host:port] [-token token] export [-format csv|jsonl|parquet] [-net-salary] [-o file] [filters]
+//	employeectl [-addr host:port] [-token token] rates file.csv
+//	employeectl [-addr host:port] [-token token] payslip [-format pdf|text] [-o file] employee-id YYYY-MM
+//	employeectl [-addr host:port] [-token token] org-chart [-format dot|json] [-root id] [-depth n] [-o file]
+//
+// The token defaults to the EMPLOYEE_API_TOKEN environment variable.
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	employeev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/employee/v1"
+	exchangeratev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/exchangerate/v1"
+	payslipv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/payslip/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// chunkSize is the size of the file chunks streamed to the server.
+const chunkSize = 32 << 10
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "gRPC server address")
+	token := flag.String("token", os.Getenv("EMPLOYEE_API_TOKEN"), "access token")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fatal(err)
+	}
+	defer conn.Close()
+	client := employeev1.NewEmployeeServiceClient(conn)
+
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "import":
+		ok, err := runImport(ctx, client, args)
+		if err != nil {
+			fatal(err)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+	case "export":
+		if err := runExport(ctx, client, args); err != nil {
+			fatal(err)
+		}
+	case "rates":
+		if err := runRates(ctx, exchangeratev1.NewExchangeRateServiceClient(conn), args); err != nil {
+			fatal(err)
+		}
+	case "payslip":
+		if err := runPayslip(ctx, payslipv1.NewPayslipServiceClient(conn), args); err != nil {
+			fatal(err)
+		}
+	case "org-chart":
+		if err := runOrgChart(ctx, client, args); err != nil {
+			fatal(err)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", cmd)
+		usage()
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: employeectl [-addr host:port] [-token token] import [-dry-run] file.csv")
+	fmt.Fprintln(os.Stderr, "       employeectl [-addr host:port] [-token token] export [-format csv|jsonl|parquet] [-net-salary] [-o file] [filters]")
+	fmt.Fprintln(os.Stderr, "       employeectl [-addr host:port] [-token token] rates file.csv")
+	fmt.Fprintln(os.Stderr, "       employeectl [-addr host:port] [-token token] payslip [-format pdf|text] [-o file] employee-id YYYY-MM")
+	fmt.Fprintln(os.Stderr, "       employeectl [-addr host:port] [-token token] org-chart [-format dot|json] [-root id] [-depth n] [-o file]")
+	flag.PrintDefaults()
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "employeectl:", err)
+	os.Exit(1)
+}
+
+// runImport streams a CSV file to ImportEmployees and prints the result. It
+// reports false when any row was rejected.
+func runImport(ctx context.Context, client employeev1.EmployeeServiceClient, args []string) (bool, error) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "validate the file without creating employees")
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		return false, errors.New("import needs exactly one file")
+	}
+
+	file, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	stream, err := client.ImportEmployees(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	err = stream.Send(&employeev1.ImportEmployeesRequest{
+		Payload: &employeev1.ImportEmployeesRequest_Options{Options: &employeev1.ImportOptions{DryRun: *dryRun}},
+	})
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+
+	buf := make([]byte, chunkSize)
+	for {
+		n, readErr := file.Read(buf)
+		if n > 0 {
+			err := stream.Send(&employeev1.ImportEmployeesRequest{
+				Payload: &employeev1.ImportEmployeesRequest_Chunk{Chunk: buf[:n]},
+			})
+			// io.EOF means the server ended the call early; the reason is
+			// returned by CloseAndRecv.
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return false, err
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return false, readErr
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return false, err
+	}
+
+	for _, rowErr := range resp.GetErrors() {
+		fmt.Printf("line %d: %s\n", rowErr.GetLine(), rowErr.GetMessage())
+	}
+	if shown := int32(len(resp.GetErrors())); resp.GetErrorCount() > shown {
+		fmt.Printf("... and %d more errors\n", resp.GetErrorCount()-shown)
+	}
+
+	switch {
+	case resp.GetErrorCount() > 0:
+		fmt.Printf("%d of %d rows rejected; nothing was imported\n", resp.GetErrorCount(), resp.GetTotalRows())
+	case resp.GetDryRun():
+		fmt.Printf("dry run: all %d rows are valid\n", resp.GetTotalRows())
+	default:
+		fmt.Printf("imported %d employees\n", resp.GetImportedRows())
+	}
+	return resp.GetErrorCount() == 0, nil
+}
+
+var exportFormats = map[string]employeev1.ExportFormat{
+	"csv":     employeev1.ExportFormat_EXPORT_FORMAT_CSV,
+	"jsonl":   employeev1.ExportFormat_EXPORT_FORMAT_JSONL,
+	"parquet": employeev1.ExportFormat_EXPORT_FORMAT_PARQUET,
+}
+
+// runExport writes the output of ExportEmployees to a file, or to stdout.
+func runExport(ctx context.Context, client employeev1.EmployeeServiceClient, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "csv", "file format: csv, jsonl or parquet")
+	netSalary := fs.Bool("net-salary", false, "include tax_amount, tax_rate and net_salary columns")
+	output := fs.String("o", "", "output file (default stdout)")
+	req := &employeev1.ExportEmployeesRequest{}
+	fs.StringVar(&req.Country, "country", "", "only employees in this country")
+	fs.StringVar(&req.JobTitle, "job-title", "", "only employees with this job title")
+	fs.StringVar(&req.MinSalary, "min-salary", "", "minimum gross salary")
+	fs.StringVar(&req.MaxSalary, "max-salary", "", "maximum gross salary")
+	_ = fs.Parse(args)
+	if fs.NArg() != 0 {
+		return errors.New("export takes no arguments")
+	}
+
+	var ok bool
+	if req.Format, ok = exportFormats[*format]; !ok {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	req.IncludeNetSalary = *netSalary
+
+	stream, err := client.ExportEmployees(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(resp.GetChunk()); err != nil {
+			return err
+		}
+	}
+
+	if file, ok := out.(*os.File); ok && file != os.Stdout {
+		return file.Close()
+	}
+	return nil
+}
+
+// ratesHeader is the header of an exchange rate file. Each row says one unit
+// of base_currency buys rate units of quote_currency from valid_from, a
+// YYYY-MM-DD date.
+var ratesHeader = []string{"base_currency", "quote_currency", "rate", "valid_from"}
+
+// runRates loads the exchange rates in a CSV file with SetExchangeRates.
+func runRates(ctx context.Context, client exchangeratev1.ExchangeRateServiceClient, args []string) error {
+	if len(args) != 1 {
+		return errors.New("rates needs exactly one file")
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = len(ratesHeader)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return err
+	}
+	for i, column := range ratesHeader {
+		if header[i] != column {
+			return fmt.Errorf("column %d is %q, want %q", i+1, header[i], column)
+		}
+	}
+
+	req := &exchangeratev1.SetExchangeRatesRequest{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := reader.FieldPos(0)
+		validFrom, err := time.Parse(time.DateOnly, record[3])
+		if err != nil {
+			return fmt.Errorf("line %d: valid_from must be a YYYY-MM-DD date", line)
+		}
+		req.Rates = append(req.Rates, &exchangeratev1.ExchangeRate{
+			BaseCurrency:  record[0],
+			QuoteCurrency: record[1],
+			Rate:          record[2],
+			ValidFrom:     timestamppb.New(validFrom),
+		})
+	}
+
+	resp, err := client.SetExchangeRates(ctx, req)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("stored %d exchange rates\n", resp.GetStored())
+	return nil
+}
+
+var payslipFormats = map[string]payslipv1.PayslipFormat{
+	"pdf":  payslipv1.PayslipFormat_PAYSLIP_FORMAT_PDF,
+	"text": payslipv1.PayslipFormat_PAYSLIP_FORMAT_TEXT,
+}
+
+// runPayslip saves an employee's payslip for a month, by default under the
+// file name the server suggests.
+func runPayslip(ctx context.Context, client payslipv1.PayslipServiceClient, args []string) error {
+	fs := flag.NewFlagSet("payslip", flag.ExitOnError)
+	format := fs.String("format", "pdf", "file format: pdf or text")
+	output := fs.String("o", "", "output file, or - for stdout (default the suggested file name)")
+	_ = fs.Parse(args)
+	if fs.NArg() != 2 {
+		return errors.New("payslip needs an employee id and a YYYY-MM month")
+	}
+
+	period, err := time.Parse("2006-01", fs.Arg(1))
+	if err != nil {
+		return errors.New("month must be YYYY-MM")
+	}
+	req := &payslipv1.GeneratePayslipRequest{
+		EmployeeId: fs.Arg(0),
+		Year:       int32(period.Year()),
+		Month:      int32(period.Month()),
+	}
+	var ok bool
+	if req.Format, ok = payslipFormats[*format]; !ok {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	resp, err := client.GeneratePayslip(ctx, req)
+	if err != nil {
+		return err
+	}
+	return saveFile(*output, resp.GetFilename(), resp.GetContent())
+}
+
+var orgChartFormats = map[string]employeev1.OrgChartFormat{
+	"dot":  employeev1.OrgChartFormat_ORG_CHART_FORMAT_DOT,
+	"json": employeev1.OrgChartFormat_ORG_CHART_FORMAT_JSON,
+}
+
+// runOrgChart saves the org chart, by default under the file name the server
+// suggests. A DOT chart renders with Graphviz, for example
+// dot -Tsvg org-chart.dot.
+func runOrgChart(ctx context.Context, client employeev1.EmployeeServiceClient, args []string) error {
+	fs := flag.NewFlagSet("org-chart", flag.ExitOnError)
+	format := fs.String("format", "dot", "file format: dot or json")
+	root := fs.String("root", "", "chart only this employee and the employees below it")
+	depth := fs.Int("depth", 0, "levels below the roots to chart (default all)")
+	output := fs.String("o", "", "output file, or - for stdout (default the suggested file name)")
+	_ = fs.Parse(args)
+	if fs.NArg() != 0 {
+		return errors.New("org-chart takes no arguments")
+	}
+
+	req := &employeev1.ExportOrgChartRequest{
+		RootId:   *root,
+		MaxDepth: int32(*depth),
+	}
+	var ok bool
+	if req.Format, ok = orgChartFormats[*format]; !ok {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	resp, err := client.ExportOrgChart(ctx, req)
+	if err != nil {
+		return err
+	}
+	return saveFile(*output, resp.GetFilename(), resp.GetContent())
+}
+
+// saveFile writes content to output: stdout when it is -, the file name
+// suggested by the server when it is empty.
+func saveFile(output, suggested string, content []byte) error {
+	switch output {
+	case "-":
+		_, err := os.Stdout.Write(content)
+		return err
+	case "":
+		output = suggested
+	}
+	if err := os.WriteFile(output, content, 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("wrote %s\n", output)
+	return nil
+}
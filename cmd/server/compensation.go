@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/config"
+	employeeuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/employee"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+// runCompensation applies scheduled salary changes once they take effect,
+// once at startup and then every interval, until ctx is cancelled.
+func runCompensation(ctx context.Context, employees employeeuc.Service, cfg config.CompensationConfig, logger log.Logger) {
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		applied, err := employees.ApplyDueCompensation(ctx)
+		if err != nil && ctx.Err() == nil {
+			_ = level.Error(logger).Log("msg", "failed to apply scheduled compensation changes", "applied", applied, "err", err)
+		} else if applied > 0 {
+			_ = level.Info(logger).Log("msg", "applied scheduled compensation changes", "applied", applied)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
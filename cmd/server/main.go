@@ -1,20 +1,41 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/auth"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/config"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/health"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/metrics"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/persistence/postgres"
 	transportgrpc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/transport/grpc"
+	transporthttp "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/transport/http"
 	authuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/auth"
 	employeeuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/employee"
+	exchangerateuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/exchangerate"
+	organizationuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/organization"
+	payslipuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/payslip"
 	salaryuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/salary"
+	taxruleuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/taxrule"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	shutdownTimeout    = 15 * time.Second
+	healthCheckTimeout = 2 * time.Second
+	metricsTimeout     = 5 * time.Second
 )
 
 func main() {
@@ -26,6 +47,14 @@ func main() {
 		_ = level.Error(logger).Log("msg", "failed to load config", "err", err)
 		os.Exit(1)
 	}
+	if cfg.Retention.DeletedEmployees > 0 && cfg.Retention.Interval <= 0 {
+		_ = level.Error(logger).Log("msg", "RETENTION_INTERVAL must be positive")
+		os.Exit(1)
+	}
+	if cfg.Compensation.Interval <= 0 {
+		_ = level.Error(logger).Log("msg", "COMPENSATION_INTERVAL must be positive")
+		os.Exit(1)
+	}
 
 	_ = level.Info(logger).Log("msg", "connecting to database")
 	db, err := postgres.NewConnection(cfg.Database)
@@ -40,29 +69,139 @@ func main() {
 		}
 	}()
 
-	_ = level.Info(logger).Log("msg", "running database migrations")
-	if err := postgres.AutoMigrate(db); err != nil {
-		_ = level.Error(logger).Log("msg", "failed to run migrations", "err", err)
+	migrator, err := postgres.NewMigrator(db)
+	if err != nil {
+		_ = level.Error(logger).Log("msg", "failed to load migrations", "err", err)
+		os.Exit(1)
+	}
+
+	if len(os.Args) > 1 {
+		if os.Args[1] != "migrate" {
+			_ = level.Error(logger).Log("msg", "unknown command", "command", os.Args[1])
+			os.Exit(2)
+		}
+		if err := runMigrate(context.Background(), migrator, os.Args[2:], os.Stdout); err != nil {
+			_ = level.Error(logger).Log("msg", "migration failed", "err", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if cfg.Database.MigrateOnStart {
+		_ = level.Info(logger).Log("msg", "running database migrations")
+		if _, err := migrator.Up(context.Background()); err != nil {
+			_ = level.Error(logger).Log("msg", "failed to run migrations", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	// Refuse to serve against an outdated schema; queries would fail in
+	// confusing ways on missing columns or tables.
+	pending, err := migrator.Pending(context.Background())
+	if err != nil {
+		_ = level.Error(logger).Log("msg", "failed to check migrations", "err", err)
+		os.Exit(1)
+	}
+	if len(pending) > 0 {
+		_ = level.Error(logger).Log(
+			"msg", "database schema is behind; run `server migrate up`",
+			"pending", len(pending),
+			"next", fmt.Sprintf("%04d_%s", pending[0].Version, pending[0].Name),
+		)
 		os.Exit(1)
 	}
 
 	userRepo := postgres.NewUserRepository(db)
 	employeeRepo := postgres.NewEmployeeRepository(db)
-	jwtManager := auth.NewJWTManager(cfg.JWT)
-	authService := authuc.NewService(userRepo, jwtManager)
+	taxRuleRepo := postgres.NewTaxRuleRepository(db)
+	refreshTokenRepo := postgres.NewRefreshTokenRepository(db)
+	revokedTokenRepo := postgres.NewRevokedTokenRepository(db)
+	employeeAuditRepo := postgres.NewEmployeeAuditRepository(db)
+	employeeEventRepo := postgres.NewEmployeeEventRepository(db)
+	compensationRepo := postgres.NewCompensationRepository(db)
+	exchangeRateRepo := postgres.NewExchangeRateRepository(db)
+	departmentRepo := postgres.NewDepartmentRepository(db)
+	costCenterRepo := postgres.NewCostCenterRepository(db)
+	transactor := postgres.NewTransactor(db)
+	jwtManager := auth.NewJWTManager(cfg.JWT, revokedTokenRepo)
+	authService := authuc.NewService(userRepo, refreshTokenRepo, revokedTokenRepo, jwtManager, cfg.Auth.BootstrapAdminEmail)
+	if err := authService.BootstrapAdmin(context.Background()); err != nil {
+		_ = level.Error(logger).Log("msg", "failed to bootstrap admin", "err", err)
+		os.Exit(1)
+	}
+
+	salaryService := salaryuc.NewService(employeeRepo, compensationRepo, taxRuleRepo, exchangeRateRepo, departmentRepo, transactor)
+	employeeService := employeeuc.NewService(employeeRepo, employeeAuditRepo, compensationRepo, employeeEventRepo, salaryService, transactor)
+	taxRuleService := taxruleuc.NewService(taxRuleRepo)
+	exchangeRateService := exchangerateuc.NewService(exchangeRateRepo)
+	organizationService := organizationuc.NewService(departmentRepo, costCenterRepo, employeeAuditRepo, employeeEventRepo, transactor)
+
+	payslipRenderer, err := payslipuc.NewRenderer(cfg.Payslip.TemplateDir)
+	if err != nil {
+		_ = level.Error(logger).Log("msg", "failed to load payslip templates", "err", err)
+		os.Exit(1)
+	}
+	payslipService := payslipuc.NewService(employeeRepo, salaryService, payslipRenderer, payslipuc.Employer{
+		Name:    cfg.Payslip.EmployerName,
+		Address: cfg.Payslip.EmployerAddress,
+	})
+
+	checker := health.NewChecker(healthCheckTimeout)
+	checker.Register("database", postgres.Ping(db))
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		_ = level.Error(logger).Log("msg", "failed to access database pool", "err", err)
+		os.Exit(1)
+	}
 
-	employeeService := employeeuc.NewService(employeeRepo)
-	salaryService := salaryuc.NewService(employeeRepo)
+	registry := metrics.NewRegistry()
+	registry.MustRegister(
+		collectors.NewDBStatsCollector(sqlDB, cfg.Database.Name),
+		metrics.NewBusinessCollector(employeeRepo, userRepo, metricsTimeout),
+	)
+	rpcMetrics := metrics.NewRPCMetrics(registry)
 
 	grpcServer := transportgrpc.NewServer(transportgrpc.ServerConfig{
-		AuthService:     authService,
-		EmployeeService: employeeService,
-		SalaryService:   salaryService,
-		JWTManager:      jwtManager,
-		Logger:          log.With(logger, "transport", "grpc"),
+		AuthService:         authService,
+		EmployeeService:     employeeService,
+		SalaryService:       salaryService,
+		TaxRuleService:      taxRuleService,
+		ExchangeRateService: exchangeRateService,
+		PayslipService:      payslipService,
+		OrganizationService: organizationService,
+		JWTManager:          jwtManager,
+		Health:              checker,
+		Metrics:             rpcMetrics,
+		Logger:              log.With(logger, "transport", "grpc"),
 	})
 
-	errChan := make(chan error, 1)
+	// The HTTP gateway forwards to the gRPC server over loopback so both
+	// transports share the same interceptors.
+	gatewayConn, err := grpc.NewClient(
+		net.JoinHostPort("localhost", cfg.Server.GRPCPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		_ = level.Error(logger).Log("msg", "failed to create gateway connection", "err", err)
+		os.Exit(1)
+	}
+	defer gatewayConn.Close()
+
+	httpServer, err := transporthttp.NewServer(transporthttp.ServerConfig{
+		Addr:   ":" + cfg.Server.Port,
+		Conn:   gatewayConn,
+		Health: checker,
+		Logger: log.With(logger, "transport", "http"),
+	})
+	if err != nil {
+		_ = level.Error(logger).Log("msg", "failed to create HTTP server", "err", err)
+		os.Exit(1)
+	}
+
+	metricsServer := metrics.NewServer(":"+cfg.Server.MetricsPort, registry)
+
+	errChan := make(chan error, 3)
 	go func() {
 		grpcListener, err := net.Listen("tcp", ":"+cfg.Server.GRPCPort)
 		if err != nil {
@@ -73,6 +212,27 @@ func main() {
 		errChan <- grpcServer.Serve(grpcListener)
 	}()
 
+	go func() {
+		_ = level.Info(logger).Log("msg", "starting HTTP server", "port", cfg.Server.Port)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errChan <- err
+		}
+	}()
+
+	go func() {
+		_ = level.Info(logger).Log("msg", "starting metrics server", "port", cfg.Server.MetricsPort)
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errChan <- err
+		}
+	}()
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	if cfg.Retention.DeletedEmployees > 0 {
+		go runRetention(jobsCtx, employeeService, cfg.Retention, log.With(logger, "job", "retention"))
+	}
+	go runCompensation(jobsCtx, employeeService, cfg.Compensation, log.With(logger, "job", "compensation"))
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
@@ -83,7 +243,36 @@ func main() {
 		_ = level.Info(logger).Log("msg", "shutting down servers", "signal", sig)
 	}
 
-	grpcServer.GracefulStop()
+	stopJobs()
+
+	// Report NOT_SERVING first so load balancers drain traffic while the
+	// servers are still accepting requests.
+	checker.Drain()
+	time.Sleep(cfg.Server.DrainDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(ctx); err != nil {
+		_ = level.Error(logger).Log("msg", "failed to shut down HTTP server", "err", err)
+	}
+
+	// Watch streams stay open until the client leaves; cut them off once
+	// the shutdown timeout is up. Watchers reconnect and resume.
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+
+	// Stop the metrics server last so the final requests are still recorded.
+	if err := metricsServer.Shutdown(ctx); err != nil {
+		_ = level.Error(logger).Log("msg", "failed to shut down metrics server", "err", err)
+	}
 
 	_ = level.Info(logger).Log("msg", "servers stopped")
 }
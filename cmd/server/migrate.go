@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/persistence/postgres"
+)
+
+const migrateUsage = "usage: server migrate up | down [steps] | status"
+
+// runMigrate implements the migrate subcommand.
+func runMigrate(ctx context.Context, migrator *postgres.Migrator, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			_, _ = fmt.Fprintln(out, "schema is up to date")
+		}
+		for _, m := range applied {
+			_, _ = fmt.Fprintf(out, "applied %04d_%s\n", m.Version, m.Name)
+		}
+		return nil
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+			steps = n
+		}
+		rolledBack, err := migrator.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		if len(rolledBack) == 0 {
+			_, _ = fmt.Fprintln(out, "no migrations to roll back")
+		}
+		for _, m := range rolledBack {
+			_, _ = fmt.Fprintf(out, "rolled back %04d_%s\n", m.Version, m.Name)
+		}
+		return nil
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			name := s.Name
+			if name == "" {
+				name = "(unknown to this binary)"
+			}
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.UTC().Format(time.RFC3339)
+			}
+			_, _ = fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, name, appliedAt)
+		}
+		return w.Flush()
+
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/config"
+	employeeuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/employee"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+// runRetention purges employees deleted longer ago than the retention period,
+// once at startup and then every interval, until ctx is cancelled.
+func runRetention(ctx context.Context, employees employeeuc.Service, cfg config.RetentionConfig, logger log.Logger) {
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		cutoff := time.Now().Add(-cfg.DeletedEmployees)
+		purged, err := employees.PurgeDeleted(ctx, cutoff)
+		if err != nil && ctx.Err() == nil {
+			_ = level.Error(logger).Log("msg", "failed to purge deleted employees", "purged", purged, "err", err)
+		} else if purged > 0 {
+			_ = level.Info(logger).Log("msg", "purged deleted employees", "purged", purged, "deleted_before", cutoff)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CompensationReason string
+
+const (
+	CompensationReasonHire       CompensationReason = "hire"
+	CompensationReasonPromotion  CompensationReason = "promotion"
+	CompensationReasonMerit      CompensationReason = "merit"
+	CompensationReasonAdjustment CompensationReason = "adjustment"
+)
+
+func (r CompensationReason) IsValid() bool {
+	switch r {
+	case CompensationReasonHire, CompensationReasonPromotion, CompensationReasonMerit, CompensationReasonAdjustment:
+		return true
+	}
+	return false
+}
+
+// CompensationRecord is an employee's gross salary from EffectiveFrom
+// (inclusive) until EffectiveTo (exclusive, nil while no later change is
+// scheduled). An employee's records form a timeline without gaps or
+// overlaps; Employee.GrossSalary is the salary of the record in force now.
+type CompensationRecord struct {
+	ID            uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	EmployeeID    uuid.UUID          `gorm:"type:uuid;not null;uniqueIndex:idx_compensation_records_employee_effective_from,priority:1"`
+	GrossSalary   decimal.Decimal    `gorm:"type:decimal(15,2);not null"`
+	Currency      string             `gorm:"type:char(3);not null"`
+	Reason        CompensationReason `gorm:"type:varchar(20);not null"`
+	ApproverID    *uuid.UUID         `gorm:"type:uuid"`
+	EffectiveFrom time.Time          `gorm:"not null;uniqueIndex:idx_compensation_records_employee_effective_from,priority:2"`
+	EffectiveTo   *time.Time
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (CompensationRecord) TableName() string {
+	return "compensation_records"
+}
+
+func NewCompensationRecord(employeeID uuid.UUID, grossSalary decimal.Decimal, currency string, reason CompensationReason, approverID *uuid.UUID, effectiveFrom time.Time) *CompensationRecord {
+	return &CompensationRecord{
+		ID:            uuid.New(),
+		EmployeeID:    employeeID,
+		GrossSalary:   grossSalary,
+		Currency:      currency,
+		Reason:        reason,
+		ApproverID:    approverID,
+		EffectiveFrom: effectiveFrom,
+	}
+}
+
+// IsEffectiveAt reports whether the record applies on the given instant.
+func (r *CompensationRecord) IsEffectiveAt(t time.Time) bool {
+	if t.Before(r.EffectiveFrom) {
+		return false
+	}
+	return r.EffectiveTo == nil || t.Before(*r.EffectiveTo)
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CostCenter is the budget an employee's payroll is charged to, identified
+// by a code that is unique regardless of case.
+type CostCenter struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Code      string    `gorm:"type:varchar(20);not null"`
+	Name      string    `gorm:"type:varchar(100);not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (CostCenter) TableName() string {
+	return "cost_centers"
+}
+
+func NewCostCenter(code, name string) *CostCenter {
+	return &CostCenter{
+		ID:   uuid.New(),
+		Code: code,
+		Name: name,
+	}
+}
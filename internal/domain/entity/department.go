@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Department is a unit of the organisation. Departments nest within a
+// parent department; those without a parent are divisions. Names are unique
+// regardless of case.
+type Department struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name      string     `gorm:"type:varchar(100);not null"`
+	ParentID  *uuid.UUID `gorm:"type:uuid;index"`
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt time.Time  `gorm:"autoUpdateTime"`
+}
+
+func (Department) TableName() string {
+	return "departments"
+}
+
+func NewDepartment(name string, parentID *uuid.UUID) *Department {
+	return &Department{
+		ID:       uuid.New(),
+		Name:     name,
+		ParentID: parentID,
+	}
+}
@@ -13,22 +13,49 @@ type Employee struct {
 	FullName    string          `gorm:"type:varchar(255);not null"`
 	JobTitle    string          `gorm:"type:varchar(100);not null;index"`
 	Country     string          `gorm:"type:varchar(100);not null;index"`
-	GrossSalary decimal.Decimal `gorm:"type:decimal(15,2);not null"`
-	CreatedAt   time.Time       `gorm:"autoCreateTime"`
-	UpdatedAt   time.Time       `gorm:"autoUpdateTime"`
-	DeletedAt   gorm.DeletedAt  `gorm:"index"`
+	GrossSalary decimal.Decimal `gorm:"type:decimal(15,2);not null;index"`
+	// Currency is the ISO 4217 code GrossSalary is paid in.
+	Currency string `gorm:"type:char(3);not null"`
+	// ManagerID is the employee this one reports to, nil at the top of the
+	// organisation. Reporting lines never form a cycle.
+	ManagerID *uuid.UUID `gorm:"type:uuid;index"`
+	// DepartmentID and CostCenterID are nil until the employee is assigned
+	// to one.
+	DepartmentID *uuid.UUID `gorm:"type:uuid;index"`
+	CostCenterID *uuid.UUID `gorm:"type:uuid;index"`
+	// HireDate is the UTC date of the employee's first working day, which
+	// may be before the record was created or, while pending, in the future.
+	HireDate       time.Time        `gorm:"type:date;not null"`
+	EmploymentType EmploymentType   `gorm:"type:varchar(20);not null;default:full_time"`
+	Status         EmploymentStatus `gorm:"type:varchar(20);not null;default:active;index"`
+	// TerminationReason and LastWorkingDay are only set while the employee
+	// is terminated.
+	TerminationReason TerminationReason `gorm:"type:varchar(20);not null;default:''"`
+	LastWorkingDay    *time.Time        `gorm:"type:date"`
+	CreatedAt         time.Time         `gorm:"autoCreateTime;index"`
+	UpdatedAt         time.Time         `gorm:"autoUpdateTime;index"`
+	// Version is incremented by every update. Writers must supply the
+	// version they read, so concurrent edits cannot overwrite each other.
+	Version   int64          `gorm:"not null;default:1"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 func (Employee) TableName() string {
 	return "employees"
 }
 
-func NewEmployee(fullName, jobTitle, country string, grossSalary decimal.Decimal) *Employee {
+// NewEmployee returns an active, full-time employee. Callers set the hire
+// date.
+func NewEmployee(fullName, jobTitle, country string, grossSalary decimal.Decimal, currency string) *Employee {
 	return &Employee{
-		ID:          uuid.New(),
-		FullName:    fullName,
-		JobTitle:    jobTitle,
-		Country:     country,
-		GrossSalary: grossSalary,
+		ID:             uuid.New(),
+		FullName:       fullName,
+		JobTitle:       jobTitle,
+		Country:        country,
+		GrossSalary:    grossSalary,
+		Currency:       currency,
+		EmploymentType: EmploymentTypeFullTime,
+		Status:         EmploymentStatusActive,
+		Version:        1,
 	}
 }
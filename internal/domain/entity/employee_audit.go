@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditAction string
+
+const (
+	AuditActionCreated  AuditAction = "created"
+	AuditActionUpdated  AuditAction = "updated"
+	AuditActionDeleted  AuditAction = "deleted"
+	AuditActionRestored AuditAction = "restored"
+	AuditActionPurged   AuditAction = "purged"
+)
+
+// FieldChange is the before and after value of one employee field. Before is
+// nil for a created record and After is nil for a deleted one.
+type FieldChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
+}
+
+// FieldChanges is stored as a JSONB array.
+type FieldChanges []FieldChange
+
+func (c FieldChanges) Value() (driver.Value, error) {
+	if c == nil {
+		return "[]", nil
+	}
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (c *FieldChanges) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*c = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return errors.New("unsupported type for field changes")
+	}
+}
+
+// EmployeeAuditEntry records one change to an employee: who made it, when,
+// and the value of every field that changed. Entries are append-only.
+type EmployeeAuditEntry struct {
+	ID         uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	EmployeeID uuid.UUID    `gorm:"type:uuid;not null;index:idx_employee_audit_log_employee_occurred,priority:1"`
+	Action     AuditAction  `gorm:"type:varchar(20);not null"`
+	ActorID    *uuid.UUID   `gorm:"type:uuid"`
+	Changes    FieldChanges `gorm:"type:jsonb;not null;default:'[]'"`
+	OccurredAt time.Time    `gorm:"not null;index:idx_employee_audit_log_employee_occurred,priority:2"`
+}
+
+func (EmployeeAuditEntry) TableName() string {
+	return "employee_audit_log"
+}
+
+func NewEmployeeAuditEntry(employeeID uuid.UUID, action AuditAction, actorID *uuid.UUID, changes FieldChanges, occurredAt time.Time) *EmployeeAuditEntry {
+	return &EmployeeAuditEntry{
+		ID:         uuid.New(),
+		EmployeeID: employeeID,
+		Action:     action,
+		ActorID:    actorID,
+		Changes:    changes,
+		OccurredAt: occurredAt,
+	}
+}
+
+// auditedEmployeeFields lists the employee fields tracked by the audit log
+// and how each is rendered.
+var auditedEmployeeFields = []struct {
+	name  string
+	value func(*Employee) string
+}{
+	{"full_name", func(e *Employee) string { return e.FullName }},
+	{"job_title", func(e *Employee) string { return e.JobTitle }},
+	{"country", func(e *Employee) string { return e.Country }},
+	{"gross_salary", func(e *Employee) string { return e.GrossSalary.StringFixed(2) }},
+	{"currency", func(e *Employee) string { return e.Currency }},
+	{"manager_id", func(e *Employee) string { return optionalID(e.ManagerID) }},
+	{"department_id", func(e *Employee) string { return optionalID(e.DepartmentID) }},
+	{"cost_center_id", func(e *Employee) string { return optionalID(e.CostCenterID) }},
+	{"hire_date", func(e *Employee) string { return e.HireDate.Format(time.DateOnly) }},
+	{"employment_type", func(e *Employee) string { return string(e.EmploymentType) }},
+	{"status", func(e *Employee) string { return string(e.Status) }},
+	{"termination_reason", func(e *Employee) string { return string(e.TerminationReason) }},
+	{"last_working_day", func(e *Employee) string { return optionalDate(e.LastWorkingDay) }},
+}
+
+// optionalID renders a nil ID as an empty string.
+func optionalID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// optionalDate renders a nil date as an empty string.
+func optionalDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format(time.DateOnly)
+}
+
+// DiffEmployee returns the audited fields that differ between before and
+// after. Pass a nil before for a created employee and a nil after for a
+// deleted one.
+func DiffEmployee(before, after *Employee) FieldChanges {
+	changes := FieldChanges{}
+	for _, field := range auditedEmployeeFields {
+		var oldValue, newValue *string
+		if before != nil {
+			v := field.value(before)
+			oldValue = &v
+		}
+		if after != nil {
+			v := field.value(after)
+			newValue = &v
+		}
+		if oldValue != nil && newValue != nil && *oldValue == *newValue {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: field.name, Before: oldValue, After: newValue})
+	}
+	return changes
+}
@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmployeeEventType is the kind of change an employee event reports.
+type EmployeeEventType string
+
+const (
+	EmployeeEventCreated EmployeeEventType = "created"
+	EmployeeEventUpdated EmployeeEventType = "updated"
+	EmployeeEventDeleted EmployeeEventType = "deleted"
+)
+
+func (t EmployeeEventType) IsValid() bool {
+	switch t {
+	case EmployeeEventCreated, EmployeeEventUpdated, EmployeeEventDeleted:
+		return true
+	}
+	return false
+}
+
+// employeeEventTypes maps the audited changes published as events. A
+// restored employee is published as created again; purging an already
+// deleted employee is not published.
+var employeeEventTypes = map[AuditAction]EmployeeEventType{
+	AuditActionCreated:  EmployeeEventCreated,
+	AuditActionUpdated:  EmployeeEventUpdated,
+	AuditActionDeleted:  EmployeeEventDeleted,
+	AuditActionRestored: EmployeeEventCreated,
+}
+
+// EmployeeSnapshot is the state of an employee stored with an event, as a
+// JSONB document.
+type EmployeeSnapshot Employee
+
+func (s EmployeeSnapshot) Value() (driver.Value, error) {
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (s *EmployeeSnapshot) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return errors.New("unsupported type for employee snapshot")
+	}
+}
+
+// EmployeeEvent publishes a committed change to an employee to watchers.
+// Events are durable and append-only; Sequence is assigned when the event is
+// stored and identifies it in the stream, which is ordered by commit rather
+// than by sequence.
+type EmployeeEvent struct {
+	Sequence   int64             `gorm:"primaryKey;autoIncrement;index:idx_employee_events_country_sequence,priority:2"`
+	Type       EmployeeEventType `gorm:"type:varchar(20);not null"`
+	EmployeeID uuid.UUID         `gorm:"type:uuid;not null"`
+	// Country is the employee's country after the change, or when it was
+	// deleted, so watchers can filter on it.
+	Country string `gorm:"type:varchar(100);not null;index:idx_employee_events_country_sequence,priority:1"`
+	// PreviousCountry is the employee's country before an update that moved
+	// it to Country, and empty otherwise, so watchers of the old country see
+	// the employee leave.
+	PreviousCountry string `gorm:"type:varchar(100);not null;default:''"`
+	// Employee is the employee after the change, or as it was when deleted.
+	Employee   EmployeeSnapshot `gorm:"type:jsonb;not null"`
+	ActorID    *uuid.UUID       `gorm:"type:uuid"`
+	Changes    FieldChanges     `gorm:"type:jsonb;not null;default:'[]'"`
+	OccurredAt time.Time        `gorm:"not null"`
+}
+
+func (EmployeeEvent) TableName() string {
+	return "employee_events"
+}
+
+// NewEmployeeEvent returns the event publishing an audited change to
+// employee, or nil when the change is not published.
+func NewEmployeeEvent(entry *EmployeeAuditEntry, employee *Employee) *EmployeeEvent {
+	eventType, ok := employeeEventTypes[entry.Action]
+	if !ok {
+		return nil
+	}
+	event := &EmployeeEvent{
+		Type:       eventType,
+		EmployeeID: entry.EmployeeID,
+		Country:    employee.Country,
+		Employee:   EmployeeSnapshot(*employee),
+		ActorID:    entry.ActorID,
+		Changes:    entry.Changes,
+		OccurredAt: entry.OccurredAt,
+	}
+	if eventType == EmployeeEventUpdated {
+		for _, change := range entry.Changes {
+			if change.Field == "country" && change.Before != nil {
+				event.PreviousCountry = *change.Before
+			}
+		}
+	}
+	return event
+}
+
+// ForCountry returns the event as seen by a watcher of the employees in
+// country: an update moving the employee out of country is its deletion, and
+// one moving the employee in is its creation. Other events are returned as
+// they are.
+func (e *EmployeeEvent) ForCountry(country string) *EmployeeEvent {
+	if e.Type != EmployeeEventUpdated || e.PreviousCountry == "" {
+		return e
+	}
+	scoped := *e
+	switch country {
+	case e.PreviousCountry:
+		scoped.Type = EmployeeEventDeleted
+	case e.Country:
+		scoped.Type = EmployeeEventCreated
+	}
+	return &scoped
+}
@@ -0,0 +1,58 @@
+package entity
+
+// EmploymentType is the contract an employee works under.
+type EmploymentType string
+
+const (
+	EmploymentTypeFullTime   EmploymentType = "full_time"
+	EmploymentTypePartTime   EmploymentType = "part_time"
+	EmploymentTypeContractor EmploymentType = "contractor"
+)
+
+func (t EmploymentType) IsValid() bool {
+	switch t {
+	case EmploymentTypeFullTime, EmploymentTypePartTime, EmploymentTypeContractor:
+		return true
+	}
+	return false
+}
+
+// EmploymentStatus is where an employee is in the employment lifecycle. A
+// pending employee has been hired but not yet started.
+type EmploymentStatus string
+
+const (
+	EmploymentStatusPending    EmploymentStatus = "pending"
+	EmploymentStatusActive     EmploymentStatus = "active"
+	EmploymentStatusOnLeave    EmploymentStatus = "on_leave"
+	EmploymentStatusTerminated EmploymentStatus = "terminated"
+)
+
+func (s EmploymentStatus) IsValid() bool {
+	switch s {
+	case EmploymentStatusPending, EmploymentStatusActive, EmploymentStatusOnLeave, EmploymentStatusTerminated:
+		return true
+	}
+	return false
+}
+
+// TerminationReason is why an employee left.
+type TerminationReason string
+
+const (
+	TerminationReasonResignation TerminationReason = "resignation"
+	TerminationReasonDismissal   TerminationReason = "dismissal"
+	TerminationReasonRedundancy  TerminationReason = "redundancy"
+	TerminationReasonRetirement  TerminationReason = "retirement"
+	TerminationReasonContractEnd TerminationReason = "contract_end"
+	TerminationReasonOther       TerminationReason = "other"
+)
+
+func (r TerminationReason) IsValid() bool {
+	switch r {
+	case TerminationReasonResignation, TerminationReasonDismissal, TerminationReasonRedundancy,
+		TerminationReasonRetirement, TerminationReasonContractEnd, TerminationReasonOther:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRate is the number of units of QuoteCurrency one unit of
+// BaseCurrency buys, valid from the start of ValidFrom (a UTC date) until the
+// next rate for the same pair.
+type ExchangeRate struct {
+	ID            uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	BaseCurrency  string          `gorm:"type:char(3);not null;uniqueIndex:idx_exchange_rates_pair_valid_from,priority:1"`
+	QuoteCurrency string          `gorm:"type:char(3);not null;uniqueIndex:idx_exchange_rates_pair_valid_from,priority:2"`
+	Rate          decimal.Decimal `gorm:"type:decimal(20,10);not null"`
+	ValidFrom     time.Time       `gorm:"type:date;not null;uniqueIndex:idx_exchange_rates_pair_valid_from,priority:3"`
+	CreatedAt     time.Time       `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time       `gorm:"autoUpdateTime"`
+}
+
+func (ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
+
+func NewExchangeRate(baseCurrency, quoteCurrency string, rate decimal.Decimal, validFrom time.Time) *ExchangeRate {
+	return &ExchangeRate{
+		ID:            uuid.New(),
+		BaseCurrency:  baseCurrency,
+		QuoteCurrency: quoteCurrency,
+		Rate:          rate,
+		ValidFrom:     validFrom,
+	}
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type TaxRuleType string
+
+const (
+	TaxRuleTypeFlat        TaxRuleType = "flat"
+	TaxRuleTypeProgressive TaxRuleType = "progressive"
+)
+
+func (t TaxRuleType) IsValid() bool {
+	return t == TaxRuleTypeFlat || t == TaxRuleTypeProgressive
+}
+
+type TaxBand struct {
+	UpTo *decimal.Decimal `json:"up_to,omitempty"`
+	Rate decimal.Decimal  `json:"rate"`
+}
+
+// TaxBands is stored as a JSONB array.
+type TaxBands []TaxBand
+
+func (b TaxBands) Value() (driver.Value, error) {
+	if b == nil {
+		return "[]", nil
+	}
+	raw, err := json.Marshal(b)
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (b *TaxBands) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*b = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, b)
+	case string:
+		return json.Unmarshal([]byte(v), b)
+	default:
+		return errors.New("unsupported type for tax bands")
+	}
+}
+
+// TaxRule is a country's tax schedule valid from EffectiveFrom (inclusive)
+// until EffectiveTo (exclusive, nil while the rule is current). Rules are
+// never edited once created, only retired, so calculations for a past date
+// always resolve to the same rule.
+type TaxRule struct {
+	ID                uuid.UUID           `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Country           string              `gorm:"type:varchar(100);not null;index:idx_tax_rules_country_effective_from,priority:1"`
+	RuleType          TaxRuleType         `gorm:"type:varchar(20);not null"`
+	Rate              decimal.Decimal     `gorm:"type:decimal(7,6);not null;default:0"`
+	Bands             TaxBands            `gorm:"type:jsonb;not null;default:'[]'"`
+	StandardDeduction decimal.Decimal     `gorm:"type:decimal(15,2);not null;default:0"`
+	MaxTax            decimal.NullDecimal `gorm:"type:decimal(15,2)"`
+	EffectiveFrom     time.Time           `gorm:"not null;index:idx_tax_rules_country_effective_from,priority:2"`
+	EffectiveTo       *time.Time
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+}
+
+func (TaxRule) TableName() string {
+	return "tax_rules"
+}
+
+func NewTaxRule(country string, ruleType TaxRuleType, rate decimal.Decimal, bands TaxBands, standardDeduction decimal.Decimal, maxTax decimal.NullDecimal, effectiveFrom time.Time) *TaxRule {
+	return &TaxRule{
+		ID:                uuid.New(),
+		Country:           country,
+		RuleType:          ruleType,
+		Rate:              rate,
+		Bands:             bands,
+		StandardDeduction: standardDeduction,
+		MaxTax:            maxTax,
+		EffectiveFrom:     effectiveFrom,
+	}
+}
+
+// IsEffectiveAt reports whether the rule applies on the given instant.
+func (r *TaxRule) IsEffectiveAt(t time.Time) bool {
+	if t.Before(r.EffectiveFrom) {
+		return false
+	}
+	return r.EffectiveTo == nil || t.Before(*r.EffectiveTo)
+}
+
+// Schedule converts the rule into the value object used for calculations. A
+// flat rule is a single unbounded bracket.
+func (r *TaxRule) Schedule() valueobject.TaxSchedule {
+	schedule := valueobject.TaxSchedule{StandardDeduction: r.StandardDeduction}
+	if r.MaxTax.Valid {
+		maxTax := r.MaxTax.Decimal
+		schedule.MaxTax = &maxTax
+	}
+
+	if r.RuleType == TaxRuleTypeFlat {
+		schedule.Brackets = []valueobject.TaxBracket{{Rate: r.Rate}}
+		return schedule
+	}
+
+	schedule.Brackets = make([]valueobject.TaxBracket, 0, len(r.Bands))
+	for _, band := range r.Bands {
+		schedule.Brackets = append(schedule.Brackets, valueobject.TaxBracket{UpTo: band.UpTo, Rate: band.Rate})
+	}
+	return schedule
+}
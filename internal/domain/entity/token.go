@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken is a long-lived credential exchanged for new access tokens.
+// Only a hash of the token is stored. Every refresh rotates the token; all
+// tokens descending from one login share a FamilyID so the whole chain can
+// be revoked when a rotated token is replayed.
+type RefreshToken struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID       uuid.UUID  `gorm:"type:uuid;not null;index"`
+	FamilyID     uuid.UUID  `gorm:"type:uuid;not null;index"`
+	TokenHash    string     `gorm:"type:varchar(64);not null;uniqueIndex"`
+	ExpiresAt    time.Time  `gorm:"not null"`
+	RevokedAt    *time.Time `gorm:""`
+	ReplacedByID *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt    time.Time  `gorm:"autoCreateTime"`
+}
+
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+func NewRefreshToken(userID, familyID uuid.UUID, tokenHash string, expiresAt time.Time) *RefreshToken {
+	return &RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// RevokedToken is an access token revoked before its expiry, identified by
+// its jti claim. Rows are only needed until the token would have expired.
+type RevokedToken struct {
+	JTI       string    `gorm:"type:varchar(36);primary_key"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	RevokedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (RevokedToken) TableName() string {
+	return "revoked_tokens"
+}
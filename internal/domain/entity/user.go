@@ -6,10 +6,28 @@ import (
 	"github.com/google/uuid"
 )
 
+type Role string
+
+const (
+	RoleAdmin   Role = "admin"
+	RoleHR      Role = "hr"
+	RoleManager Role = "manager"
+	RoleViewer  Role = "viewer"
+)
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleAdmin, RoleHR, RoleManager, RoleViewer:
+		return true
+	}
+	return false
+}
+
 type User struct {
 	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
 	Email        string    `gorm:"type:varchar(255);not null;uniqueIndex"`
 	PasswordHash string    `gorm:"type:varchar(255);not null"`
+	Role         Role      `gorm:"type:varchar(20);not null;default:'viewer';index"`
 	CreatedAt    time.Time `gorm:"autoCreateTime"`
 	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
 }
@@ -23,5 +41,6 @@ func NewUser(email, passwordHash string) *User {
 		ID:           uuid.New(),
 		Email:        email,
 		PasswordHash: passwordHash,
+		Role:         RoleViewer,
 	}
 }
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// CompensationRepository stores employees' compensation timelines.
+type CompensationRepository interface {
+	// Insert adds record to its employee's timeline. The record in force at
+	// record.EffectiveFrom is cut short there, and record runs until the next
+	// scheduled change, if any. Inserting a second record with the same
+	// EffectiveFrom is a conflict.
+	Insert(ctx context.Context, record *entity.CompensationRecord) error
+	// CreateBatch inserts the first record of newly created employees.
+	CreateBatch(ctx context.Context, records []*entity.CompensationRecord) error
+	// FindEffective returns the employee's record in force at asOf.
+	FindEffective(ctx context.Context, employeeID uuid.UUID, asOf time.Time) (*entity.CompensationRecord, error)
+	// ListByEmployee returns the employee's timeline, oldest first.
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]*entity.CompensationRecord, error)
+	// ListDue returns up to limit records in force at asOf whose salary or
+	// currency is not yet that of their active, non-deleted employee.
+	ListDue(ctx context.Context, asOf time.Time, limit int) ([]*entity.CompensationRecord, error)
+	// CancelScheduled removes the employee's records taking effect after
+	// asOf, so the record in force at asOf runs on without end.
+	CancelScheduled(ctx context.Context, employeeID uuid.UUID, asOf time.Time) error
+}
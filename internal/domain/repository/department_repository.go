@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// DepartmentRepository stores the department hierarchy.
+type DepartmentRepository interface {
+	// Create fails with a conflict error when the name is taken and a
+	// validation error when the parent does not exist.
+	Create(ctx context.Context, department *entity.Department) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Department, error)
+	// List returns every department ordered by name.
+	List(ctx context.Context) ([]*entity.Department, error)
+	// Update renames or moves a department. Moving it under itself or one of
+	// its sub-departments fails with a validation error.
+	Update(ctx context.Context, department *entity.Department) error
+	// Delete removes a department. Its employees and sub-departments move to
+	// reassignTo; when it is nil, a department that has either cannot be
+	// deleted and a conflict error is returned. Employees moved are returned
+	// with the version they had before the move.
+	Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) ([]*entity.Employee, error)
+}
+
+// CostCenterRepository stores cost centers.
+type CostCenterRepository interface {
+	// Create fails with a conflict error when the code is taken.
+	Create(ctx context.Context, costCenter *entity.CostCenter) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.CostCenter, error)
+	// List returns every cost center ordered by code.
+	List(ctx context.Context) ([]*entity.CostCenter, error)
+	Update(ctx context.Context, costCenter *entity.CostCenter) error
+	// Delete removes a cost center, moving its employees to reassignTo like
+	// DepartmentRepository.Delete.
+	Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) ([]*entity.Employee, error)
+}
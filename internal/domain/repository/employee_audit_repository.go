@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// EmployeeAuditPage is one page of an employee's history, newest first.
+type EmployeeAuditPage struct {
+	Entries    []*entity.EmployeeAuditEntry
+	TotalCount int64
+	Page       int
+	PageSize   int
+}
+
+// EmployeeAuditRepository stores the append-only employee audit log.
+type EmployeeAuditRepository interface {
+	Create(ctx context.Context, entry *entity.EmployeeAuditEntry) error
+	CreateBatch(ctx context.Context, entries []*entity.EmployeeAuditEntry) error
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID, page, pageSize int) (*EmployeeAuditPage, error)
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+)
+
+// EmployeeEventFilter selects employee events. Country matches events for
+// employees in the country before or after the change. Empty fields match
+// every event.
+type EmployeeEventFilter struct {
+	Country string
+	Types   []entity.EmployeeEventType
+}
+
+// EmployeeEventRepository stores the durable stream of employee events.
+type EmployeeEventRepository interface {
+	// Append stores events in order and assigns their sequence numbers. It
+	// must be called in the transaction making the change, so the events are
+	// only listed once it commits.
+	Append(ctx context.Context, events []*entity.EmployeeEvent) error
+	// ListAfter returns up to limit committed events matching filter that
+	// follow the event with sequence after, zero for the first event, in
+	// commit order. Events are listed only once no earlier-starting
+	// transaction can still append, so a later call never returns an event
+	// ahead of one already returned.
+	ListAfter(ctx context.Context, after int64, filter EmployeeEventFilter, limit int) ([]*entity.EmployeeEvent, error)
+	// Exists reports whether an event with the sequence has been committed.
+	Exists(ctx context.Context, sequence int64) (bool, error)
+}
@@ -2,17 +2,297 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
+// EmployeeSortField is a column employees can be ordered by. Only indexed
+// columns are sortable.
+type EmployeeSortField string
+
+const (
+	SortByCreatedAt   EmployeeSortField = "created_at"
+	SortByUpdatedAt   EmployeeSortField = "updated_at"
+	SortByJobTitle    EmployeeSortField = "job_title"
+	SortByCountry     EmployeeSortField = "country"
+	SortByGrossSalary EmployeeSortField = "gross_salary"
+)
+
+func (f EmployeeSortField) IsValid() bool {
+	switch f {
+	case SortByCreatedAt, SortByUpdatedAt, SortByJobTitle, SortByCountry, SortByGrossSalary:
+		return true
+	}
+	return false
+}
+
+// EmployeeField is an employee field that can be updated on its own.
+type EmployeeField string
+
+const (
+	EmployeeFieldFullName    EmployeeField = "full_name"
+	EmployeeFieldJobTitle    EmployeeField = "job_title"
+	EmployeeFieldCountry     EmployeeField = "country"
+	EmployeeFieldGrossSalary EmployeeField = "gross_salary"
+	EmployeeFieldCurrency    EmployeeField = "currency"
+	// EmployeeFieldManagerID, EmployeeFieldDepartmentID and
+	// EmployeeFieldCostCenterID are only updated when asked for by name: an
+	// update that replaces the other fields leaves the employee's place in
+	// the organisation alone.
+	EmployeeFieldManagerID    EmployeeField = "manager_id"
+	EmployeeFieldDepartmentID EmployeeField = "department_id"
+	EmployeeFieldCostCenterID EmployeeField = "cost_center_id"
+	// EmployeeFieldHireDate, EmployeeFieldEmploymentType and
+	// EmployeeFieldStatus are likewise only updated when named. Writing the
+	// status also writes the termination reason and last working day.
+	EmployeeFieldHireDate       EmployeeField = "hire_date"
+	EmployeeFieldEmploymentType EmployeeField = "employment_type"
+	EmployeeFieldStatus         EmployeeField = "status"
+)
+
+// UpdatableEmployeeFields lists the fields an update replaces when it does
+// not name any: every EmployeeField except the placement and employment
+// fields.
+var UpdatableEmployeeFields = []EmployeeField{
+	EmployeeFieldFullName,
+	EmployeeFieldJobTitle,
+	EmployeeFieldCountry,
+	EmployeeFieldGrossSalary,
+	EmployeeFieldCurrency,
+}
+
+func (f EmployeeField) IsValid() bool {
+	switch f {
+	case EmployeeFieldFullName, EmployeeFieldJobTitle, EmployeeFieldCountry, EmployeeFieldGrossSalary, EmployeeFieldCurrency,
+		EmployeeFieldManagerID, EmployeeFieldDepartmentID, EmployeeFieldCostCenterID,
+		EmployeeFieldHireDate, EmployeeFieldEmploymentType, EmployeeFieldStatus:
+		return true
+	}
+	return false
+}
+
+// EmployeeFilter narrows a set of employees. Zero values are ignored.
+type EmployeeFilter struct {
+	Country       string
+	JobTitle      string
+	MinSalary     *decimal.Decimal
+	MaxSalary     *decimal.Decimal
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	// ManagerID keeps the direct reports of a manager; ReportsTo keeps
+	// everyone below a manager, directly or indirectly. Neither includes the
+	// manager.
+	ManagerID *uuid.UUID
+	ReportsTo *uuid.UUID
+	// DepartmentID keeps the employees of a department and of the
+	// departments within it.
+	DepartmentID *uuid.UUID
+	CostCenterID *uuid.UUID
+	// Statuses keeps the employees with any of these employment statuses.
+	Statuses []entity.EmploymentStatus
+}
+
+// Validate rejects empty salary and time ranges and unknown statuses.
+func (f EmployeeFilter) Validate() error {
+	if f.MinSalary != nil && f.MaxSalary != nil && f.MinSalary.GreaterThan(*f.MaxSalary) {
+		return errors.NewValidationError("min_salary cannot be greater than max_salary")
+	}
+	if f.CreatedAfter != nil && f.CreatedBefore != nil && !f.CreatedAfter.Before(*f.CreatedBefore) {
+		return errors.NewValidationError("created_after must be before created_before")
+	}
+	if f.UpdatedAfter != nil && f.UpdatedBefore != nil && !f.UpdatedAfter.Before(*f.UpdatedBefore) {
+		return errors.NewValidationError("updated_after must be before updated_before")
+	}
+	for _, status := range f.Statuses {
+		if !status.IsValid() {
+			return errors.NewValidationError("unsupported employment status")
+		}
+	}
+	return nil
+}
+
+// EmployeeListParams describes one page of a filtered, sorted employee list.
+// When Cursor is set keyset pagination is used and Page is ignored.
+type EmployeeListParams struct {
+	Filter   EmployeeFilter
+	SortBy   EmployeeSortField
+	SortDesc bool
+	Page     int
+	PageSize int
+	Cursor   string
+}
+
+type EmployeePage struct {
+	Employees  []*entity.Employee
+	TotalCount int64
+	Page       int
+	PageSize   int
+	NextCursor string
+}
+
+// OrgNode is an employee in a reporting tree, Depth levels below the root
+// of the tree.
+type OrgNode struct {
+	Employee *entity.Employee
+	Depth    int
+}
+
+// Search highlights enclose the words of a full name or job title that match
+// the query.
+const (
+	HighlightStart = "<mark>"
+	HighlightEnd   = "</mark>"
+)
+
+// EmployeeMatch is an employee found by a search, with its relevance and its
+// full name and job title highlighted.
+type EmployeeMatch struct {
+	Employee          *entity.Employee
+	Rank              float64
+	FullNameHighlight string
+	JobTitleHighlight string
+}
+
+// SalaryDimension is an employee attribute salaries can be grouped by.
+type SalaryDimension string
+
+const (
+	DimensionCountry  SalaryDimension = "country"
+	DimensionJobTitle SalaryDimension = "job_title"
+	// DimensionHireYear is the year of the employee's hire date.
+	DimensionHireYear SalaryDimension = "hire_year"
+	DimensionCurrency SalaryDimension = "currency"
+	// DimensionDepartment and DimensionCostCenter group by department name
+	// and cost center code, empty for employees without one.
+	DimensionDepartment SalaryDimension = "department"
+	DimensionCostCenter SalaryDimension = "cost_center"
+)
+
+func (d SalaryDimension) IsValid() bool {
+	switch d {
+	case DimensionCountry, DimensionJobTitle, DimensionHireYear, DimensionCurrency, DimensionDepartment, DimensionCostCenter:
+		return true
+	}
+	return false
+}
+
+// SalaryMetric is an aggregate computed over the salaries of a group.
+type SalaryMetric string
+
+const (
+	MetricCount  SalaryMetric = "count"
+	MetricSum    SalaryMetric = "sum"
+	MetricMin    SalaryMetric = "min"
+	MetricMax    SalaryMetric = "max"
+	MetricAvg    SalaryMetric = "avg"
+	MetricMedian SalaryMetric = "median"
+	// MetricStdDev is the population standard deviation.
+	MetricStdDev SalaryMetric = "stddev"
+)
+
+func (m SalaryMetric) IsValid() bool {
+	switch m {
+	case MetricCount, MetricSum, MetricMin, MetricMax, MetricAvg, MetricMedian, MetricStdDev:
+		return true
+	}
+	return false
+}
+
+// SalaryAggregation groups the salaries of the employees matching Filter by
+// Dimensions and computes Metrics for each group, after multiplying every
+// salary by the rate of its currency. Every currency paid must have a rate.
+// Groups are ordered by their dimension values; at most Limit are returned.
+type SalaryAggregation struct {
+	Filter     EmployeeFilter
+	Dimensions []SalaryDimension
+	Metrics    []SalaryMetric
+	Rates      map[valueobject.Currency]decimal.Decimal
+	Limit      int
+}
+
+// SalaryGroup is one row of a SalaryAggregation: the values of its
+// dimensions and metrics, in the order they were requested. Amounts are in
+// Currency.
+type SalaryGroup struct {
+	Keys     []string
+	Values   []decimal.Decimal
+	Currency valueobject.Currency
+}
+
+// DepartmentSalaryTotals are the salaries of the employees of one
+// department, not counting its sub-departments, paid in one currency.
+// DepartmentID is nil for employees without a department.
+type DepartmentSalaryTotals struct {
+	DepartmentID *uuid.UUID
+	valueobject.SalaryTotals
+}
+
 type EmployeeRepository interface {
+	// Create fails with a validation error when the manager, department or
+	// cost center of the employee does not exist.
 	Create(ctx context.Context, employee *entity.Employee) error
+	CreateBatch(ctx context.Context, employees []*entity.Employee) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Employee, error)
-	Update(ctx context.Context, employee *entity.Employee) error
+	// FindByIDWithDeleted returns an employee even when it is soft-deleted.
+	FindByIDWithDeleted(ctx context.Context, id uuid.UUID) (*entity.Employee, error)
+	List(ctx context.Context, params EmployeeListParams) (*EmployeePage, error)
+	// Search returns up to limit employees whose full name or job title
+	// contains every word of query as a word prefix, or is similar to query
+	// by trigrams, most relevant first.
+	Search(ctx context.Context, query string, limit int) ([]EmployeeMatch, error)
+	// Stream calls fn for every employee matching filter, oldest first,
+	// reading rows from a database cursor rather than loading them all. It
+	// stops at the first error fn returns.
+	Stream(ctx context.Context, filter EmployeeFilter, fn func(*entity.Employee) error) error
+	// Update writes the given fields of employee if its row is still at
+	// employee.Version and increments the version. A row that has since
+	// changed is left alone and a precondition failed error is returned.
+	//
+	// Changing the manager fails with a validation error when the new
+	// manager is the employee or reports to it, which would make a cycle,
+	// and changing the department or cost center when it does not exist.
+	Update(ctx context.Context, employee *entity.Employee, fields []EmployeeField) error
+	// Delete soft-deletes an employee. It fails with a conflict error while
+	// the employee has direct reports.
 	Delete(ctx context.Context, id uuid.UUID) error
-	GetSalaryStatsByCountry(ctx context.Context, country string) (*valueobject.SalaryStats, error)
-	GetAvgSalaryByJobTitle(ctx context.Context, jobTitle string) (*valueobject.JobTitleSalaryStats, error)
+	// ListDeleted pages through soft-deleted employees, most recently
+	// deleted first.
+	ListDeleted(ctx context.Context, page, pageSize int) (*EmployeePage, error)
+	// Restore undeletes a soft-deleted employee, incrementing its version.
+	// An employee whose manager has since been deleted loses its manager.
+	Restore(ctx context.Context, id uuid.UUID) (*entity.Employee, error)
+	// Purge permanently removes a soft-deleted employee.
+	Purge(ctx context.Context, id uuid.UUID) error
+	// PurgeDeletedBefore permanently removes up to limit employees
+	// soft-deleted before cutoff and returns their ids. Rows being purged by
+	// another caller are skipped.
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error)
+	// GetSalaryTotals aggregates the salaries of the employees matching
+	// filter once per currency. It returns no totals when none match.
+	GetSalaryTotals(ctx context.Context, filter EmployeeFilter) ([]valueobject.SalaryTotals, error)
+	// GetDepartmentSalaryTotals aggregates the salaries of the employees with
+	// any of the given statuses once per department and currency.
+	GetDepartmentSalaryTotals(ctx context.Context, statuses []entity.EmploymentStatus) ([]DepartmentSalaryTotals, error)
+	// GetSalaryDistribution describes the salaries of the employees matching
+	// filter, each multiplied by the rate of its currency, with a histogram
+	// of equal-width buckets between the lowest and highest. Every currency
+	// paid must have a rate.
+	GetSalaryDistribution(ctx context.Context, filter EmployeeFilter, rates map[valueobject.Currency]decimal.Decimal, buckets int) (*valueobject.SalaryDistribution, error)
+	AggregateSalaries(ctx context.Context, aggregation SalaryAggregation) ([]SalaryGroup, error)
+	CountByCountry(ctx context.Context) (map[string]int64, error)
+	// ListOrgTree returns the employee root at depth 0, or every employee
+	// without a manager when root is nil, and the employees below, at most
+	// maxDepth levels down (all levels when zero). Nodes are ordered by
+	// depth, then full name; at most limit are returned.
+	ListOrgTree(ctx context.Context, root *uuid.UUID, maxDepth, limit int) ([]OrgNode, error)
+	// GetManagementChain returns the managers above an employee, its direct
+	// manager first and the top of the organisation last.
+	GetManagementChain(ctx context.Context, id uuid.UUID) ([]*entity.Employee, error)
 }
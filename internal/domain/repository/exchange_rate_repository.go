@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+)
+
+// ExchangeRateRepository stores daily exchange rates between currency pairs.
+type ExchangeRateRepository interface {
+	// Upsert stores rates, replacing any rate already stored for the same
+	// pair and date.
+	Upsert(ctx context.Context, rates []*entity.ExchangeRate) error
+	// FindEffective returns the latest rate from base to quote valid on the
+	// date of asOf.
+	FindEffective(ctx context.Context, base, quote string, asOf time.Time) (*entity.ExchangeRate, error)
+	// List returns rates ordered by pair and date, optionally only those
+	// with the given base currency.
+	List(ctx context.Context, base string) ([]*entity.ExchangeRate, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type TaxRuleRepository interface {
+	// Supersede closes the country's open-ended rule at rule.EffectiveFrom and
+	// inserts rule, atomically. It returns a conflict error when another rule
+	// of the country starts on or after rule.EffectiveFrom or ends after it.
+	Supersede(ctx context.Context, rule *entity.TaxRule) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.TaxRule, error)
+	// FindEffective returns the rule in force for the country at asOf.
+	FindEffective(ctx context.Context, country string, asOf time.Time) (*entity.TaxRule, error)
+	// ListByCountry returns rules ordered by effective date; an empty country
+	// lists every rule.
+	ListByCountry(ctx context.Context, country string) ([]*entity.TaxRule, error)
+	Update(ctx context.Context, rule *entity.TaxRule) error
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *entity.RefreshToken) error
+	FindByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error)
+	// Rotate revokes the token identified by oldID and stores next as its
+	// replacement, atomically. It fails with a conflict error if oldID was
+	// already revoked.
+	Rotate(ctx context.Context, oldID uuid.UUID, next *entity.RefreshToken) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+}
+
+type RevokedTokenRepository interface {
+	Revoke(ctx context.Context, jti string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import "context"
+
+// Transactor runs a function inside a database transaction. Repository calls
+// made with the context passed to fn join the transaction; it is committed
+// when fn returns nil and rolled back otherwise.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithinSnapshot runs fn in a read-only transaction in which every query
+	// sees the database as it was when the first one ran, so reads that
+	// depend on each other stay consistent.
+	WithinSnapshot(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -12,4 +12,6 @@ type UserRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	CountByRole(ctx context.Context, role entity.Role) (int64, error)
+	UpdateRole(ctx context.Context, id uuid.UUID, role entity.Role) error
 }
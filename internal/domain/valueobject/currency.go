@@ -0,0 +1,40 @@
+package valueobject
+
+import (
+	"golang.org/x/text/currency"
+)
+
+// Currency is an ISO 4217 currency code, such as INR or USD.
+type Currency string
+
+const (
+	CurrencyINR Currency = "INR"
+	CurrencyUSD Currency = "USD"
+)
+
+// ParseCurrency validates an ISO 4217 code, in any case, and returns it in
+// upper case.
+func ParseCurrency(code string) (Currency, error) {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return "", err
+	}
+	return Currency(unit.String()), nil
+}
+
+func (c Currency) String() string {
+	return string(c)
+}
+
+// countryCurrencies holds the currency employees of a country are paid in
+// unless another is given.
+var countryCurrencies = map[Country]Currency{
+	CountryIndia:        CurrencyINR,
+	CountryUnitedStates: CurrencyUSD,
+}
+
+// Currency returns the country's default salary currency, or "" when it has
+// none.
+func (c Country) Currency() Currency {
+	return countryCurrencies[c]
+}
@@ -2,27 +2,25 @@ package valueobject
 
 import "github.com/shopspring/decimal"
 
+// Salary is the result of a net salary calculation. TaxRate is the effective
+// rate (total tax over gross); Brackets shows how the tax was built up, and
+// CapAdjustment is the (non-positive) correction applied when the schedule
+// caps the total tax. Every amount is in Currency.
 type Salary struct {
-	GrossSalary decimal.Decimal
-	TaxRate     decimal.Decimal
-	TaxAmount   decimal.Decimal
-	NetSalary   decimal.Decimal
-}
-
-func CalculateNetSalary(grossSalary decimal.Decimal, country Country) Salary {
-	taxRate := country.GetTaxRate()
-	taxAmount := grossSalary.Mul(taxRate)
-	netSalary := grossSalary.Sub(taxAmount)
-
-	return Salary{
-		GrossSalary: grossSalary,
-		TaxRate:     taxRate,
-		TaxAmount:   taxAmount.Round(2),
-		NetSalary:   netSalary.Round(2),
-	}
+	Currency          Currency
+	GrossSalary       decimal.Decimal
+	StandardDeduction decimal.Decimal
+	TaxableIncome     decimal.Decimal
+	Brackets          []BracketTax
+	CapAdjustment     decimal.Decimal
+	TaxRate           decimal.Decimal
+	TaxAmount         decimal.Decimal
+	NetSalary         decimal.Decimal
 }
 
+// SalaryStats summarises a group of salaries, all in Currency.
 type SalaryStats struct {
+	Currency  Currency
 	MinSalary decimal.Decimal
 	MaxSalary decimal.Decimal
 	AvgSalary decimal.Decimal
@@ -31,6 +29,44 @@ type SalaryStats struct {
 
 type JobTitleSalaryStats struct {
 	JobTitle  string
+	Currency  Currency
 	AvgSalary decimal.Decimal
 	Count     int64
 }
+
+// SalaryTotals aggregates the salaries of a group of employees paid in one
+// currency. Unlike averages, totals in different currencies can be
+// converted and combined.
+type SalaryTotals struct {
+	Currency  Currency
+	MinSalary decimal.Decimal
+	MaxSalary decimal.Decimal
+	SumSalary decimal.Decimal
+	Count     int64
+}
+
+// SalaryDistribution describes the spread of a group of salaries, all in
+// Currency. Percentiles are interpolated between neighbouring salaries.
+type SalaryDistribution struct {
+	Currency     Currency
+	Count        int64
+	MinSalary    decimal.Decimal
+	MaxSalary    decimal.Decimal
+	AvgSalary    decimal.Decimal
+	MedianSalary decimal.Decimal
+	P10Salary    decimal.Decimal
+	P25Salary    decimal.Decimal
+	P75Salary    decimal.Decimal
+	P90Salary    decimal.Decimal
+	// StdDev is the population standard deviation.
+	StdDev    decimal.Decimal
+	Histogram []SalaryBucket
+}
+
+// SalaryBucket counts the salaries from LowerBound (inclusive) to UpperBound
+// (exclusive, inclusive for the last bucket of a histogram).
+type SalaryBucket struct {
+	LowerBound decimal.Decimal
+	UpperBound decimal.Decimal
+	Count      int64
+}
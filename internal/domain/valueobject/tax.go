@@ -0,0 +1,128 @@
+package valueobject
+
+import (
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// TaxBracket is a marginal income band. It covers taxable income from the
+// previous bracket's UpTo to its own UpTo; a nil UpTo is unbounded and must
+// be the last bracket of a schedule.
+type TaxBracket struct {
+	UpTo *decimal.Decimal
+	Rate decimal.Decimal
+}
+
+// TaxSchedule describes how a country taxes annual income: a standard
+// deduction taken off gross income, ordered marginal brackets, and an
+// optional cap on the total tax due.
+type TaxSchedule struct {
+	StandardDeduction decimal.Decimal
+	Brackets          []TaxBracket
+	MaxTax            *decimal.Decimal
+}
+
+// BracketTax is the tax attributed to a single bracket of a calculation.
+type BracketTax struct {
+	LowerBound    decimal.Decimal
+	UpperBound    *decimal.Decimal
+	Rate          decimal.Decimal
+	TaxableAmount decimal.Decimal
+	TaxAmount     decimal.Decimal
+}
+
+func (s TaxSchedule) Validate() error {
+	if s.StandardDeduction.IsNegative() {
+		return errors.NewValidationError("standard deduction cannot be negative")
+	}
+	if s.MaxTax != nil && s.MaxTax.IsNegative() {
+		return errors.NewValidationError("max tax cannot be negative")
+	}
+
+	lower := decimal.Zero
+	for i, b := range s.Brackets {
+		if b.Rate.IsNegative() || b.Rate.GreaterThan(decimal.NewFromInt(1)) {
+			return errors.NewValidationError("bracket rate must be between 0 and 1")
+		}
+		if b.UpTo == nil {
+			if i != len(s.Brackets)-1 {
+				return errors.NewValidationError("only the last bracket can be unbounded")
+			}
+			continue
+		}
+		if !b.UpTo.GreaterThan(lower) {
+			return errors.NewValidationError("bracket bounds must be strictly increasing")
+		}
+		lower = *b.UpTo
+	}
+	if len(s.Brackets) > 0 && s.Brackets[len(s.Brackets)-1].UpTo != nil {
+		return errors.NewValidationError("the last bracket must be unbounded")
+	}
+	return nil
+}
+
+// Apply calculates the tax due on an annual gross salary. Each bracket's tax
+// is rounded to cents before summing so the breakdown reconciles exactly with
+// the total.
+func (s TaxSchedule) Apply(grossSalary decimal.Decimal) Salary {
+	taxable := grossSalary.Sub(s.StandardDeduction)
+	if taxable.IsNegative() {
+		taxable = decimal.Zero
+	}
+
+	breakdown := make([]BracketTax, 0, len(s.Brackets))
+	taxAmount := decimal.Zero
+	lower := decimal.Zero
+	for _, b := range s.Brackets {
+		if !taxable.GreaterThan(lower) {
+			break
+		}
+
+		portion := taxable.Sub(lower)
+		if b.UpTo != nil && taxable.GreaterThan(*b.UpTo) {
+			portion = b.UpTo.Sub(lower)
+		}
+		tax := portion.Mul(b.Rate).Round(2)
+
+		breakdown = append(breakdown, BracketTax{
+			LowerBound:    lower,
+			UpperBound:    b.UpTo,
+			Rate:          b.Rate,
+			TaxableAmount: portion,
+			TaxAmount:     tax,
+		})
+		taxAmount = taxAmount.Add(tax)
+
+		if b.UpTo == nil {
+			break
+		}
+		lower = *b.UpTo
+	}
+
+	capAdjustment := decimal.Zero
+	if s.MaxTax != nil && taxAmount.GreaterThan(*s.MaxTax) {
+		capAdjustment = s.MaxTax.Sub(taxAmount)
+		taxAmount = *s.MaxTax
+	}
+
+	effectiveRate := decimal.Zero
+	if grossSalary.IsPositive() {
+		effectiveRate = taxAmount.DivRound(grossSalary, 4)
+	}
+
+	return Salary{
+		GrossSalary:       grossSalary,
+		StandardDeduction: s.StandardDeduction,
+		TaxableIncome:     taxable,
+		Brackets:          breakdown,
+		CapAdjustment:     capAdjustment,
+		TaxRate:           effectiveRate,
+		TaxAmount:         taxAmount.Round(2),
+		NetSalary:         grossSalary.Sub(taxAmount).Round(2),
+	}
+}
+
+func amount(value int64) *decimal.Decimal {
+	d := decimal.NewFromInt(value)
+	return &d
+}
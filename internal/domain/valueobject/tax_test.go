@@ -0,0 +1,24 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxSchedule_MaxTax(t *testing.T) {
+	maxTax := decimal.NewFromInt(1000)
+	schedule := TaxSchedule{
+		Brackets: []TaxBracket{{Rate: decimal.RequireFromString("0.5")}},
+		MaxTax:   &maxTax,
+	}
+
+	assert.NoError(t, schedule.Validate())
+
+	salary := schedule.Apply(decimal.NewFromInt(10000))
+
+	assert.Equal(t, "1000", salary.TaxAmount.String())
+	assert.Equal(t, "-4000", salary.CapAdjustment.String())
+	assert.Equal(t, "9000", salary.NetSalary.String())
+}
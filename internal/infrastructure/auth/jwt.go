@@ -1,8 +1,11 @@
 package auth
 
 import (
+	"context"
 	"time"
 
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/config"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
 	"github.com/golang-jwt/jwt/v5"
@@ -10,32 +13,51 @@ import (
 )
 
 type JWTManager struct {
-	secret     []byte
-	expiration time.Duration
-	issuer     string
+	secret            []byte
+	expiration        time.Duration
+	refreshExpiration time.Duration
+	issuer            string
+	revoked           repository.RevokedTokenRepository
 }
 
 type Claims struct {
 	UserID string `json:"user_id"`
 	Email  string `json:"email"`
+	Role   string `json:"role"`
 	jwt.RegisteredClaims
 }
 
-func NewJWTManager(cfg config.JWTConfig) *JWTManager {
+// NewJWTManager creates a JWT manager. Tokens whose jti is in revoked are
+// rejected; revoked may be nil to skip the check.
+func NewJWTManager(cfg config.JWTConfig, revoked repository.RevokedTokenRepository) *JWTManager {
 
 	return &JWTManager{
-		secret:     []byte(cfg.Secret),
-		expiration: cfg.Expiration,
-		issuer:     cfg.Issuer,
+		secret:            []byte(cfg.Secret),
+		expiration:        cfg.Expiration,
+		refreshExpiration: cfg.RefreshExpiration,
+		issuer:            cfg.Issuer,
+		revoked:           revoked,
 	}
 }
 
-func (m *JWTManager) GenerateToken(userID uuid.UUID, email string) (string, error) {
+// Expiration is the lifetime of access tokens.
+func (m *JWTManager) Expiration() time.Duration {
+	return m.expiration
+}
+
+// RefreshExpiration is the lifetime of refresh tokens.
+func (m *JWTManager) RefreshExpiration() time.Duration {
+	return m.refreshExpiration
+}
+
+func (m *JWTManager) GenerateToken(userID uuid.UUID, email string, role entity.Role) (string, error) {
 	now := time.Now()
 	claims := Claims{
 		UserID: userID.String(),
 		Email:  email,
+		Role:   string(role),
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
 			ExpiresAt: jwt.NewNumericDate(now.Add(m.expiration)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			Issuer:    m.issuer,
@@ -46,7 +68,7 @@ func (m *JWTManager) GenerateToken(userID uuid.UUID, email string) (string, erro
 	return token.SignedString(m.secret)
 }
 
-func (m *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
+func (m *JWTManager) ValidateToken(ctx context.Context, tokenString string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, errors.NewUnauthorizedError("invalid signing method")
@@ -63,5 +85,15 @@ func (m *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
 		return nil, errors.NewUnauthorizedError("invalid token claims")
 	}
 
+	if m.revoked != nil && claims.ID != "" {
+		revoked, err := m.revoked.IsRevoked(ctx, claims.ID)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, errors.NewUnauthorizedError("token has been revoked")
+		}
+	}
+
 	return claims, nil
 }
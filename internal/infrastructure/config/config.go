@@ -7,14 +7,24 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
+	Server       ServerConfig
+	Database     DatabaseConfig
+	JWT          JWTConfig
+	Auth         AuthConfig
+	Retention    RetentionConfig
+	Compensation CompensationConfig
+	Payslip      PayslipConfig
 }
 
 type ServerConfig struct {
 	Port     string `envconfig:"SERVER_PORT" default:"8080"`
 	GRPCPort string `envconfig:"GRPC_PORT" default:"50051"`
+	// DrainDelay is how long readiness reports NOT_SERVING before the
+	// servers stop accepting requests, giving load balancers time to notice.
+	DrainDelay time.Duration `envconfig:"SHUTDOWN_DRAIN_DELAY" default:"5s"`
+	// MetricsPort serves Prometheus metrics. It is kept off the public port
+	// so it can be firewalled separately.
+	MetricsPort string `envconfig:"METRICS_PORT" default:"9090"`
 }
 
 type DatabaseConfig struct {
@@ -24,6 +34,9 @@ type DatabaseConfig struct {
 	Password string `envconfig:"DB_PASSWORD" default:"postgres"`
 	Name     string `envconfig:"DB_NAME" default:"employee_db"`
 	SSLMode  string `envconfig:"DB_SSLMODE" default:"disable"`
+	// MigrateOnStart applies pending migrations at startup instead of
+	// requiring `server migrate up` to be run first.
+	MigrateOnStart bool `envconfig:"DB_MIGRATE_ON_START" default:"false"`
 }
 
 func Load() (*Config, error) {
@@ -44,7 +57,37 @@ func (d DatabaseConfig) DSN() string {
 }
 
 type JWTConfig struct {
-	Secret     string        `envconfig:"JWT_SECRET" default:"your-secret-key-change-in-production"`
-	Expiration time.Duration `envconfig:"JWT_EXPIRATION" default:"24h"`
-	Issuer     string        `envconfig:"JWT_ISSUER" default:"employee-api"`
+	Secret            string        `envconfig:"JWT_SECRET" default:"your-secret-key-change-in-production"`
+	Expiration        time.Duration `envconfig:"JWT_EXPIRATION" default:"15m"`
+	RefreshExpiration time.Duration `envconfig:"JWT_REFRESH_EXPIRATION" default:"720h"`
+	Issuer            string        `envconfig:"JWT_ISSUER" default:"employee-api"`
+}
+
+type AuthConfig struct {
+	// BootstrapAdminEmail is granted the admin role while no admin exists.
+	BootstrapAdminEmail string `envconfig:"BOOTSTRAP_ADMIN_EMAIL"`
+}
+
+type RetentionConfig struct {
+	// DeletedEmployees is how long soft-deleted employees are kept before
+	// they are purged. Zero keeps them forever.
+	DeletedEmployees time.Duration `envconfig:"DELETED_EMPLOYEE_RETENTION" default:"0"`
+	// Interval is how often the retention job runs.
+	Interval time.Duration `envconfig:"RETENTION_INTERVAL" default:"1h"`
+}
+
+type CompensationConfig struct {
+	// Interval is how often scheduled salary changes that have taken effect
+	// are applied to employees.
+	Interval time.Duration `envconfig:"COMPENSATION_INTERVAL" default:"1m"`
+}
+
+type PayslipConfig struct {
+	// EmployerName and EmployerAddress (comma-separated lines) are printed
+	// at the top of payslips.
+	EmployerName    string   `envconfig:"PAYSLIP_EMPLOYER_NAME"`
+	EmployerAddress []string `envconfig:"PAYSLIP_EMPLOYER_ADDRESS"`
+	// TemplateDir holds payslip templates that replace or add to the
+	// built-in ones.
+	TemplateDir string `envconfig:"PAYSLIP_TEMPLATE_DIR"`
 }
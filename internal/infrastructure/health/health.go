@@ -0,0 +1,114 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// CheckFunc reports whether a dependency is usable. A nil error means healthy.
+type CheckFunc func(ctx context.Context) error
+
+// CheckResult is the outcome of one dependency check.
+type CheckResult struct {
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Report is the aggregated readiness of the process.
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// Ready reports whether every dependency is up and the process is serving.
+func (r Report) Ready() bool {
+	return r.Status == StatusUp
+}
+
+type namedCheck struct {
+	name  string
+	check CheckFunc
+}
+
+// Checker runs the readiness checks of the process. It is shared by the gRPC
+// health service and the HTTP probes so both report the same state.
+type Checker struct {
+	mu       sync.RWMutex
+	checks   []namedCheck
+	timeout  time.Duration
+	draining atomic.Bool
+}
+
+// NewChecker creates a Checker that gives each check at most timeout to
+// complete.
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Register adds a named dependency check.
+func (c *Checker) Register(name string, check CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Drain marks the process as not ready. It is called at the start of
+// shutdown so load balancers stop routing traffic before connections close.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+// Draining reports whether Drain has been called.
+func (c *Checker) Draining() bool {
+	return c.draining.Load()
+}
+
+// Check runs every registered check concurrently and reports the result.
+// While draining the report is down regardless of the dependencies.
+func (c *Checker) Check(ctx context.Context) Report {
+	c.mu.RLock()
+	checks := c.checks
+	c.mu.RUnlock()
+
+	report := Report{Status: StatusUp, Checks: make(map[string]CheckResult, len(checks))}
+	if c.Draining() {
+		report.Status = StatusDown
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	results := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, nc := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			result := CheckResult{Status: StatusUp}
+			if err := nc.check(ctx); err != nil {
+				result.Status = StatusDown
+				result.Error = err.Error()
+			}
+			result.Duration = time.Since(start).String()
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	for i, nc := range checks {
+		report.Checks[nc.name] = results[i]
+		if results[i].Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
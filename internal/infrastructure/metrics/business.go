@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// businessCollector reads business gauges from the database on every scrape,
+// so the values are always current and every replica reports the same numbers.
+type businessCollector struct {
+	employeeRepo repository.EmployeeRepository
+	userRepo     repository.UserRepository
+	timeout      time.Duration
+
+	employees *prometheus.Desc
+	users     *prometheus.Desc
+	up        *prometheus.Desc
+}
+
+// NewBusinessCollector creates a collector for employee and user counts.
+// Each scrape runs a few aggregate queries bounded by timeout.
+func NewBusinessCollector(employeeRepo repository.EmployeeRepository, userRepo repository.UserRepository, timeout time.Duration) prometheus.Collector {
+	return &businessCollector{
+		employeeRepo: employeeRepo,
+		userRepo:     userRepo,
+		timeout:      timeout,
+		employees: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "employees"),
+			"Number of employees, by country.",
+			[]string{"country"}, nil,
+		),
+		users: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "users"),
+			"Number of registered users, by role.",
+			[]string{"role"}, nil,
+		),
+		up: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "business_metrics_up"),
+			"Whether the last collection of business metrics succeeded.",
+			nil, nil,
+		),
+	}
+}
+
+func (c *businessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.employees
+	ch <- c.users
+	ch <- c.up
+}
+
+func (c *businessCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	up := 1.0
+	if counts, err := c.employeeRepo.CountByCountry(ctx); err != nil {
+		up = 0
+	} else {
+		for country, count := range counts {
+			ch <- prometheus.MustNewConstMetric(c.employees, prometheus.GaugeValue, float64(count), country)
+		}
+	}
+
+	for _, role := range []entity.Role{entity.RoleAdmin, entity.RoleHR, entity.RoleManager, entity.RoleViewer} {
+		count, err := c.userRepo.CountByRole(ctx, role)
+		if err != nil {
+			up = 0
+			break
+		}
+		ch <- prometheus.MustNewConstMetric(c.users, prometheus.GaugeValue, float64(count), string(role))
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, up)
+}
@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "employee_api"
+
+// NewRegistry creates a registry with the Go runtime and process collectors.
+func NewRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return reg
+}
+
+// RPCMetrics records per-method request counts, status codes and latency.
+type RPCMetrics struct {
+	started  *prometheus.CounterVec
+	handled  *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewRPCMetrics creates the RPC metrics and registers them with reg.
+func NewRPCMetrics(reg prometheus.Registerer) *RPCMetrics {
+	m := &RPCMetrics{
+		started: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_requests_started_total",
+			Help:      "Number of RPCs started on the server.",
+		}, []string{"service", "method"}),
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_requests_handled_total",
+			Help:      "Number of RPCs completed on the server, by status code.",
+		}, []string{"service", "method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Latency of RPCs handled by the server.",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"service", "method"}),
+	}
+	reg.MustRegister(m.started, m.handled, m.duration)
+	return m
+}
+
+// Started records that an RPC has begun.
+func (m *RPCMetrics) Started(service, method string) {
+	m.started.WithLabelValues(service, method).Inc()
+}
+
+// Handled records a completed RPC with its status code and latency.
+func (m *RPCMetrics) Handled(service, method, code string, duration time.Duration) {
+	m.handled.WithLabelValues(service, method, code).Inc()
+	m.duration.WithLabelValues(service, method).Observe(duration.Seconds())
+}
+
+// NewServer creates the admin HTTP server exposing reg at /metrics.
+func NewServer(addr string, reg *prometheus.Registry) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}))
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type compensationRepository struct {
+	db *gorm.DB
+}
+
+func NewCompensationRepository(db *gorm.DB) repository.CompensationRepository {
+	return &compensationRepository{db: db}
+}
+
+func (r *compensationRepository) Insert(ctx context.Context, record *entity.CompensationRecord) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Locking the timeline serializes concurrent changes to the same
+		// employee, so two inserts cannot both cut the same record short.
+		var timeline []*entity.CompensationRecord
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("employee_id = ?", record.EmployeeID).
+			Order("effective_from").
+			Find(&timeline).Error
+		if err != nil {
+			return errors.NewInternalError(err)
+		}
+
+		var previous *entity.CompensationRecord
+		record.EffectiveTo = nil
+		for _, existing := range timeline {
+			if existing.EffectiveFrom.Equal(record.EffectiveFrom) {
+				return errors.NewConflictError("a compensation change already takes effect at effective_from")
+			}
+			if existing.EffectiveFrom.After(record.EffectiveFrom) {
+				next := existing.EffectiveFrom
+				record.EffectiveTo = &next
+				break
+			}
+			previous = existing
+		}
+
+		if previous != nil {
+			err := tx.Model(previous).Update("effective_to", record.EffectiveFrom).Error
+			if err != nil {
+				return errors.NewInternalError(err)
+			}
+		}
+		if err := tx.Create(record).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		return nil
+	})
+}
+
+func (r *compensationRepository) CreateBatch(ctx context.Context, records []*entity.CompensationRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if err := dbWithContext(ctx, r.db).Create(&records).Error; err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *compensationRepository) FindEffective(ctx context.Context, employeeID uuid.UUID, asOf time.Time) (*entity.CompensationRecord, error) {
+	var record entity.CompensationRecord
+	err := dbWithContext(ctx, r.db).
+		Where("employee_id = ? AND effective_from <= ? AND (effective_to IS NULL OR effective_to > ?)", employeeID, asOf, asOf).
+		Order("effective_from DESC").
+		First(&record).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("compensation record")
+		}
+		return nil, errors.NewInternalError(err)
+	}
+	return &record, nil
+}
+
+func (r *compensationRepository) ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]*entity.CompensationRecord, error) {
+	var records []*entity.CompensationRecord
+	err := dbWithContext(ctx, r.db).
+		Where("employee_id = ?", employeeID).
+		Order("effective_from").
+		Find(&records).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return records, nil
+}
+
+func (r *compensationRepository) ListDue(ctx context.Context, asOf time.Time, limit int) ([]*entity.CompensationRecord, error) {
+	var records []*entity.CompensationRecord
+	err := dbWithContext(ctx, r.db).
+		Joins("JOIN employees ON employees.id = compensation_records.employee_id AND employees.deleted_at IS NULL AND employees.status = ?", entity.EmploymentStatusActive).
+		Where("compensation_records.effective_from <= ?", asOf).
+		Where("compensation_records.effective_to IS NULL OR compensation_records.effective_to > ?", asOf).
+		Where("compensation_records.gross_salary <> employees.gross_salary OR compensation_records.currency <> employees.currency").
+		Order("compensation_records.effective_from").
+		Limit(limit).
+		Find(&records).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return records, nil
+}
+
+func (r *compensationRepository) CancelScheduled(ctx context.Context, employeeID uuid.UUID, asOf time.Time) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Locking the timeline, as Insert does, keeps a concurrent insert from
+		// ending the record in force at a change being cancelled.
+		var timeline []*entity.CompensationRecord
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("employee_id = ?", employeeID).
+			Find(&timeline).Error
+		if err != nil {
+			return errors.NewInternalError(err)
+		}
+
+		err = tx.Where("employee_id = ? AND effective_from > ?", employeeID, asOf).
+			Delete(&entity.CompensationRecord{}).Error
+		if err != nil {
+			return errors.NewInternalError(err)
+		}
+		err = tx.Model(&entity.CompensationRecord{}).
+			Where("employee_id = ? AND effective_to > ?", employeeID, asOf).
+			Update("effective_to", nil).Error
+		if err != nil {
+			return errors.NewInternalError(err)
+		}
+		return nil
+	})
+}
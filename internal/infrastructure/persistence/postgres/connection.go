@@ -1,9 +1,9 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/config"
 
 	"gorm.io/driver/postgres"
@@ -31,11 +31,15 @@ func NewConnection(cfg config.DatabaseConfig) (*gorm.DB, error) {
 	return db, nil
 }
 
-func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
-		&entity.User{},
-		&entity.Employee{},
-	)
+// Ping verifies that a connection from the pool can reach the database.
+func Ping(db *gorm.DB) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	}
 }
 
 func Close(db *gorm.DB) error {
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type costCenterRepository struct {
+	db *gorm.DB
+}
+
+func NewCostCenterRepository(db *gorm.DB) repository.CostCenterRepository {
+	return &costCenterRepository{db: db}
+}
+
+func (r *costCenterRepository) Create(ctx context.Context, costCenter *entity.CostCenter) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := checkCostCenterCode(tx, costCenter); err != nil {
+			return err
+		}
+		if err := tx.Create(costCenter).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		return nil
+	})
+}
+
+// checkCostCenterCode fails with a conflict error when another cost center
+// has the code of costCenter.
+func checkCostCenterCode(tx *gorm.DB, costCenter *entity.CostCenter) error {
+	var taken int64
+	err := tx.Model(&entity.CostCenter{}).
+		Where("upper(code) = upper(?) AND id <> ?", costCenter.Code, costCenter.ID).
+		Count(&taken).Error
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if taken > 0 {
+		return errors.NewConflictError("cost center " + costCenter.Code + " already exists")
+	}
+	return nil
+}
+
+func (r *costCenterRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.CostCenter, error) {
+	var costCenter entity.CostCenter
+	if err := dbWithContext(ctx, r.db).First(&costCenter, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("cost center")
+		}
+		return nil, errors.NewInternalError(err)
+	}
+	return &costCenter, nil
+}
+
+func (r *costCenterRepository) List(ctx context.Context) ([]*entity.CostCenter, error) {
+	var costCenters []*entity.CostCenter
+	if err := dbWithContext(ctx, r.db).Order("code").Find(&costCenters).Error; err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return costCenters, nil
+}
+
+func (r *costCenterRepository) Update(ctx context.Context, costCenter *entity.CostCenter) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := checkCostCenterCode(tx, costCenter); err != nil {
+			return err
+		}
+
+		updatedAt := time.Now()
+		result := tx.Model(&entity.CostCenter{}).
+			Where("id = ?", costCenter.ID).
+			Updates(map[string]interface{}{
+				"code":       costCenter.Code,
+				"name":       costCenter.Name,
+				"updated_at": updatedAt,
+			})
+		if result.Error != nil {
+			return errors.NewInternalError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.NewNotFoundError("cost center")
+		}
+		costCenter.UpdatedAt = updatedAt
+		return nil
+	})
+}
+
+func (r *costCenterRepository) Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) ([]*entity.Employee, error) {
+	var moved []*entity.Employee
+	err := dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var ids []uuid.UUID
+		err := tx.Model(&entity.CostCenter{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", id).
+			Pluck("id", &ids).Error
+		if err != nil {
+			return errors.NewInternalError(err)
+		}
+		if len(ids) == 0 {
+			return errors.NewNotFoundError("cost center")
+		}
+
+		moved, err = membersOf(tx, "cost_center_id", id)
+		if err != nil {
+			return err
+		}
+		if len(moved) > 0 {
+			if reassignTo == nil {
+				return errors.NewConflictError("cost center has employees; reassign them to another cost center first")
+			}
+			if *reassignTo == id {
+				return errors.NewValidationError("reassign_to cannot be the cost center being deleted")
+			}
+			if err := checkReference(tx, &entity.CostCenter{}, *reassignTo, "reassign_to does not match a cost center"); err != nil {
+				return err
+			}
+			if err := moveMembers(tx, "cost_center_id", id, *reassignTo); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Delete(&entity.CostCenter{}, "id = ?", id).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return moved, nil
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// employeeCursor is the keyset position encoded in an opaque page token. It
+// records the sort it was issued for so a token cannot be replayed against a
+// different ordering.
+type employeeCursor struct {
+	SortBy   repository.EmployeeSortField `json:"s"`
+	SortDesc bool                         `json:"d"`
+	Value    string                       `json:"v"`
+	ID       uuid.UUID                    `json:"id"`
+}
+
+func encodeEmployeeCursor(sortBy repository.EmployeeSortField, desc bool, e *entity.Employee) string {
+	c := employeeCursor{SortBy: sortBy, SortDesc: desc, ID: e.ID}
+	switch sortBy {
+	case repository.SortByUpdatedAt:
+		c.Value = e.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case repository.SortByJobTitle:
+		c.Value = e.JobTitle
+	case repository.SortByCountry:
+		c.Value = e.Country
+	case repository.SortByGrossSalary:
+		c.Value = e.GrossSalary.String()
+	default:
+		c.Value = e.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeEmployeeCursor(token string) (*employeeCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.NewValidationError("invalid page_token")
+	}
+	var c employeeCursor
+	if err := json.Unmarshal(raw, &c); err != nil || !c.SortBy.IsValid() {
+		return nil, errors.NewValidationError("invalid page_token")
+	}
+	return &c, nil
+}
+
+// sortValue converts the cursor value back to the Go type of its column so
+// the keyset comparison is typed correctly in SQL.
+func (c *employeeCursor) sortValue() (interface{}, error) {
+	switch c.SortBy {
+	case repository.SortByCreatedAt, repository.SortByUpdatedAt:
+		t, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, errors.NewValidationError("invalid page_token")
+		}
+		return t, nil
+	case repository.SortByGrossSalary:
+		d, err := decimal.NewFromString(c.Value)
+		if err != nil {
+			return nil, errors.NewValidationError("invalid page_token")
+		}
+		return d, nil
+	default:
+		return c.Value, nil
+	}
+}
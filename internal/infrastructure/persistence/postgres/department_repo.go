@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// departmentTreeLock is the advisory lock held by transactions that change
+// the department hierarchy, so that two concurrent moves cannot together
+// make a cycle.
+const departmentTreeLock = 0x646570
+
+type departmentRepository struct {
+	db *gorm.DB
+}
+
+func NewDepartmentRepository(db *gorm.DB) repository.DepartmentRepository {
+	return &departmentRepository{db: db}
+}
+
+// departmentSubtree selects the ids of a department and all of its
+// sub-departments.
+func departmentSubtree(id uuid.UUID) clause.Expr {
+	return gorm.Expr(`
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM departments WHERE id = ?
+			UNION
+			SELECT d.id FROM departments d JOIN subtree s ON d.parent_id = s.id
+		)
+		SELECT id FROM subtree`, id)
+}
+
+func (r *departmentRepository) Create(ctx context.Context, department *entity.Department) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := checkDepartment(tx, department); err != nil {
+			return err
+		}
+		if err := tx.Create(department).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		return nil
+	})
+}
+
+// checkDepartment takes the department tree lock and verifies that the name
+// of department is free and that its parent exists and is not the
+// department or one of its sub-departments.
+func checkDepartment(tx *gorm.DB, department *entity.Department) error {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", departmentTreeLock).Error; err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	var taken int64
+	err := tx.Model(&entity.Department{}).
+		Where("lower(name) = lower(?) AND id <> ?", department.Name, department.ID).
+		Count(&taken).Error
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if taken > 0 {
+		return errors.NewConflictError("a department named " + department.Name + " already exists")
+	}
+
+	if department.ParentID == nil {
+		return nil
+	}
+	if err := checkReference(tx, &entity.Department{}, *department.ParentID, "parent_id does not match a department"); err != nil {
+		return err
+	}
+	var cycles int64
+	err = tx.Model(&entity.Department{}).
+		Where("id IN (?) AND id = ?", departmentSubtree(department.ID), *department.ParentID).
+		Count(&cycles).Error
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if cycles > 0 {
+		return errors.NewValidationError("parent_id cannot be the department or one of its sub-departments")
+	}
+	return nil
+}
+
+func (r *departmentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Department, error) {
+	var department entity.Department
+	if err := dbWithContext(ctx, r.db).First(&department, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("department")
+		}
+		return nil, errors.NewInternalError(err)
+	}
+	return &department, nil
+}
+
+func (r *departmentRepository) List(ctx context.Context) ([]*entity.Department, error) {
+	var departments []*entity.Department
+	if err := dbWithContext(ctx, r.db).Order("lower(name), id").Find(&departments).Error; err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return departments, nil
+}
+
+func (r *departmentRepository) Update(ctx context.Context, department *entity.Department) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := checkDepartment(tx, department); err != nil {
+			return err
+		}
+
+		updatedAt := time.Now()
+		result := tx.Model(&entity.Department{}).
+			Where("id = ?", department.ID).
+			Updates(map[string]interface{}{
+				"name":       department.Name,
+				"parent_id":  department.ParentID,
+				"updated_at": updatedAt,
+			})
+		if result.Error != nil {
+			return errors.NewInternalError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.NewNotFoundError("department")
+		}
+		department.UpdatedAt = updatedAt
+		return nil
+	})
+}
+
+func (r *departmentRepository) Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) ([]*entity.Employee, error) {
+	var moved []*entity.Employee
+	err := dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", departmentTreeLock).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+
+		var ids []uuid.UUID
+		err := tx.Model(&entity.Department{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", id).
+			Pluck("id", &ids).Error
+		if err != nil {
+			return errors.NewInternalError(err)
+		}
+		if len(ids) == 0 {
+			return errors.NewNotFoundError("department")
+		}
+
+		var children int64
+		if err := tx.Model(&entity.Department{}).Where("parent_id = ?", id).Count(&children).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		moved, err = membersOf(tx, "department_id", id)
+		if err != nil {
+			return err
+		}
+
+		if children > 0 || len(moved) > 0 {
+			if reassignTo == nil {
+				return errors.NewConflictError("department has employees or sub-departments; reassign them to another department first")
+			}
+			if err := checkReference(tx, &entity.Department{}, *reassignTo, "reassign_to does not match a department"); err != nil {
+				return err
+			}
+			var inside int64
+			err := tx.Model(&entity.Department{}).
+				Where("id IN (?) AND id = ?", departmentSubtree(id), *reassignTo).
+				Count(&inside).Error
+			if err != nil {
+				return errors.NewInternalError(err)
+			}
+			if inside > 0 {
+				return errors.NewValidationError("reassign_to cannot be the department or one of its sub-departments")
+			}
+
+			err = tx.Model(&entity.Department{}).
+				Where("parent_id = ?", id).
+				Updates(map[string]interface{}{"parent_id": *reassignTo, "updated_at": time.Now()}).Error
+			if err != nil {
+				return errors.NewInternalError(err)
+			}
+			if err := moveMembers(tx, "department_id", id, *reassignTo); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Delete(&entity.Department{}, "id = ?", id).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return moved, nil
+}
+
+// membersOf locks and returns the employees whose column is id.
+func membersOf(tx *gorm.DB, column string, id uuid.UUID) ([]*entity.Employee, error) {
+	var employees []*entity.Employee
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(column+" = ?", id).
+		Order("id").
+		Find(&employees).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return employees, nil
+}
+
+// moveMembers sets column from one id to another on every employee, bumping
+// their versions as any other update does.
+func moveMembers(tx *gorm.DB, column string, from, to uuid.UUID) error {
+	err := tx.Model(&entity.Employee{}).
+		Where(column+" = ?", from).
+		Updates(map[string]interface{}{
+			column:       to,
+			"updated_at": time.Now(),
+			"version":    gorm.Expr("version + 1"),
+		}).Error
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
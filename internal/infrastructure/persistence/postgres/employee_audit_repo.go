@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type employeeAuditRepository struct {
+	db *gorm.DB
+}
+
+func NewEmployeeAuditRepository(db *gorm.DB) repository.EmployeeAuditRepository {
+	return &employeeAuditRepository{db: db}
+}
+
+func (r *employeeAuditRepository) Create(ctx context.Context, entry *entity.EmployeeAuditEntry) error {
+	if err := dbWithContext(ctx, r.db).Create(entry).Error; err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *employeeAuditRepository) CreateBatch(ctx context.Context, entries []*entity.EmployeeAuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	if err := dbWithContext(ctx, r.db).Create(&entries).Error; err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *employeeAuditRepository) ListByEmployee(ctx context.Context, employeeID uuid.UUID, page, pageSize int) (*repository.EmployeeAuditPage, error) {
+	query := dbWithContext(ctx, r.db).
+		Model(&entity.EmployeeAuditEntry{}).
+		Where("employee_id = ?", employeeID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	var entries []*entity.EmployeeAuditEntry
+	err := query.
+		Order("occurred_at DESC").
+		Order("id DESC").
+		Limit(pageSize).
+		Offset((page - 1) * pageSize).
+		Find(&entries).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	return &repository.EmployeeAuditPage{
+		Entries:    entries,
+		TotalCount: total,
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type employeeEventRepository struct {
+	db *gorm.DB
+}
+
+func NewEmployeeEventRepository(db *gorm.DB) repository.EmployeeEventRepository {
+	return &employeeEventRepository{db: db}
+}
+
+func (r *employeeEventRepository) Append(ctx context.Context, events []*entity.EmployeeEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if err := dbWithContext(ctx, r.db).Create(&events).Error; err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
+
+// ListAfter reads events in commit order: by the appending transaction, whose
+// id the xact_id column defaults to, then by sequence. Only transactions older
+// than every running one are read, so no event can later commit ahead of one
+// already returned; a long transaction delays delivery without blocking
+// writers.
+func (r *employeeEventRepository) ListAfter(ctx context.Context, after int64, filter repository.EmployeeEventFilter, limit int) ([]*entity.EmployeeEvent, error) {
+	query := eventsAfter(dbWithContext(ctx, r.db), after)
+	if filter.Country != "" {
+		query = query.Where("country = @country OR previous_country = @country", sql.Named("country", filter.Country))
+	}
+	if len(filter.Types) > 0 {
+		query = query.Where("type IN ?", filter.Types)
+	}
+
+	var events []*entity.EmployeeEvent
+	if err := query.Order("xact_id, sequence").Limit(limit).Find(&events).Error; err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return events, nil
+}
+
+func (r *employeeEventRepository) Exists(ctx context.Context, sequence int64) (bool, error) {
+	var count int64
+	if err := dbWithContext(ctx, r.db).Model(&entity.EmployeeEvent{}).Where("sequence = ?", sequence).Count(&count).Error; err != nil {
+		return false, errors.NewInternalError(err)
+	}
+	return count > 0, nil
+}
+
+// eventsAfter selects the committed events following the event with sequence
+// after in commit order, or every committed event when there is none, so
+// callers resuming a stream check that the event exists first.
+func eventsAfter(tx *gorm.DB, after int64) *gorm.DB {
+	return tx.Model(&entity.EmployeeEvent{}).
+		Where("xact_id < pg_snapshot_xmin(pg_current_snapshot())").
+		Where("(xact_id, sequence) > (COALESCE((SELECT xact_id FROM employee_events WHERE sequence = @after), '0'::xid8), @after)",
+			sql.Named("after", after))
+}
@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventsAfter(t *testing.T) {
+	db := dryRunDB(t)
+
+	t.Run("reads only transactions older than every running one", func(t *testing.T) {
+		stmt := eventsAfter(db, 42).Find(&[]*entity.EmployeeEvent{}).Statement
+		query := db.Dialector.Explain(stmt.SQL.String(), stmt.Vars...)
+
+		assert.Contains(t, query, "xact_id < pg_snapshot_xmin(pg_current_snapshot())")
+		assert.Contains(t, query, "(xact_id, sequence) > (COALESCE((SELECT xact_id FROM employee_events WHERE sequence = 42), '0'::xid8), 42)")
+	})
+}
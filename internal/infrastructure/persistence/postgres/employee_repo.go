@@ -2,6 +2,12 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
@@ -10,8 +16,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+var errStaleEmployee = errors.NewPreconditionFailedError("employee was modified by another request; reload it and retry")
+
+// orgChartLock is the advisory lock held by transactions that change
+// reporting lines, so that two concurrent changes cannot together make a
+// cycle or leave reports under a deleted manager.
+const orgChartLock = 0x6f7267
+
 type employeeRepository struct {
 	db *gorm.DB
 }
@@ -21,7 +35,111 @@ func NewEmployeeRepository(db *gorm.DB) repository.EmployeeRepository {
 }
 
 func (r *employeeRepository) Create(ctx context.Context, employee *entity.Employee) error {
-	if err := r.db.WithContext(ctx).Create(employee).Error; err != nil {
+	db := dbWithContext(ctx, r.db)
+	if employee.ManagerID == nil && employee.DepartmentID == nil && employee.CostCenterID == nil {
+		if err := db.Create(employee).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := checkPlacement(tx, employee, placementFields); err != nil {
+			return err
+		}
+		if err := tx.Create(employee).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		return nil
+	})
+}
+
+// placementFields are the employee fields that reference another row.
+var placementFields = []repository.EmployeeField{
+	repository.EmployeeFieldManagerID,
+	repository.EmployeeFieldDepartmentID,
+	repository.EmployeeFieldCostCenterID,
+}
+
+// checkPlacement verifies the manager, department and cost center of the
+// employee that are named in fields and set. The department and cost center
+// are share-locked so that they cannot be deleted before the transaction
+// commits.
+func checkPlacement(tx *gorm.DB, employee *entity.Employee, fields []repository.EmployeeField) error {
+	if employee.ManagerID != nil && slices.Contains(fields, repository.EmployeeFieldManagerID) {
+		if err := checkManager(tx, employee.ID, *employee.ManagerID); err != nil {
+			return err
+		}
+	}
+	if employee.DepartmentID != nil && slices.Contains(fields, repository.EmployeeFieldDepartmentID) {
+		if err := checkReference(tx, &entity.Department{}, *employee.DepartmentID, "department_id does not match a department"); err != nil {
+			return err
+		}
+	}
+	if employee.CostCenterID != nil && slices.Contains(fields, repository.EmployeeFieldCostCenterID) {
+		if err := checkReference(tx, &entity.CostCenter{}, *employee.CostCenterID, "cost_center_id does not match a cost center"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkReference share-locks the row of model with the given id, failing
+// with a validation error carrying message when there is none.
+func checkReference(tx *gorm.DB, model interface{}, id uuid.UUID, message string) error {
+	var ids []uuid.UUID
+	err := tx.Model(model).
+		Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("id = ?", id).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if len(ids) == 0 {
+		return errors.NewValidationError(message)
+	}
+	return nil
+}
+
+// checkManager takes the org chart lock and verifies that managerID is an
+// employee that does not report to employeeID.
+func checkManager(tx *gorm.DB, employeeID, managerID uuid.UUID) error {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", orgChartLock).Error; err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	var check struct {
+		ManagerExists bool
+		MakesCycle    bool
+	}
+	err := tx.Raw(`
+		WITH RECURSIVE chain AS (
+			SELECT id, manager_id FROM employees WHERE id = @manager
+			UNION
+			SELECT e.id, e.manager_id FROM employees e JOIN chain c ON e.id = c.manager_id
+		)
+		SELECT
+			EXISTS (SELECT 1 FROM employees WHERE id = @manager AND deleted_at IS NULL) AS manager_exists,
+			EXISTS (SELECT 1 FROM chain WHERE id = @employee) AS makes_cycle`,
+		sql.Named("manager", managerID), sql.Named("employee", employeeID)).
+		Scan(&check).Error
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if !check.ManagerExists {
+		return errors.NewValidationError("manager_id does not match an employee")
+	}
+	if check.MakesCycle {
+		return errors.NewValidationError("manager_id cannot be the employee or one of its reports")
+	}
+	return nil
+}
+
+func (r *employeeRepository) CreateBatch(ctx context.Context, employees []*entity.Employee) error {
+	if len(employees) == 0 {
+		return nil
+	}
+	if err := dbWithContext(ctx, r.db).Create(&employees).Error; err != nil {
 		return errors.NewInternalError(err)
 	}
 	return nil
@@ -29,7 +147,18 @@ func (r *employeeRepository) Create(ctx context.Context, employee *entity.Employ
 
 func (r *employeeRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
 	var employee entity.Employee
-	if err := r.db.WithContext(ctx).First(&employee, "id = ?", id).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).First(&employee, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("employee")
+		}
+		return nil, errors.NewInternalError(err)
+	}
+	return &employee, nil
+}
+
+func (r *employeeRepository) FindByIDWithDeleted(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	var employee entity.Employee
+	if err := dbWithContext(ctx, r.db).Unscoped().First(&employee, "id = ?", id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, errors.NewNotFoundError("employee")
 		}
@@ -38,81 +167,751 @@ func (r *employeeRepository) FindByID(ctx context.Context, id uuid.UUID) (*entit
 	return &employee, nil
 }
 
-func (r *employeeRepository) Update(ctx context.Context, employee *entity.Employee) error {
-	result := r.db.WithContext(ctx).Save(employee)
+func (r *employeeRepository) List(ctx context.Context, params repository.EmployeeListParams) (*repository.EmployeePage, error) {
+	query := applyEmployeeFilter(dbWithContext(ctx, r.db).Model(&entity.Employee{}), params.Filter).
+		Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	column := string(params.SortBy)
+	direction, comparison := "ASC", ">"
+	if params.SortDesc {
+		direction, comparison = "DESC", "<"
+	}
+
+	page := query
+	if params.Cursor != "" {
+		cursor, err := decodeEmployeeCursor(params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if cursor.SortBy != params.SortBy || cursor.SortDesc != params.SortDesc {
+			return nil, errors.NewValidationError("page_token does not match the requested sort order")
+		}
+		value, err := cursor.sortValue()
+		if err != nil {
+			return nil, err
+		}
+		page = page.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, comparison), value, cursor.ID)
+	} else {
+		page = page.Offset((params.Page - 1) * params.PageSize)
+	}
+
+	// Fetch one extra row to learn whether another page follows.
+	var employees []*entity.Employee
+	err := page.
+		Order(fmt.Sprintf("%s %s, id %s", column, direction, direction)).
+		Limit(params.PageSize + 1).
+		Find(&employees).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	result := &repository.EmployeePage{
+		TotalCount: total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+	}
+	if len(employees) > params.PageSize {
+		employees = employees[:params.PageSize]
+		result.NextCursor = encodeEmployeeCursor(params.SortBy, params.SortDesc, employees[len(employees)-1])
+	}
+	result.Employees = employees
+
+	return result, nil
+}
+
+// employeeSearchDocument is the text searched by Search. It must match the
+// expression of idx_employees_search for the index to be used.
+const employeeSearchDocument = "to_tsvector('simple', e.full_name || ' ' || e.job_title)"
+
+// employeeMatchRow is an employee read by Search with its rank and
+// highlights.
+type employeeMatchRow struct {
+	entity.Employee
+	Rank              float64
+	FullNameHighlight string
+	JobTitleHighlight string
+}
+
+func (r *employeeRepository) Search(ctx context.Context, query string, limit int) ([]repository.EmployeeMatch, error) {
+	db := dbWithContext(ctx, r.db)
+
+	var rows []employeeMatchRow
+	err := db.Raw(`
+		WITH q AS (SELECT to_tsquery('simple', @prefixes) AS query)
+		SELECT e.*,
+			ts_rank(`+employeeSearchDocument+`, q.query)
+				+ greatest(word_similarity(@text, e.full_name), word_similarity(@text, e.job_title)) AS rank,
+			ts_headline('simple', e.full_name, q.query, @headline) AS full_name_highlight,
+			ts_headline('simple', e.job_title, q.query, @headline) AS job_title_highlight
+		FROM employees e, q
+		WHERE e.deleted_at IS NULL
+			AND (`+employeeSearchDocument+` @@ q.query OR e.full_name %> @text OR e.job_title %> @text)
+		ORDER BY rank DESC, e.full_name, e.id
+		LIMIT @limit`,
+		sql.Named("prefixes", prefixQuery(query)),
+		sql.Named("text", query),
+		sql.Named("headline", "StartSel="+repository.HighlightStart+", StopSel="+repository.HighlightEnd+", HighlightAll=true"),
+		sql.Named("limit", limit)).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	matches := make([]repository.EmployeeMatch, 0, len(rows))
+	for i := range rows {
+		matches = append(matches, repository.EmployeeMatch{
+			Employee:          &rows[i].Employee,
+			Rank:              rows[i].Rank,
+			FullNameHighlight: rows[i].FullNameHighlight,
+			JobTitleHighlight: rows[i].JobTitleHighlight,
+		})
+	}
+	return matches, nil
+}
+
+// prefixQuery turns a search into a tsquery matching every word of it as a
+// prefix, such as "jo:* & eng:*". Everything but letters and digits
+// separates words, so no tsquery syntax gets through.
+func prefixQuery(search string) string {
+	words := strings.FieldsFunc(strings.ToLower(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+func (r *employeeRepository) Stream(ctx context.Context, filter repository.EmployeeFilter, fn func(*entity.Employee) error) error {
+	db := dbWithContext(ctx, r.db)
+	rows, err := applyEmployeeFilter(db.Model(&entity.Employee{}), filter).
+		Order("created_at ASC, id ASC").
+		Rows()
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var employee entity.Employee
+		if err := db.ScanRows(rows, &employee); err != nil {
+			return errors.NewInternalError(err)
+		}
+		if err := fn(&employee); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
+
+func applyEmployeeFilter(query *gorm.DB, filter repository.EmployeeFilter) *gorm.DB {
+	if filter.Country != "" {
+		query = query.Where("country = ?", filter.Country)
+	}
+	if filter.JobTitle != "" {
+		query = query.Where("job_title = ?", filter.JobTitle)
+	}
+	if filter.MinSalary != nil {
+		query = query.Where("gross_salary >= ?", *filter.MinSalary)
+	}
+	if filter.MaxSalary != nil {
+		query = query.Where("gross_salary <= ?", *filter.MaxSalary)
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *filter.CreatedBefore)
+	}
+	if filter.UpdatedAfter != nil {
+		query = query.Where("updated_at >= ?", *filter.UpdatedAfter)
+	}
+	if filter.UpdatedBefore != nil {
+		query = query.Where("updated_at < ?", *filter.UpdatedBefore)
+	}
+	if filter.ManagerID != nil {
+		query = query.Where("manager_id = ?", *filter.ManagerID)
+	}
+	if filter.ReportsTo != nil {
+		query = query.Where(`id IN (
+			WITH RECURSIVE reports AS (
+				SELECT id FROM employees WHERE manager_id = ? AND deleted_at IS NULL
+				UNION
+				SELECT e.id FROM employees e JOIN reports r ON e.manager_id = r.id WHERE e.deleted_at IS NULL
+			)
+			SELECT id FROM reports)`, *filter.ReportsTo)
+	}
+	if filter.DepartmentID != nil {
+		query = query.Where("department_id IN (?)", departmentSubtree(*filter.DepartmentID))
+	}
+	if filter.CostCenterID != nil {
+		query = query.Where("cost_center_id = ?", *filter.CostCenterID)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	return query
+}
+
+func (r *employeeRepository) Update(ctx context.Context, employee *entity.Employee, fields []repository.EmployeeField) error {
+	db := dbWithContext(ctx, r.db)
+	if !slices.ContainsFunc(fields, func(field repository.EmployeeField) bool {
+		return slices.Contains(placementFields, field)
+	}) {
+		return updateEmployee(db, employee, fields)
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := checkPlacement(tx, employee, fields); err != nil {
+			return err
+		}
+		return updateEmployee(tx, employee, fields)
+	})
+}
+
+func updateEmployee(db *gorm.DB, employee *entity.Employee, fields []repository.EmployeeField) error {
+	updatedAt := time.Now()
+
+	columns := map[string]interface{}{
+		"updated_at": updatedAt,
+		"version":    gorm.Expr("version + 1"),
+	}
+	for _, field := range fields {
+		switch field {
+		case repository.EmployeeFieldFullName:
+			columns["full_name"] = employee.FullName
+		case repository.EmployeeFieldJobTitle:
+			columns["job_title"] = employee.JobTitle
+		case repository.EmployeeFieldCountry:
+			columns["country"] = employee.Country
+		case repository.EmployeeFieldGrossSalary:
+			columns["gross_salary"] = employee.GrossSalary
+		case repository.EmployeeFieldCurrency:
+			columns["currency"] = employee.Currency
+		case repository.EmployeeFieldManagerID:
+			columns["manager_id"] = employee.ManagerID
+		case repository.EmployeeFieldDepartmentID:
+			columns["department_id"] = employee.DepartmentID
+		case repository.EmployeeFieldCostCenterID:
+			columns["cost_center_id"] = employee.CostCenterID
+		case repository.EmployeeFieldHireDate:
+			columns["hire_date"] = employee.HireDate
+		case repository.EmployeeFieldEmploymentType:
+			columns["employment_type"] = employee.EmploymentType
+		case repository.EmployeeFieldStatus:
+			columns["status"] = employee.Status
+			columns["termination_reason"] = employee.TerminationReason
+			columns["last_working_day"] = employee.LastWorkingDay
+		default:
+			return errors.NewInternalError(fmt.Errorf("unknown employee field %q", field))
+		}
+	}
+
+	// The version check and increment happen in the one statement, so two
+	// writers that read the same version cannot both succeed.
+	result := db.Model(&entity.Employee{}).
+		Where("id = ? AND version = ?", employee.ID, employee.Version).
+		Updates(columns)
 	if result.Error != nil {
 		return errors.NewInternalError(result.Error)
 	}
+
 	if result.RowsAffected == 0 {
-		return errors.NewNotFoundError("employee")
+		var count int64
+		if err := db.Model(&entity.Employee{}).Where("id = ?", employee.ID).Count(&count).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		if count == 0 {
+			return errors.NewNotFoundError("employee")
+		}
+		return errStaleEmployee
 	}
+
+	employee.UpdatedAt = updatedAt
+	employee.Version++
 	return nil
 }
 
 func (r *employeeRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&entity.Employee{}, "id = ?", id)
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", orgChartLock).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+
+		var reports int64
+		if err := tx.Model(&entity.Employee{}).Where("manager_id = ?", id).Count(&reports).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		if reports > 0 {
+			return errors.NewConflictError("employee has direct reports; assign them another manager first")
+		}
+
+		result := tx.Delete(&entity.Employee{}, "id = ?", id)
+		if result.Error != nil {
+			return errors.NewInternalError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.NewNotFoundError("employee")
+		}
+		return nil
+	})
+}
+
+func (r *employeeRepository) ListDeleted(ctx context.Context, page, pageSize int) (*repository.EmployeePage, error) {
+	query := dbWithContext(ctx, r.db).Unscoped().
+		Model(&entity.Employee{}).
+		Where("deleted_at IS NOT NULL")
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	var employees []*entity.Employee
+	err := query.
+		Order("deleted_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&employees).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	return &repository.EmployeePage{
+		Employees:  employees,
+		TotalCount: total,
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
+
+func (r *employeeRepository) Restore(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	var employee entity.Employee
+	result := dbWithContext(ctx, r.db).Unscoped().
+		Model(&employee).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"updated_at": time.Now(),
+			"version":    gorm.Expr("version + 1"),
+			"manager_id": gorm.Expr("CASE WHEN manager_id IN (SELECT id FROM employees WHERE deleted_at IS NULL) THEN manager_id END"),
+		})
+	if result.Error != nil {
+		return nil, errors.NewInternalError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.NewNotFoundError("deleted employee")
+	}
+	return &employee, nil
+}
+
+func (r *employeeRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	result := dbWithContext(ctx, r.db).Unscoped().
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Delete(&entity.Employee{})
 	if result.Error != nil {
 		return errors.NewInternalError(result.Error)
 	}
 	if result.RowsAffected == 0 {
-		return errors.NewNotFoundError("employee")
+		return errors.NewNotFoundError("deleted employee")
 	}
 	return nil
 }
 
-func (r *employeeRepository) GetSalaryStatsByCountry(ctx context.Context, country string) (*valueobject.SalaryStats, error) {
-	var result struct {
+func (r *employeeRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	db := dbWithContext(ctx, r.db)
+
+	// SKIP LOCKED lets several replicas run the retention job at once
+	// without waiting on, or purging, the same rows.
+	expired := db.Unscoped().
+		Model(&entity.Employee{}).
+		Select("id").
+		Where("deleted_at < ?", cutoff).
+		Order("deleted_at").
+		Limit(limit).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+
+	var purged []*entity.Employee
+	err := db.Unscoped().
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("id IN (?)", expired).
+		Delete(&purged).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(purged))
+	for _, employee := range purged {
+		ids = append(ids, employee.ID)
+	}
+	return ids, nil
+}
+
+func (r *employeeRepository) GetSalaryTotals(ctx context.Context, filter repository.EmployeeFilter) ([]valueobject.SalaryTotals, error) {
+	var rows []struct {
+		Currency  string
 		MinSalary decimal.Decimal
 		MaxSalary decimal.Decimal
-		AvgSalary decimal.Decimal
+		SumSalary decimal.Decimal
 		Count     int64
 	}
 
-	err := r.db.WithContext(ctx).
-		Model(&entity.Employee{}).
-		Select("MIN(gross_salary) as min_salary, MAX(gross_salary) as max_salary, AVG(gross_salary) as avg_salary, COUNT(*) as count").
-		Where("country = ?", country).
-		Scan(&result).Error
+	err := applyEmployeeFilter(dbWithContext(ctx, r.db).Model(&entity.Employee{}), filter).
+		Select("currency, MIN(gross_salary) as min_salary, MAX(gross_salary) as max_salary, SUM(gross_salary) as sum_salary, COUNT(*) as count").
+		Group("currency").
+		Order("currency").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	totals := make([]valueobject.SalaryTotals, 0, len(rows))
+	for _, row := range rows {
+		totals = append(totals, valueobject.SalaryTotals{
+			Currency:  valueobject.Currency(row.Currency),
+			MinSalary: row.MinSalary,
+			MaxSalary: row.MaxSalary,
+			SumSalary: row.SumSalary,
+			Count:     row.Count,
+		})
+	}
+	return totals, nil
+}
+
+func (r *employeeRepository) GetDepartmentSalaryTotals(ctx context.Context, statuses []entity.EmploymentStatus) ([]repository.DepartmentSalaryTotals, error) {
+	var rows []struct {
+		DepartmentID *uuid.UUID
+		Currency     string
+		MinSalary    decimal.Decimal
+		MaxSalary    decimal.Decimal
+		SumSalary    decimal.Decimal
+		Count        int64
+	}
 
+	err := applyEmployeeFilter(dbWithContext(ctx, r.db).Model(&entity.Employee{}), repository.EmployeeFilter{Statuses: statuses}).
+		Select("department_id, currency, MIN(gross_salary) as min_salary, MAX(gross_salary) as max_salary, SUM(gross_salary) as sum_salary, COUNT(*) as count").
+		Group("department_id, currency").
+		Order("department_id, currency").
+		Scan(&rows).Error
 	if err != nil {
 		return nil, errors.NewInternalError(err)
 	}
 
-	if result.Count == 0 {
-		return nil, errors.NewNotFoundError("employees in country")
+	totals := make([]repository.DepartmentSalaryTotals, 0, len(rows))
+	for _, row := range rows {
+		totals = append(totals, repository.DepartmentSalaryTotals{
+			DepartmentID: row.DepartmentID,
+			SalaryTotals: valueobject.SalaryTotals{
+				Currency:  valueobject.Currency(row.Currency),
+				MinSalary: row.MinSalary,
+				MaxSalary: row.MaxSalary,
+				SumSalary: row.SumSalary,
+				Count:     row.Count,
+			},
+		})
 	}
+	return totals, nil
+}
 
-	return &valueobject.SalaryStats{
-		MinSalary: result.MinSalary,
-		MaxSalary: result.MaxSalary,
-		AvgSalary: result.AvgSalary.Round(2),
-		Count:     result.Count,
-	}, nil
+// GetSalaryDistribution reads the statistics and the histogram in one
+// repeatable read transaction so the bucket counts add up to the total.
+func (r *employeeRepository) GetSalaryDistribution(ctx context.Context, filter repository.EmployeeFilter, rates map[valueobject.Currency]decimal.Decimal, buckets int) (*valueobject.SalaryDistribution, error) {
+	var distribution *valueobject.SalaryDistribution
+	err := dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		salaries := convertedSalaries(tx, filter, rates)
+
+		var stats struct {
+			Count        int64
+			MinSalary    decimal.Decimal
+			MaxSalary    decimal.Decimal
+			AvgSalary    decimal.Decimal
+			MedianSalary decimal.Decimal
+			P10Salary    decimal.Decimal
+			P25Salary    decimal.Decimal
+			P75Salary    decimal.Decimal
+			P90Salary    decimal.Decimal
+			StdDev       decimal.Decimal
+		}
+		err := tx.Table("(?) AS s", salaries).
+			Select(`COUNT(*) AS count,
+				ROUND(MIN(salary), 2) AS min_salary,
+				ROUND(MAX(salary), 2) AS max_salary,
+				ROUND(AVG(salary), 2) AS avg_salary,
+				ROUND((percentile_cont(0.5) WITHIN GROUP (ORDER BY salary))::numeric, 2) AS median_salary,
+				ROUND((percentile_cont(0.1) WITHIN GROUP (ORDER BY salary))::numeric, 2) AS p10_salary,
+				ROUND((percentile_cont(0.25) WITHIN GROUP (ORDER BY salary))::numeric, 2) AS p25_salary,
+				ROUND((percentile_cont(0.75) WITHIN GROUP (ORDER BY salary))::numeric, 2) AS p75_salary,
+				ROUND((percentile_cont(0.9) WITHIN GROUP (ORDER BY salary))::numeric, 2) AS p90_salary,
+				ROUND(COALESCE(stddev_pop(salary), 0), 2) AS std_dev`).
+			Having("COUNT(*) > 0").
+			Scan(&stats).Error
+		if err != nil {
+			return errors.NewInternalError(err)
+		}
+		if stats.Count == 0 {
+			return errors.NewNotFoundError("employees matching the filter")
+		}
+
+		distribution = &valueobject.SalaryDistribution{
+			Count:        stats.Count,
+			MinSalary:    stats.MinSalary,
+			MaxSalary:    stats.MaxSalary,
+			AvgSalary:    stats.AvgSalary,
+			MedianSalary: stats.MedianSalary,
+			P10Salary:    stats.P10Salary,
+			P25Salary:    stats.P25Salary,
+			P75Salary:    stats.P75Salary,
+			P90Salary:    stats.P90Salary,
+			StdDev:       stats.StdDev,
+		}
+		distribution.Histogram, err = salaryHistogram(tx, salaries, stats.MinSalary, stats.MaxSalary, stats.Count, buckets)
+		return err
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	return distribution, nil
 }
 
-func (r *employeeRepository) GetAvgSalaryByJobTitle(ctx context.Context, jobTitle string) (*valueobject.JobTitleSalaryStats, error) {
-	var result struct {
-		AvgSalary decimal.Decimal
-		Count     int64
+// salaryDimensionColumns and salaryMetricColumns are the SQL expressions of
+// each dimension and metric over a subquery of employees with their
+// converted salary.
+var (
+	salaryDimensionColumns = map[repository.SalaryDimension]string{
+		repository.DimensionCountry:    "country",
+		repository.DimensionJobTitle:   "job_title",
+		repository.DimensionHireYear:   "EXTRACT(YEAR FROM hire_date)::int::text",
+		repository.DimensionCurrency:   "currency::text",
+		repository.DimensionDepartment: "COALESCE((SELECT name FROM departments WHERE id = department_id), '')",
+		repository.DimensionCostCenter: "COALESCE((SELECT code FROM cost_centers WHERE id = cost_center_id), '')",
 	}
+	salaryMetricColumns = map[repository.SalaryMetric]string{
+		repository.MetricCount:  "COUNT(*)",
+		repository.MetricSum:    "ROUND(SUM(salary), 2)",
+		repository.MetricMin:    "ROUND(MIN(salary), 2)",
+		repository.MetricMax:    "ROUND(MAX(salary), 2)",
+		repository.MetricAvg:    "ROUND(AVG(salary), 2)",
+		repository.MetricMedian: "ROUND((percentile_cont(0.5) WITHIN GROUP (ORDER BY salary))::numeric, 2)",
+		repository.MetricStdDev: "ROUND(COALESCE(stddev_pop(salary), 0), 2)",
+	}
+)
+
+func (r *employeeRepository) AggregateSalaries(ctx context.Context, aggregation repository.SalaryAggregation) ([]repository.SalaryGroup, error) {
+	db := dbWithContext(ctx, r.db)
+	salaries := applyEmployeeFilter(db.Model(&entity.Employee{}), aggregation.Filter).
+		Select("country, job_title, currency, hire_date, department_id, cost_center_id, gross_salary * ? AS salary", convertedSalary(aggregation.Rates))
+
+	columns := make([]string, 0, len(aggregation.Dimensions)+len(aggregation.Metrics))
+	groupBy := make([]string, 0, len(aggregation.Dimensions))
+	for i, dimension := range aggregation.Dimensions {
+		alias := fmt.Sprintf("d%d", i)
+		columns = append(columns, salaryDimensionColumns[dimension]+" AS "+alias)
+		groupBy = append(groupBy, alias)
+	}
+	for i, metric := range aggregation.Metrics {
+		columns = append(columns, fmt.Sprintf("%s AS m%d", salaryMetricColumns[metric], i))
+	}
+
+	rows, err := db.Table("(?) AS s", salaries).
+		Select(strings.Join(columns, ", ")).
+		Group(strings.Join(groupBy, ", ")).
+		Order(strings.Join(groupBy, ", ")).
+		Limit(aggregation.Limit).
+		Rows()
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	defer rows.Close()
 
-	err := r.db.WithContext(ctx).
+	var groups []repository.SalaryGroup
+	for rows.Next() {
+		group := repository.SalaryGroup{
+			Keys:   make([]string, len(aggregation.Dimensions)),
+			Values: make([]decimal.Decimal, len(aggregation.Metrics)),
+		}
+		dest := make([]interface{}, 0, len(columns))
+		for i := range group.Keys {
+			dest = append(dest, &group.Keys[i])
+		}
+		for i := range group.Values {
+			dest = append(dest, &group.Values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, errors.NewInternalError(err)
+		}
+		groups = append(groups, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return groups, nil
+}
+
+// convertedSalaries selects the salary of every employee matching filter,
+// multiplied by the rate of its currency. Employees paid in a currency
+// without a rate are left out rather than counted as NULL salaries.
+func convertedSalaries(tx *gorm.DB, filter repository.EmployeeFilter, rates map[valueobject.Currency]decimal.Decimal) *gorm.DB {
+	converted := applyEmployeeFilter(tx.Model(&entity.Employee{}), filter).
+		Select("gross_salary * ? AS salary", convertedSalary(rates))
+	return tx.Table("(?) AS c", converted).Select("salary").Where("salary IS NOT NULL")
+}
+
+// convertedSalary returns the rate of each row's currency as a CASE
+// expression, NULL for currencies without a rate.
+func convertedSalary(rates map[valueobject.Currency]decimal.Decimal) clause.Expr {
+	currencies := make([]valueobject.Currency, 0, len(rates))
+	for currency := range rates {
+		currencies = append(currencies, currency)
+	}
+	slices.Sort(currencies)
+
+	var query strings.Builder
+	args := make([]interface{}, 0, 2*len(rates))
+	query.WriteString("CASE currency")
+	for _, currency := range currencies {
+		query.WriteString(" WHEN ? THEN ?::numeric")
+		args = append(args, currency.String(), rates[currency])
+	}
+	query.WriteString(" ELSE NULL END")
+	return gorm.Expr(query.String(), args...)
+}
+
+// salaryHistogram splits [minSalary, maxSalary] into equal-width buckets and
+// counts the salaries in each. Salaries are bucketed by their rounded value,
+// so that they fall within the rounded bounds that are reported.
+func salaryHistogram(tx *gorm.DB, salaries *gorm.DB, minSalary, maxSalary decimal.Decimal, count int64, buckets int) ([]valueobject.SalaryBucket, error) {
+	if minSalary.Equal(maxSalary) {
+		return []valueobject.SalaryBucket{{LowerBound: minSalary, UpperBound: maxSalary, Count: count}}, nil
+	}
+
+	var rows []struct {
+		Bucket int
+		Count  int64
+	}
+	err := tx.Table("(?) AS s", salaries).
+		Select("LEAST(width_bucket(ROUND(salary, 2), ?, ?, ?), ?) AS bucket, COUNT(*) AS count", minSalary, maxSalary, buckets, buckets).
+		Group("bucket").
+		Order("bucket").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	width := maxSalary.Sub(minSalary).Div(decimal.NewFromInt(int64(buckets)))
+	histogram := make([]valueobject.SalaryBucket, buckets)
+	for i := range histogram {
+		histogram[i].LowerBound = minSalary.Add(width.Mul(decimal.NewFromInt(int64(i)))).Round(2)
+		histogram[i].UpperBound = minSalary.Add(width.Mul(decimal.NewFromInt(int64(i + 1)))).Round(2)
+	}
+	histogram[buckets-1].UpperBound = maxSalary
+	for _, row := range rows {
+		histogram[row.Bucket-1].Count = row.Count
+	}
+	return histogram, nil
+}
+
+func (r *employeeRepository) CountByCountry(ctx context.Context) (map[string]int64, error) {
+	var rows []struct {
+		Country string
+		Count   int64
+	}
+
+	err := dbWithContext(ctx, r.db).
 		Model(&entity.Employee{}).
-		Select("AVG(gross_salary) as avg_salary, COUNT(*) as count").
-		Where("job_title = ?", jobTitle).
-		Scan(&result).Error
+		Select("country, COUNT(*) as count").
+		Group("country").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Country] = row.Count
+	}
+	return counts, nil
+}
+
+// orgNodeRow is an employee read from a recursive query with its depth.
+type orgNodeRow struct {
+	entity.Employee
+	Depth int
+}
+
+func (r *employeeRepository) ListOrgTree(ctx context.Context, root *uuid.UUID, maxDepth, limit int) ([]repository.OrgNode, error) {
+	db := dbWithContext(ctx, r.db)
+
+	start := "e.manager_id IS NULL"
+	args := []interface{}{sql.Named("max_depth", maxDepth), sql.Named("limit", limit)}
+	if root != nil {
+		start = "e.id = @root"
+		args = append(args, sql.Named("root", *root))
+	}
 
+	var rows []orgNodeRow
+	err := db.Raw(`
+		WITH RECURSIVE tree AS (
+			SELECT e.*, 0 AS depth FROM employees e
+			WHERE e.deleted_at IS NULL AND `+start+`
+			UNION ALL
+			SELECT e.*, t.depth + 1 FROM employees e
+			JOIN tree t ON e.manager_id = t.id
+			WHERE e.deleted_at IS NULL AND (@max_depth = 0 OR t.depth < @max_depth)
+		)
+		SELECT * FROM tree ORDER BY depth, full_name, id LIMIT @limit`, args...).
+		Scan(&rows).Error
 	if err != nil {
 		return nil, errors.NewInternalError(err)
 	}
+	if root != nil && len(rows) == 0 {
+		return nil, errors.NewNotFoundError("employee")
+	}
 
-	if result.Count == 0 {
-		return nil, errors.NewNotFoundError("employees with job title")
+	nodes := make([]repository.OrgNode, 0, len(rows))
+	for i := range rows {
+		nodes = append(nodes, repository.OrgNode{Employee: &rows[i].Employee, Depth: rows[i].Depth})
 	}
+	return nodes, nil
+}
 
-	return &valueobject.JobTitleSalaryStats{
-		JobTitle:  jobTitle,
-		AvgSalary: result.AvgSalary.Round(2),
-		Count:     result.Count,
-	}, nil
+func (r *employeeRepository) GetManagementChain(ctx context.Context, id uuid.UUID) ([]*entity.Employee, error) {
+	db := dbWithContext(ctx, r.db)
+
+	var rows []orgNodeRow
+	err := db.Raw(`
+		WITH RECURSIVE chain AS (
+			SELECT m.*, 1 AS depth FROM employees m
+			JOIN employees e ON e.manager_id = m.id
+			WHERE e.id = ? AND e.deleted_at IS NULL AND m.deleted_at IS NULL
+			UNION ALL
+			SELECT m.*, c.depth + 1 FROM employees m
+			JOIN chain c ON m.id = c.manager_id
+			WHERE m.deleted_at IS NULL
+		)
+		SELECT * FROM chain ORDER BY depth`, id).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	if len(rows) == 0 {
+		// Tell an employee at the top of the organisation from a missing one.
+		if _, err := r.FindByID(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	chain := make([]*entity.Employee, 0, len(rows))
+	for i := range rows {
+		chain = append(chain, &rows[i].Employee)
+	}
+	return chain, nil
 }
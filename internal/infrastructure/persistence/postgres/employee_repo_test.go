@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB builds statements without connecting to a database.
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+	return db
+}
+
+func TestConvertedSalaries(t *testing.T) {
+	db := dryRunDB(t)
+
+	t.Run("leaves out currencies without a rate", func(t *testing.T) {
+		rates := map[valueobject.Currency]decimal.Decimal{
+			valueobject.CurrencyUSD: decimal.NewFromInt(1),
+			valueobject.CurrencyINR: decimal.RequireFromString("0.012"),
+		}
+
+		stmt := convertedSalaries(db, repository.EmployeeFilter{Country: "India"}, rates).Find(&[]decimal.Decimal{}).Statement
+		query := db.Dialector.Explain(stmt.SQL.String(), stmt.Vars...)
+
+		assert.Contains(t, query, "CASE currency WHEN 'INR' THEN '0.012'::numeric WHEN 'USD' THEN '1'::numeric ELSE NULL END")
+		assert.Contains(t, query, "country = 'India'")
+		assert.Contains(t, query, "WHERE salary IS NOT NULL")
+	})
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type exchangeRateRepository struct {
+	db *gorm.DB
+}
+
+func NewExchangeRateRepository(db *gorm.DB) repository.ExchangeRateRepository {
+	return &exchangeRateRepository{db: db}
+}
+
+func (r *exchangeRateRepository) Upsert(ctx context.Context, rates []*entity.ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+	err := dbWithContext(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}, {Name: "valid_from"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+		}).
+		Create(&rates).Error
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *exchangeRateRepository) FindEffective(ctx context.Context, base, quote string, asOf time.Time) (*entity.ExchangeRate, error) {
+	var rate entity.ExchangeRate
+	err := dbWithContext(ctx, r.db).
+		Where("base_currency = ? AND quote_currency = ? AND valid_from <= ?", base, quote, asOf.UTC().Format(time.DateOnly)).
+		Order("valid_from DESC").
+		First(&rate).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("exchange rate from " + base + " to " + quote)
+		}
+		return nil, errors.NewInternalError(err)
+	}
+	return &rate, nil
+}
+
+func (r *exchangeRateRepository) List(ctx context.Context, base string) ([]*entity.ExchangeRate, error) {
+	query := dbWithContext(ctx, r.db).Order("base_currency, quote_currency, valid_from")
+	if base != "" {
+		query = query.Where("base_currency = ?", base)
+	}
+
+	var rates []*entity.ExchangeRate
+	if err := query.Find(&rates).Error; err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return rates, nil
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS revoked_tokens;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS tax_rules;
+DROP TABLE IF EXISTS employees;
+DROP TABLE IF EXISTS users;
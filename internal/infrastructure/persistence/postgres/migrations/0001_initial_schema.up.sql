@@ -0,0 +1,66 @@
+-- Baseline schema, matching what GORM AutoMigrate created before versioned
+-- migrations were introduced. IF NOT EXISTS lets existing databases adopt it.
+
+CREATE TABLE IF NOT EXISTS users (
+    id            uuid         PRIMARY KEY DEFAULT gen_random_uuid(),
+    email         varchar(255) NOT NULL,
+    password_hash varchar(255) NOT NULL,
+    role          varchar(20)  NOT NULL DEFAULT 'viewer',
+    created_at    timestamptz,
+    updated_at    timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+CREATE INDEX IF NOT EXISTS idx_users_role ON users (role);
+
+CREATE TABLE IF NOT EXISTS employees (
+    id           uuid          PRIMARY KEY DEFAULT gen_random_uuid(),
+    full_name    varchar(255)  NOT NULL,
+    job_title    varchar(100)  NOT NULL,
+    country      varchar(100)  NOT NULL,
+    gross_salary decimal(15,2) NOT NULL,
+    created_at   timestamptz,
+    updated_at   timestamptz,
+    deleted_at   timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_employees_job_title ON employees (job_title);
+CREATE INDEX IF NOT EXISTS idx_employees_country ON employees (country);
+CREATE INDEX IF NOT EXISTS idx_employees_gross_salary ON employees (gross_salary);
+CREATE INDEX IF NOT EXISTS idx_employees_created_at ON employees (created_at);
+CREATE INDEX IF NOT EXISTS idx_employees_updated_at ON employees (updated_at);
+CREATE INDEX IF NOT EXISTS idx_employees_deleted_at ON employees (deleted_at);
+
+CREATE TABLE IF NOT EXISTS tax_rules (
+    id                 uuid          PRIMARY KEY DEFAULT gen_random_uuid(),
+    country            varchar(100)  NOT NULL,
+    rule_type          varchar(20)   NOT NULL,
+    rate               decimal(7,6)  NOT NULL DEFAULT 0,
+    bands              jsonb         NOT NULL DEFAULT '[]',
+    standard_deduction decimal(15,2) NOT NULL DEFAULT 0,
+    max_tax            decimal(15,2),
+    effective_from     timestamptz   NOT NULL,
+    effective_to       timestamptz,
+    created_at         timestamptz,
+    updated_at         timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_tax_rules_country_effective_from ON tax_rules (country, effective_from);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id             uuid        PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id        uuid        NOT NULL,
+    family_id      uuid        NOT NULL,
+    token_hash     varchar(64) NOT NULL,
+    expires_at     timestamptz NOT NULL,
+    revoked_at     timestamptz,
+    replaced_by_id uuid,
+    created_at     timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refresh_tokens_token_hash ON refresh_tokens (token_hash);
+
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti        varchar(36) PRIMARY KEY,
+    expires_at timestamptz NOT NULL,
+    revoked_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
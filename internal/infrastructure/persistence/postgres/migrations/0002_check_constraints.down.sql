@@ -0,0 +1,6 @@
+ALTER TABLE refresh_tokens DROP CONSTRAINT IF EXISTS refresh_tokens_user_id_fkey;
+ALTER TABLE tax_rules DROP CONSTRAINT IF EXISTS tax_rules_effective_range_check;
+ALTER TABLE tax_rules DROP CONSTRAINT IF EXISTS tax_rules_rate_check;
+ALTER TABLE tax_rules DROP CONSTRAINT IF EXISTS tax_rules_rule_type_check;
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS employees_gross_salary_check;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
@@ -0,0 +1,27 @@
+-- Constraints mirroring the validation in the use case layer, so rows written
+-- outside the API cannot break it. Dropped first so the migration can be
+-- re-applied after a rollback.
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_check
+    CHECK (role IN ('admin', 'hr', 'manager', 'viewer'));
+
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS employees_gross_salary_check;
+ALTER TABLE employees ADD CONSTRAINT employees_gross_salary_check
+    CHECK (gross_salary >= 0);
+
+ALTER TABLE tax_rules DROP CONSTRAINT IF EXISTS tax_rules_rule_type_check;
+ALTER TABLE tax_rules ADD CONSTRAINT tax_rules_rule_type_check
+    CHECK (rule_type IN ('flat', 'progressive'));
+
+ALTER TABLE tax_rules DROP CONSTRAINT IF EXISTS tax_rules_rate_check;
+ALTER TABLE tax_rules ADD CONSTRAINT tax_rules_rate_check
+    CHECK (rate >= 0 AND rate <= 1);
+
+ALTER TABLE tax_rules DROP CONSTRAINT IF EXISTS tax_rules_effective_range_check;
+ALTER TABLE tax_rules ADD CONSTRAINT tax_rules_effective_range_check
+    CHECK (effective_to IS NULL OR effective_to >= effective_from);
+
+ALTER TABLE refresh_tokens DROP CONSTRAINT IF EXISTS refresh_tokens_user_id_fkey;
+ALTER TABLE refresh_tokens ADD CONSTRAINT refresh_tokens_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS employee_audit_log;
+DROP FUNCTION IF EXISTS employee_audit_log_immutable();
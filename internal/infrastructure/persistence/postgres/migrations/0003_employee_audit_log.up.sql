@@ -0,0 +1,21 @@
+CREATE TABLE employee_audit_log (
+    id          uuid        PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id uuid        NOT NULL,
+    action      varchar(20) NOT NULL CHECK (action IN ('created', 'updated', 'deleted')),
+    actor_id    uuid,
+    changes     jsonb       NOT NULL DEFAULT '[]',
+    occurred_at timestamptz NOT NULL
+);
+CREATE INDEX idx_employee_audit_log_employee_occurred ON employee_audit_log (employee_id, occurred_at);
+
+-- The audit log is append-only. Entries outlive the employee they describe,
+-- so there is deliberately no foreign key to employees.
+CREATE FUNCTION employee_audit_log_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'employee_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER employee_audit_log_immutable
+    BEFORE UPDATE OR DELETE ON employee_audit_log
+    FOR EACH ROW EXECUTE FUNCTION employee_audit_log_immutable();
@@ -0,0 +1,2 @@
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS employees_version_check;
+ALTER TABLE employees DROP COLUMN IF EXISTS version;
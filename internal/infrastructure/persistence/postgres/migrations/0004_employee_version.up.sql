@@ -0,0 +1,7 @@
+-- Row version for optimistic concurrency control. Every update must name the
+-- version it read and increments it.
+ALTER TABLE employees ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS employees_version_check;
+ALTER TABLE employees ADD CONSTRAINT employees_version_check
+    CHECK (version >= 1);
@@ -0,0 +1,5 @@
+-- The audit log is append-only, so entries for the new actions cannot be
+-- removed; the narrower constraint only applies to new rows.
+ALTER TABLE employee_audit_log DROP CONSTRAINT IF EXISTS employee_audit_log_action_check;
+ALTER TABLE employee_audit_log ADD CONSTRAINT employee_audit_log_action_check
+    CHECK (action IN ('created', 'updated', 'deleted')) NOT VALID;
@@ -0,0 +1,4 @@
+-- Restores and purges of soft-deleted employees are audited too.
+ALTER TABLE employee_audit_log DROP CONSTRAINT IF EXISTS employee_audit_log_action_check;
+ALTER TABLE employee_audit_log ADD CONSTRAINT employee_audit_log_action_check
+    CHECK (action IN ('created', 'updated', 'deleted', 'restored', 'purged'));
@@ -0,0 +1,21 @@
+-- Effective-dated compensation. Each employee's records form a timeline;
+-- employees.gross_salary remains the salary of the record in force now.
+CREATE TABLE compensation_records (
+    id             uuid          PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id    uuid          NOT NULL REFERENCES employees (id) ON DELETE CASCADE,
+    gross_salary   decimal(15,2) NOT NULL CHECK (gross_salary >= 0),
+    reason         varchar(20)   NOT NULL CHECK (reason IN ('hire', 'promotion', 'merit', 'adjustment')),
+    approver_id    uuid,
+    effective_from timestamptz   NOT NULL,
+    effective_to   timestamptz,
+    created_at     timestamptz   NOT NULL DEFAULT now(),
+    CONSTRAINT compensation_records_effective_range_check
+        CHECK (effective_to IS NULL OR effective_to > effective_from)
+);
+CREATE UNIQUE INDEX idx_compensation_records_employee_effective_from
+    ON compensation_records (employee_id, effective_from);
+
+-- Existing employees start their timeline with their current salary.
+INSERT INTO compensation_records (employee_id, gross_salary, reason, effective_from)
+SELECT id, gross_salary, 'hire', created_at
+FROM employees;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS exchange_rates;
+ALTER TABLE compensation_records DROP COLUMN IF EXISTS currency;
+ALTER TABLE employees DROP COLUMN IF EXISTS currency;
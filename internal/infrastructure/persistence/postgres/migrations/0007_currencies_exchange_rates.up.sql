@@ -0,0 +1,26 @@
+-- Salaries carry the ISO 4217 currency they are paid in. Existing rows take
+-- the currency of their country.
+ALTER TABLE employees ADD COLUMN currency char(3);
+UPDATE employees SET currency = CASE WHEN country = 'India' THEN 'INR' ELSE 'USD' END;
+ALTER TABLE employees ALTER COLUMN currency SET NOT NULL;
+
+ALTER TABLE compensation_records ADD COLUMN currency char(3);
+UPDATE compensation_records c SET currency = e.currency
+FROM employees e
+WHERE e.id = c.employee_id;
+ALTER TABLE compensation_records ALTER COLUMN currency SET NOT NULL;
+
+-- One unit of base_currency buys rate units of quote_currency from valid_from
+-- until the next rate for the pair.
+CREATE TABLE exchange_rates (
+    id             uuid           PRIMARY KEY DEFAULT gen_random_uuid(),
+    base_currency  char(3)        NOT NULL,
+    quote_currency char(3)        NOT NULL,
+    rate           decimal(20,10) NOT NULL CHECK (rate > 0),
+    valid_from     date           NOT NULL,
+    created_at     timestamptz    NOT NULL DEFAULT now(),
+    updated_at     timestamptz    NOT NULL DEFAULT now(),
+    CONSTRAINT exchange_rates_pair_check CHECK (base_currency <> quote_currency)
+);
+CREATE UNIQUE INDEX idx_exchange_rates_pair_valid_from
+    ON exchange_rates (base_currency, quote_currency, valid_from);
@@ -0,0 +1,8 @@
+-- Employees may report to a manager. Reports of a purged manager are left
+-- without one; the API refuses to delete a manager who still has reports.
+ALTER TABLE employees ADD COLUMN manager_id uuid;
+ALTER TABLE employees ADD CONSTRAINT employees_manager_id_fkey
+    FOREIGN KEY (manager_id) REFERENCES employees (id) ON DELETE SET NULL;
+ALTER TABLE employees ADD CONSTRAINT employees_manager_id_check
+    CHECK (manager_id <> id);
+CREATE INDEX idx_employees_manager_id ON employees (manager_id);
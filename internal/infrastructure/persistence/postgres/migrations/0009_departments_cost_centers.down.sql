@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_employees_cost_center_id;
+DROP INDEX IF EXISTS idx_employees_department_id;
+ALTER TABLE employees DROP COLUMN IF EXISTS cost_center_id;
+ALTER TABLE employees DROP COLUMN IF EXISTS department_id;
+DROP TABLE IF EXISTS cost_centers;
+DROP TABLE IF EXISTS departments;
@@ -0,0 +1,30 @@
+-- Departments nest within a parent department; departments without a parent
+-- are divisions.
+CREATE TABLE departments (
+    id         uuid         PRIMARY KEY DEFAULT gen_random_uuid(),
+    name       varchar(100) NOT NULL,
+    parent_id  uuid         REFERENCES departments (id),
+    created_at timestamptz  NOT NULL DEFAULT now(),
+    updated_at timestamptz  NOT NULL DEFAULT now(),
+    CONSTRAINT departments_parent_id_check CHECK (parent_id <> id)
+);
+CREATE UNIQUE INDEX idx_departments_name ON departments (lower(name));
+CREATE INDEX idx_departments_parent_id ON departments (parent_id);
+
+CREATE TABLE cost_centers (
+    id         uuid         PRIMARY KEY DEFAULT gen_random_uuid(),
+    code       varchar(20)  NOT NULL,
+    name       varchar(100) NOT NULL,
+    created_at timestamptz  NOT NULL DEFAULT now(),
+    updated_at timestamptz  NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX idx_cost_centers_code ON cost_centers (upper(code));
+
+-- The API refuses to delete a department or cost center with employees;
+-- deleted employees are left without one.
+ALTER TABLE employees ADD COLUMN department_id uuid
+    REFERENCES departments (id) ON DELETE SET NULL;
+ALTER TABLE employees ADD COLUMN cost_center_id uuid
+    REFERENCES cost_centers (id) ON DELETE SET NULL;
+CREATE INDEX idx_employees_department_id ON employees (department_id);
+CREATE INDEX idx_employees_cost_center_id ON employees (cost_center_id);
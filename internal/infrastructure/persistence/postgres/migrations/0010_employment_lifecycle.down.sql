@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_employees_status;
+ALTER TABLE employees DROP COLUMN IF EXISTS last_working_day;
+ALTER TABLE employees DROP COLUMN IF EXISTS termination_reason;
+ALTER TABLE employees DROP COLUMN IF EXISTS status;
+ALTER TABLE employees DROP COLUMN IF EXISTS employment_type;
+ALTER TABLE employees DROP COLUMN IF EXISTS hire_date;
@@ -0,0 +1,20 @@
+-- Employment lifecycle. Existing employees are active full-time staff hired
+-- on the day their record was created; their hire date can be corrected.
+ALTER TABLE employees ADD COLUMN hire_date date;
+UPDATE employees SET hire_date = (created_at AT TIME ZONE 'UTC')::date;
+ALTER TABLE employees ALTER COLUMN hire_date SET NOT NULL;
+
+ALTER TABLE employees ADD COLUMN employment_type varchar(20) NOT NULL DEFAULT 'full_time';
+ALTER TABLE employees ADD CONSTRAINT employees_employment_type_check
+    CHECK (employment_type IN ('full_time', 'part_time', 'contractor'));
+
+ALTER TABLE employees ADD COLUMN status varchar(20) NOT NULL DEFAULT 'active';
+ALTER TABLE employees ADD CONSTRAINT employees_status_check
+    CHECK (status IN ('pending', 'active', 'on_leave', 'terminated'));
+CREATE INDEX idx_employees_status ON employees (status);
+
+-- Only terminated employees have a termination reason and last working day.
+ALTER TABLE employees ADD COLUMN termination_reason varchar(20) NOT NULL DEFAULT '';
+ALTER TABLE employees ADD COLUMN last_working_day date;
+ALTER TABLE employees ADD CONSTRAINT employees_termination_check
+    CHECK ((status = 'terminated') = (termination_reason <> '' AND last_working_day IS NOT NULL));
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_employees_job_title_trgm;
+DROP INDEX IF EXISTS idx_employees_full_name_trgm;
+DROP INDEX IF EXISTS idx_employees_search;
+DROP EXTENSION IF EXISTS pg_trgm;
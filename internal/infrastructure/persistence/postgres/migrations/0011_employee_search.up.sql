@@ -0,0 +1,8 @@
+-- Employees are searched by full name and job title, by word prefix with
+-- full-text search and by trigram similarity for misspellings. The text
+-- search expression must match the one in the employee repository.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX idx_employees_search ON employees
+    USING gin (to_tsvector('simple', full_name || ' ' || job_title));
+CREATE INDEX idx_employees_full_name_trgm ON employees USING gin (full_name gin_trgm_ops);
+CREATE INDEX idx_employees_job_title_trgm ON employees USING gin (job_title gin_trgm_ops);
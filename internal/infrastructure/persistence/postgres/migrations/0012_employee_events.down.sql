@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS employee_events;
+DROP FUNCTION IF EXISTS employee_events_immutable();
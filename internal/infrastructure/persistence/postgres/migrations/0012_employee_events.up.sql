@@ -0,0 +1,24 @@
+-- Committed employee changes, streamed to watchers in sequence order. Like
+-- the audit log, events outlive the employee they describe and are
+-- append-only.
+CREATE TABLE employee_events (
+    sequence    bigserial    PRIMARY KEY,
+    type        varchar(20)  NOT NULL CHECK (type IN ('created', 'updated', 'deleted')),
+    employee_id uuid         NOT NULL,
+    country     varchar(100) NOT NULL,
+    employee    jsonb        NOT NULL,
+    actor_id    uuid,
+    changes     jsonb        NOT NULL DEFAULT '[]',
+    occurred_at timestamptz  NOT NULL
+);
+CREATE INDEX idx_employee_events_country_sequence ON employee_events (country, sequence);
+
+CREATE FUNCTION employee_events_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'employee_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER employee_events_immutable
+    BEFORE UPDATE OR DELETE ON employee_events
+    FOR EACH ROW EXECUTE FUNCTION employee_events_immutable();
@@ -0,0 +1,2 @@
+DELETE FROM tax_rules
+WHERE id IN ('5d1f0e42-6a57-4c1b-9a0e-7f3c2b8d1001', '5d1f0e42-6a57-4c1b-9a0e-7f3c2b8d1002');
@@ -0,0 +1,18 @@
+-- The tax schedules previously built into the binary, as catalog rules in
+-- force since the epoch so every past calculation keeps resolving to them.
+-- A seeded rule ends where a rule already in the catalog for its country
+-- begins.
+INSERT INTO tax_rules (id, country, rule_type, bands, standard_deduction, effective_from, effective_to, created_at, updated_at)
+SELECT seed.id, seed.country, 'progressive', seed.bands::jsonb, seed.standard_deduction, '1970-01-01 00:00:00+00',
+       (SELECT min(r.effective_from) FROM tax_rules r WHERE r.country = seed.country), now(), now()
+FROM (VALUES
+    -- India, new tax regime (FY 2024-25).
+    ('5d1f0e42-6a57-4c1b-9a0e-7f3c2b8d1001'::uuid, 'India', 75000,
+     '[{"up_to":"300000","rate":"0"},{"up_to":"700000","rate":"0.05"},{"up_to":"1000000","rate":"0.1"},{"up_to":"1200000","rate":"0.15"},{"up_to":"1500000","rate":"0.2"},{"rate":"0.3"}]'),
+    -- United States federal income tax, single filer (2024).
+    ('5d1f0e42-6a57-4c1b-9a0e-7f3c2b8d1002'::uuid, 'United States', 14600,
+     '[{"up_to":"11600","rate":"0.1"},{"up_to":"47150","rate":"0.12"},{"up_to":"100525","rate":"0.22"},{"up_to":"191950","rate":"0.24"},{"up_to":"243725","rate":"0.32"},{"up_to":"609350","rate":"0.35"},{"rate":"0.37"}]')
+) AS seed (id, country, standard_deduction, bands)
+WHERE NOT EXISTS (
+    SELECT 1 FROM tax_rules r WHERE r.country = seed.country AND r.effective_from <= '1970-01-01 00:00:00+00'
+);
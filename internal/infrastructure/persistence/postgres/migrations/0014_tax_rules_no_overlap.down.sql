@@ -0,0 +1,2 @@
+ALTER TABLE tax_rules DROP CONSTRAINT IF EXISTS tax_rules_no_overlap;
+DROP EXTENSION IF EXISTS btree_gist;
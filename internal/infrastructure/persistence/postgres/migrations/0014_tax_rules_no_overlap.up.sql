@@ -0,0 +1,6 @@
+-- A country has at most one tax rule in force at any instant, even for rows
+-- written outside the API. btree_gist provides the equality operator class
+-- the exclusion constraint needs for country.
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+ALTER TABLE tax_rules ADD CONSTRAINT tax_rules_no_overlap
+    EXCLUDE USING gist (country WITH =, tstzrange(effective_from, effective_to) WITH &&);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_employee_events_xact_sequence;
+ALTER TABLE employee_events DROP COLUMN IF EXISTS xact_id;
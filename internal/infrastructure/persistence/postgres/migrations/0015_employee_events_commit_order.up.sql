@@ -0,0 +1,5 @@
+-- Record the transaction that appended each event. Watchers only read events
+-- of transactions older than every running one, so events can be appended
+-- concurrently and still never appear behind a watcher's position.
+ALTER TABLE employee_events ADD COLUMN xact_id xid8 NOT NULL DEFAULT pg_current_xact_id();
+CREATE INDEX idx_employee_events_xact_sequence ON employee_events (xact_id, sequence);
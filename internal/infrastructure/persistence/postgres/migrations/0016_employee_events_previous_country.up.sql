@@ -0,0 +1,5 @@
+-- The country an update moved the employee out of, so watchers filtering on
+-- it see the employee leave. Empty when the country did not change.
+ALTER TABLE employee_events ADD COLUMN previous_country varchar(100) NOT NULL DEFAULT '';
+CREATE INDEX idx_employee_events_previous_country_sequence ON employee_events (previous_country, sequence)
+    WHERE previous_country <> '';
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// taxRuleLock namespaces the per-country advisory locks held by
+// transactions that add tax rules, so two rules for a country cannot be
+// checked against the catalog at the same time and then overlap.
+const taxRuleLock = 0x746178
+
+type taxRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewTaxRuleRepository(db *gorm.DB) repository.TaxRuleRepository {
+	return &taxRuleRepository{db: db}
+}
+
+func (r *taxRuleRepository) Supersede(ctx context.Context, rule *entity.TaxRule) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := checkTaxRule(tx, rule); err != nil {
+			return err
+		}
+		err := tx.Model(&entity.TaxRule{}).
+			Where("country = ? AND effective_to IS NULL AND effective_from < ?", rule.Country, rule.EffectiveFrom).
+			Update("effective_to", rule.EffectiveFrom).Error
+		if err != nil {
+			return errors.NewInternalError(err)
+		}
+		if err := tx.Create(rule).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		return nil
+	})
+}
+
+// checkTaxRule takes the country's tax rule lock and verifies that rule
+// starts after every rule of the country and that no retired rule ends
+// after it starts.
+func checkTaxRule(tx *gorm.DB, rule *entity.TaxRule) error {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?, hashtext(?))", taxRuleLock, rule.Country).Error; err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	var check struct {
+		StartsLater bool
+		Overlaps    bool
+	}
+	err := tx.Raw(`
+		SELECT
+			EXISTS (SELECT 1 FROM tax_rules WHERE country = @country AND effective_from >= @from) AS starts_later,
+			EXISTS (SELECT 1 FROM tax_rules WHERE country = @country AND effective_to > @from) AS overlaps`,
+		sql.Named("country", rule.Country), sql.Named("from", rule.EffectiveFrom)).
+		Scan(&check).Error
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if check.StartsLater {
+		return errors.NewConflictError("a tax rule for this country already takes effect on or after effective_from")
+	}
+	if check.Overlaps {
+		return errors.NewConflictError("effective_from overlaps an existing tax rule")
+	}
+	return nil
+}
+
+func (r *taxRuleRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.TaxRule, error) {
+	var rule entity.TaxRule
+	if err := dbWithContext(ctx, r.db).First(&rule, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("tax rule")
+		}
+		return nil, errors.NewInternalError(err)
+	}
+	return &rule, nil
+}
+
+func (r *taxRuleRepository) FindEffective(ctx context.Context, country string, asOf time.Time) (*entity.TaxRule, error) {
+	var rule entity.TaxRule
+	err := dbWithContext(ctx, r.db).
+		Where("country = ? AND effective_from <= ? AND (effective_to IS NULL OR effective_to > ?)", country, asOf, asOf).
+		Order("effective_from DESC").
+		First(&rule).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("tax rule")
+		}
+		return nil, errors.NewInternalError(err)
+	}
+	return &rule, nil
+}
+
+func (r *taxRuleRepository) ListByCountry(ctx context.Context, country string) ([]*entity.TaxRule, error) {
+	query := dbWithContext(ctx, r.db).Order("country, effective_from")
+	if country != "" {
+		query = query.Where("country = ?", country)
+	}
+
+	var rules []*entity.TaxRule
+	if err := query.Find(&rules).Error; err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return rules, nil
+}
+
+func (r *taxRuleRepository) Update(ctx context.Context, rule *entity.TaxRule) error {
+	result := dbWithContext(ctx, r.db).Save(rule)
+	if result.Error != nil {
+		return errors.NewInternalError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.NewNotFoundError("tax rule")
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type refreshTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewRefreshTokenRepository(db *gorm.DB) repository.RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+func (r *refreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
+	if err := dbWithContext(ctx, r.db).Create(token).Error; err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *refreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	var token entity.RefreshToken
+	if err := dbWithContext(ctx, r.db).First(&token, "token_hash = ?", tokenHash).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("refresh token")
+		}
+		return nil, errors.NewInternalError(err)
+	}
+	return &token, nil
+}
+
+func (r *refreshTokenRepository) Rotate(ctx context.Context, oldID uuid.UUID, next *entity.RefreshToken) error {
+	err := dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", oldID).
+			Updates(map[string]interface{}{
+				"revoked_at":     time.Now(),
+				"replaced_by_id": next.ID,
+			})
+		if result.Error != nil {
+			return errors.NewInternalError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.NewConflictError("refresh token already used")
+		}
+		if err := tx.Create(next).Error; err != nil {
+			return errors.NewInternalError(err)
+		}
+		return nil
+	})
+	return err
+}
+
+func (r *refreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	err := dbWithContext(ctx, r.db).Model(&entity.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
+
+type revokedTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewRevokedTokenRepository(db *gorm.DB) repository.RevokedTokenRepository {
+	return &revokedTokenRepository{db: db}
+}
+
+func (r *revokedTokenRepository) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	err := dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Entries are useless once the token has expired anyway.
+		if err := tx.Where("expires_at < ?", time.Now()).Delete(&entity.RevokedToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&entity.RevokedToken{JTI: jti, ExpiresAt: expiresAt}).Error
+	})
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *revokedTokenRepository) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	var count int64
+	if err := dbWithContext(ctx, r.db).Model(&entity.RevokedToken{}).Where("jti = ?", jti).Count(&count).Error; err != nil {
+		return false, errors.NewInternalError(err)
+	}
+	return count > 0, nil
+}
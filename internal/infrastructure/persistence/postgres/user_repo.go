@@ -19,7 +19,7 @@ func NewUserRepository(db *gorm.DB) repository.UserRepository {
 }
 
 func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
-	if err := r.db.WithContext(ctx).Create(user).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Create(user).Error; err != nil {
 		return errors.NewInternalError(err)
 	}
 	return nil
@@ -27,7 +27,7 @@ func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
 
 func (r *userRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	var user entity.User
-	if err := r.db.WithContext(ctx).First(&user, "id = ?", id).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).First(&user, "id = ?", id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, errors.NewNotFoundError("user")
 		}
@@ -38,7 +38,7 @@ func (r *userRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Us
 
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var user entity.User
-	if err := r.db.WithContext(ctx).First(&user, "email = ?", email).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).First(&user, "email = ?", email).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, errors.NewNotFoundError("user")
 		}
@@ -49,8 +49,27 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*entity
 
 func (r *userRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	var count int64
-	if err := r.db.WithContext(ctx).Model(&entity.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Model(&entity.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
 		return false, errors.NewInternalError(err)
 	}
 	return count > 0, nil
 }
+
+func (r *userRepository) CountByRole(ctx context.Context, role entity.Role) (int64, error) {
+	var count int64
+	if err := dbWithContext(ctx, r.db).Model(&entity.User{}).Where("role = ?", role).Count(&count).Error; err != nil {
+		return 0, errors.NewInternalError(err)
+	}
+	return count, nil
+}
+
+func (r *userRepository) UpdateRole(ctx context.Context, id uuid.UUID, role entity.Role) error {
+	result := dbWithContext(ctx, r.db).Model(&entity.User{}).Where("id = ?", id).Update("role", role)
+	if result.Error != nil {
+		return errors.NewInternalError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.NewNotFoundError("user")
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+// Package authctx holds the request context keys set by the authentication
+// interceptor, so that use cases can identify the caller without depending
+// on the transport layer.
+package authctx
+
+import "context"
+
+type contextKey string
+
+const (
+	UserIDKey         contextKey = "user_id"
+	EmailKey          contextKey = "email"
+	RoleKey           contextKey = "role"
+	TokenIDKey        contextKey = "token_id"
+	TokenExpiresAtKey contextKey = "token_expires_at"
+)
+
+// UserID returns the authenticated user's id, or "" for public methods and
+// background jobs.
+func UserID(ctx context.Context) string {
+	userID, _ := ctx.Value(UserIDKey).(string)
+	return userID
+}
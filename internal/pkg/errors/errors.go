@@ -33,6 +33,31 @@ func NewConflictError(message string) *AppError {
 	}
 }
 
+func IsConflictError(err error) bool {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr.Code == http.StatusConflict
+	}
+	return false
+}
+
+// NewPreconditionFailedError reports a write rejected because the resource
+// changed since the caller read it.
+func NewPreconditionFailedError(message string) *AppError {
+	return &AppError{
+		Code:    http.StatusPreconditionFailed,
+		Message: message,
+	}
+}
+
+func IsPreconditionFailedError(err error) bool {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr.Code == http.StatusPreconditionFailed
+	}
+	return false
+}
+
 func NewInternalError(err error) *AppError {
 	return &AppError{
 		Code:    http.StatusInternalServerError,
@@ -82,6 +107,24 @@ func NewUnauthorizedError(message string) *AppError {
 	}
 }
 
+func NewForbiddenError(message string) *AppError {
+	if message == "" {
+		message = "permission denied"
+	}
+	return &AppError{
+		Code:    http.StatusForbidden,
+		Message: message,
+	}
+}
+
+func IsForbiddenError(err error) bool {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr.Code == http.StatusForbidden
+	}
+	return false
+}
+
 func IsValidationError(err error) bool {
 	var appErr *AppError
 	if errors.As(err, &appErr) {
@@ -2,9 +2,14 @@ package grpc
 
 import (
 	"context"
+	"time"
 
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/authctx"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
 	authuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/auth"
 	authv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/auth/v1"
+	"github.com/google/uuid"
 )
 
 // authServer implements the AuthServiceServer interface.
@@ -30,17 +35,91 @@ func (s *authServer) Register(ctx context.Context, req *authv1.RegisterRequest)
 	return &authv1.RegisterResponse{
 		Id:    user.ID.String(),
 		Email: user.Email,
+		Role:  roleToProto(user.Role),
 	}, nil
 }
 
 // Login authenticates a user and returns a JWT token.
 func (s *authServer) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.LoginResponse, error) {
-	token, err := s.service.Login(ctx, req.GetEmail(), req.GetPassword())
+	tokens, err := s.service.Login(ctx, req.GetEmail(), req.GetPassword())
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
 
+	return tokenPairToProto(tokens), nil
+}
+
+// RefreshToken rotates a refresh token and returns a new token pair.
+func (s *authServer) RefreshToken(ctx context.Context, req *authv1.RefreshTokenRequest) (*authv1.LoginResponse, error) {
+	tokens, err := s.service.RefreshToken(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return tokenPairToProto(tokens), nil
+}
+
+// Logout revokes the caller's access token and refresh token.
+func (s *authServer) Logout(ctx context.Context, req *authv1.LogoutRequest) (*authv1.LogoutResponse, error) {
+	userID, err := uuid.Parse(authctx.UserID(ctx))
+	if err != nil {
+		return nil, ToGRPCError(errors.NewUnauthorizedError(""))
+	}
+	tokenID, _ := ctx.Value(authctx.TokenIDKey).(string)
+	expiresAt, _ := ctx.Value(authctx.TokenExpiresAtKey).(time.Time)
+
+	if err := s.service.Logout(ctx, userID, tokenID, expiresAt, req.GetRefreshToken()); err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &authv1.LogoutResponse{
+		Success: true,
+	}, nil
+}
+
+func tokenPairToProto(tokens *authuc.TokenPair) *authv1.LoginResponse {
 	return &authv1.LoginResponse{
-		Token: token,
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    int64(tokens.ExpiresIn.Seconds()),
+	}
+}
+
+// AssignRole changes a user's role.
+func (s *authServer) AssignRole(ctx context.Context, req *authv1.AssignRoleRequest) (*authv1.User, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid user_id format"))
+	}
+
+	user, err := s.service.AssignRole(ctx, userID, roleFromProto(req.GetRole()))
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &authv1.User{
+		Id:    user.ID.String(),
+		Email: user.Email,
+		Role:  roleToProto(user.Role),
 	}, nil
 }
+
+var protoRoles = map[entity.Role]authv1.Role{
+	entity.RoleAdmin:   authv1.Role_ROLE_ADMIN,
+	entity.RoleHR:      authv1.Role_ROLE_HR,
+	entity.RoleManager: authv1.Role_ROLE_MANAGER,
+	entity.RoleViewer:  authv1.Role_ROLE_VIEWER,
+}
+
+func roleToProto(role entity.Role) authv1.Role {
+	return protoRoles[role]
+}
+
+func roleFromProto(role authv1.Role) entity.Role {
+	for r, p := range protoRoles {
+		if p == role {
+			return r
+		}
+	}
+	return ""
+}
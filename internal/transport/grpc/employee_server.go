@@ -1,14 +1,21 @@
 package grpc
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"io"
+	"slices"
+	"time"
 
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
 	employeeuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/employee"
 	employeev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/employee/v1"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -29,7 +36,25 @@ func (s *employeeServer) CreateEmployee(ctx context.Context, req *employeev1.Cre
 		return nil, ToGRPCError(errors.NewValidationError("invalid gross_salary format"))
 	}
 
-	employee, err := s.service.Create(ctx, req.GetFullName(), req.GetJobTitle(), req.GetCountry(), grossSalary)
+	var placement employeeuc.Placement
+	if placement.ManagerID, err = optionalUUID(req.GetManagerId()); err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid manager_id format"))
+	}
+	if placement.DepartmentID, err = optionalUUID(req.GetDepartmentId()); err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid department_id format"))
+	}
+	if placement.CostCenterID, err = optionalUUID(req.GetCostCenterId()); err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid cost_center_id format"))
+	}
+
+	employment := employeeuc.Employment{
+		Type: employmentTypeFromProto(req.GetEmploymentType()),
+	}
+	if req.GetHireDate() != nil {
+		employment.HireDate = req.GetHireDate().AsTime()
+	}
+
+	employee, err := s.service.Create(ctx, req.GetFullName(), req.GetJobTitle(), req.GetCountry(), grossSalary, req.GetCurrency(), placement, employment)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
@@ -51,18 +76,107 @@ func (s *employeeServer) GetEmployee(ctx context.Context, req *employeev1.GetEmp
 	return entityToProto(employee), nil
 }
 
+func (s *employeeServer) ListEmployees(ctx context.Context, req *employeev1.ListEmployeesRequest) (*employeev1.ListEmployeesResponse, error) {
+	filter, err := employeeFilterFromProto(req)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+	if filter.ManagerID, err = optionalUUID(req.GetManagerId()); err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid manager_id format"))
+	}
+	for _, status := range req.GetStatuses() {
+		filter.Statuses = append(filter.Statuses, employmentStatusFromProto(status))
+	}
+
+	page, err := s.service.List(ctx, repository.EmployeeListParams{
+		Filter:   filter,
+		SortBy:   repository.EmployeeSortField(req.GetSortBy()),
+		SortDesc: req.GetSortOrder() == employeev1.SortOrder_SORT_ORDER_DESC,
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+		Cursor:   req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	employees := make([]*employeev1.Employee, 0, len(page.Employees))
+	for _, e := range page.Employees {
+		employees = append(employees, entityToProto(e))
+	}
+
+	totalPages := (page.TotalCount + int64(page.PageSize) - 1) / int64(page.PageSize)
+
+	return &employeev1.ListEmployeesResponse{
+		Employees:     employees,
+		TotalCount:    page.TotalCount,
+		Page:          int32(page.Page),
+		PageSize:      int32(page.PageSize),
+		TotalPages:    int32(totalPages),
+		NextPageToken: page.NextCursor,
+	}, nil
+}
+
+// SearchEmployees returns the employees matching a query, most relevant
+// first.
+func (s *employeeServer) SearchEmployees(ctx context.Context, req *employeev1.SearchEmployeesRequest) (*employeev1.SearchEmployeesResponse, error) {
+	matches, err := s.service.Search(ctx, req.GetQuery(), int(req.GetLimit()))
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	results := make([]*employeev1.EmployeeSearchResult, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, &employeev1.EmployeeSearchResult{
+			Employee:          entityToProto(match.Employee),
+			Rank:              match.Rank,
+			FullNameHighlight: match.FullNameHighlight,
+			JobTitleHighlight: match.JobTitleHighlight,
+		})
+	}
+
+	return &employeev1.SearchEmployeesResponse{
+		Results: results,
+	}, nil
+}
+
 func (s *employeeServer) UpdateEmployee(ctx context.Context, req *employeev1.UpdateEmployeeRequest) (*employeev1.Employee, error) {
 	id, err := uuid.Parse(req.GetId())
 	if err != nil {
 		return nil, ToGRPCError(errors.NewValidationError("invalid employee id format"))
 	}
 
-	grossSalary, err := decimal.NewFromString(req.GetGrossSalary())
-	if err != nil {
-		return nil, ToGRPCError(errors.NewValidationError("invalid gross_salary format"))
+	update := employeeuc.EmployeeUpdate{
+		Version:        req.GetVersion(),
+		FullName:       req.GetFullName(),
+		JobTitle:       req.GetJobTitle(),
+		Country:        req.GetCountry(),
+		Currency:       req.GetCurrency(),
+		EmploymentType: employmentTypeFromProto(req.GetEmploymentType()),
+		Status:         employmentStatusFromProto(req.GetStatus()),
+		UpdateMask:     req.GetUpdateMask().GetPaths(),
+	}
+	if req.GetHireDate() != nil {
+		update.HireDate = req.GetHireDate().AsTime()
+	}
+	// gross_salary may be left empty when the mask does not name it.
+	if paths := update.UpdateMask; len(paths) == 0 || slices.Contains(paths, "*") || slices.Contains(paths, "gross_salary") {
+		update.GrossSalary, err = decimal.NewFromString(req.GetGrossSalary())
+		if err != nil {
+			return nil, ToGRPCError(errors.NewValidationError("invalid gross_salary format"))
+		}
+	}
+	if update.ManagerID, err = optionalUUID(req.GetManagerId()); err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid manager_id format"))
+	}
+	if update.DepartmentID, err = optionalUUID(req.GetDepartmentId()); err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid department_id format"))
+	}
+	if update.CostCenterID, err = optionalUUID(req.GetCostCenterId()); err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid cost_center_id format"))
 	}
 
-	employee, err := s.service.Update(ctx, id, req.GetFullName(), req.GetJobTitle(), req.GetCountry(), grossSalary)
+	employee, err := s.service.Update(ctx, id, update)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
@@ -85,14 +199,663 @@ func (s *employeeServer) DeleteEmployee(ctx context.Context, req *employeev1.Del
 	}, nil
 }
 
+// TerminateEmployee ends an employment, keeping the employee's record.
+func (s *employeeServer) TerminateEmployee(ctx context.Context, req *employeev1.TerminateEmployeeRequest) (*employeev1.Employee, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid employee id format"))
+	}
+
+	termination := employeeuc.Termination{
+		Reason: terminationReasonFromProto(req.GetReason()),
+	}
+	if req.GetLastWorkingDay() != nil {
+		termination.LastWorkingDay = req.GetLastWorkingDay().AsTime()
+	}
+
+	employee, err := s.service.Terminate(ctx, id, termination)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return entityToProto(employee), nil
+}
+
+// RehireEmployee starts a new employment for a terminated employee.
+func (s *employeeServer) RehireEmployee(ctx context.Context, req *employeev1.RehireEmployeeRequest) (*employeev1.Employee, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid employee id format"))
+	}
+
+	rehire := employeeuc.Rehire{
+		Type: employmentTypeFromProto(req.GetEmploymentType()),
+	}
+	if req.GetHireDate() != nil {
+		rehire.HireDate = req.GetHireDate().AsTime()
+	}
+
+	employee, err := s.service.Rehire(ctx, id, rehire)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return entityToProto(employee), nil
+}
+
+// ListDeletedEmployees returns soft-deleted employees, most recently deleted
+// first.
+func (s *employeeServer) ListDeletedEmployees(ctx context.Context, req *employeev1.ListDeletedEmployeesRequest) (*employeev1.ListDeletedEmployeesResponse, error) {
+	page, err := s.service.ListDeleted(ctx, int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	employees := make([]*employeev1.Employee, 0, len(page.Employees))
+	for _, e := range page.Employees {
+		employees = append(employees, entityToProto(e))
+	}
+
+	totalPages := (page.TotalCount + int64(page.PageSize) - 1) / int64(page.PageSize)
+
+	return &employeev1.ListDeletedEmployeesResponse{
+		Employees:  employees,
+		TotalCount: page.TotalCount,
+		Page:       int32(page.Page),
+		PageSize:   int32(page.PageSize),
+		TotalPages: int32(totalPages),
+	}, nil
+}
+
+func (s *employeeServer) RestoreEmployee(ctx context.Context, req *employeev1.RestoreEmployeeRequest) (*employeev1.Employee, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid employee id format"))
+	}
+
+	employee, err := s.service.Restore(ctx, id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return entityToProto(employee), nil
+}
+
+func (s *employeeServer) PurgeEmployee(ctx context.Context, req *employeev1.PurgeEmployeeRequest) (*employeev1.PurgeEmployeeResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid employee id format"))
+	}
+
+	if err := s.service.Purge(ctx, id); err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &employeev1.PurgeEmployeeResponse{
+		Success: true,
+	}, nil
+}
+
+// GetEmployeeHistory returns the audit trail of an employee, newest first.
+func (s *employeeServer) GetEmployeeHistory(ctx context.Context, req *employeev1.GetEmployeeHistoryRequest) (*employeev1.GetEmployeeHistoryResponse, error) {
+	id, err := uuid.Parse(req.GetEmployeeId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid employee id format"))
+	}
+
+	history, err := s.service.History(ctx, id, int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	changes := make([]*employeev1.EmployeeChange, 0, len(history.Entries))
+	for _, entry := range history.Entries {
+		changes = append(changes, auditEntryToProto(entry))
+	}
+
+	return &employeev1.GetEmployeeHistoryResponse{
+		Changes:    changes,
+		TotalCount: history.TotalCount,
+		Page:       int32(history.Page),
+		PageSize:   int32(history.PageSize),
+	}, nil
+}
+
+func (s *employeeServer) ScheduleCompensationChange(ctx context.Context, req *employeev1.ScheduleCompensationChangeRequest) (*employeev1.CompensationRecord, error) {
+	id, err := uuid.Parse(req.GetEmployeeId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid employee id format"))
+	}
+	grossSalary, err := decimal.NewFromString(req.GetGrossSalary())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid gross_salary format"))
+	}
+
+	change := employeeuc.CompensationChange{
+		GrossSalary: grossSalary,
+		Currency:    req.GetCurrency(),
+		Reason:      compensationReasonFromProto(req.GetReason()),
+	}
+	if req.GetEffectiveFrom() != nil {
+		change.EffectiveFrom = req.GetEffectiveFrom().AsTime()
+	}
+
+	record, err := s.service.ScheduleCompensation(ctx, id, change)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return compensationRecordToProto(record), nil
+}
+
+// ListCompensationHistory returns an employee's compensation timeline,
+// oldest first.
+func (s *employeeServer) ListCompensationHistory(ctx context.Context, req *employeev1.ListCompensationHistoryRequest) (*employeev1.ListCompensationHistoryResponse, error) {
+	id, err := uuid.Parse(req.GetEmployeeId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid employee id format"))
+	}
+
+	history, err := s.service.CompensationHistory(ctx, id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	records := make([]*employeev1.CompensationRecord, 0, len(history))
+	for _, record := range history {
+		records = append(records, compensationRecordToProto(record))
+	}
+
+	return &employeev1.ListCompensationHistoryResponse{
+		Records: records,
+	}, nil
+}
+
+// ImportEmployees creates employees from a streamed CSV file. Row errors are
+// reported in the response rather than failing the call.
+func (s *employeeServer) ImportEmployees(stream grpc.ClientStreamingServer[employeev1.ImportEmployeesRequest, employeev1.ImportEmployeesResponse]) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return ToGRPCError(errors.NewValidationError("import file is empty"))
+	}
+	if err != nil {
+		return err
+	}
+
+	body := &importStreamReader{stream: stream, buf: first.GetChunk()}
+	rows, err := employeeuc.NewCSVRowReader(body)
+	if err != nil {
+		return ToGRPCError(err)
+	}
+
+	result, err := s.service.Import(stream.Context(), rows, first.GetOptions().GetDryRun())
+	if err != nil {
+		return ToGRPCError(err)
+	}
+
+	resp := &employeev1.ImportEmployeesResponse{
+		TotalRows:    int32(result.TotalRows),
+		ImportedRows: int32(result.ImportedRows),
+		DryRun:       result.DryRun,
+		Committed:    result.Committed,
+		ErrorCount:   int32(result.ErrorCount),
+		Errors:       make([]*employeev1.ImportRowError, 0, len(result.Errors)),
+	}
+	for _, rowErr := range result.Errors {
+		resp.Errors = append(resp.Errors, &employeev1.ImportRowError{
+			Line:    int32(rowErr.Line),
+			Message: rowErr.Message,
+		})
+	}
+	return stream.SendAndClose(resp)
+}
+
+// importStreamReader presents the chunks of an import stream as one
+// io.Reader, receiving the next message only when the current one is used up.
+type importStreamReader struct {
+	stream grpc.ClientStreamingServer[employeev1.ImportEmployeesRequest, employeev1.ImportEmployeesResponse]
+	buf    []byte
+}
+
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if msg.GetOptions() != nil {
+			return 0, errors.NewValidationError("import options must be sent in the first message")
+		}
+		r.buf = msg.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// exportChunkSize is the size of the file chunks sent by ExportEmployees.
+const exportChunkSize = 32 << 10
+
+// ExportEmployees streams the matching employees as a file in the requested
+// format. The file is written in chunks as rows are read from the database.
+func (s *employeeServer) ExportEmployees(req *employeev1.ExportEmployeesRequest, stream grpc.ServerStreamingServer[employeev1.ExportEmployeesResponse]) error {
+	filter := repository.EmployeeFilter{
+		Country:  req.GetCountry(),
+		JobTitle: req.GetJobTitle(),
+	}
+
+	var err error
+	if filter.MinSalary, err = optionalDecimal(req.GetMinSalary()); err != nil {
+		return ToGRPCError(errors.NewValidationError("invalid min_salary format"))
+	}
+	if filter.MaxSalary, err = optionalDecimal(req.GetMaxSalary()); err != nil {
+		return ToGRPCError(errors.NewValidationError("invalid max_salary format"))
+	}
+
+	format, err := exportFormatFromProto(req.GetFormat())
+	if err != nil {
+		return ToGRPCError(err)
+	}
+
+	w := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportChunkSize)
+	err = s.service.Export(stream.Context(), employeeuc.ExportParams{
+		Filter:           filter,
+		Format:           format,
+		IncludeNetSalary: req.GetIncludeNetSalary(),
+	}, w)
+	if err != nil {
+		return ToGRPCError(err)
+	}
+	return ToGRPCError(w.Flush())
+}
+
+// exportStreamWriter sends each write as one chunk of an export stream.
+// Send marshals the message before returning, so p is not retained.
+type exportStreamWriter struct {
+	stream grpc.ServerStreamingServer[employeev1.ExportEmployeesResponse]
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&employeev1.ExportEmployeesResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func exportFormatFromProto(format employeev1.ExportFormat) (employeeuc.ExportFormat, error) {
+	switch format {
+	case employeev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, employeev1.ExportFormat_EXPORT_FORMAT_CSV:
+		return employeeuc.ExportFormatCSV, nil
+	case employeev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return employeeuc.ExportFormatJSONL, nil
+	case employeev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		return employeeuc.ExportFormatParquet, nil
+	default:
+		return "", errors.NewValidationError("unsupported export format")
+	}
+}
+
+// ListDirectReports returns the employees reporting directly to a manager,
+// ordered by name.
+func (s *employeeServer) ListDirectReports(ctx context.Context, req *employeev1.ListDirectReportsRequest) (*employeev1.ListDirectReportsResponse, error) {
+	managerID, err := uuid.Parse(req.GetManagerId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid manager_id format"))
+	}
+
+	reports, err := s.service.DirectReports(ctx, managerID)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &employeev1.ListDirectReportsResponse{
+		Employees: entitiesToProto(reports),
+	}, nil
+}
+
+// ListReports returns everyone below a manager, ordered by depth, then name.
+func (s *employeeServer) ListReports(ctx context.Context, req *employeev1.ListReportsRequest) (*employeev1.ListReportsResponse, error) {
+	managerID, err := uuid.Parse(req.GetManagerId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid manager_id format"))
+	}
+
+	nodes, err := s.service.Reports(ctx, managerID, int(req.GetMaxDepth()))
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	reports := make([]*employeev1.OrgNode, 0, len(nodes))
+	for _, node := range nodes {
+		reports = append(reports, &employeev1.OrgNode{
+			Employee: entityToProto(node.Employee),
+			Depth:    int32(node.Depth),
+		})
+	}
+
+	return &employeev1.ListReportsResponse{
+		Reports: reports,
+	}, nil
+}
+
+// GetManagementChain returns the managers above an employee, its direct
+// manager first.
+func (s *employeeServer) GetManagementChain(ctx context.Context, req *employeev1.GetManagementChainRequest) (*employeev1.GetManagementChainResponse, error) {
+	id, err := uuid.Parse(req.GetEmployeeId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid employee id format"))
+	}
+
+	managers, err := s.service.ManagementChain(ctx, id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &employeev1.GetManagementChainResponse{
+		Managers: entitiesToProto(managers),
+	}, nil
+}
+
+// ExportOrgChart renders the reporting lines below root_id, or of the whole
+// organisation, as a single file.
+func (s *employeeServer) ExportOrgChart(ctx context.Context, req *employeev1.ExportOrgChartRequest) (*employeev1.ExportOrgChartResponse, error) {
+	root, err := optionalUUID(req.GetRootId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid root_id format"))
+	}
+
+	format, err := orgChartFormatFromProto(req.GetFormat())
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	var buf bytes.Buffer
+	err = s.service.ExportOrgChart(ctx, employeeuc.OrgChartParams{
+		Root:     root,
+		MaxDepth: int(req.GetMaxDepth()),
+		Format:   format,
+	}, &buf)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	resp := &employeev1.ExportOrgChartResponse{
+		Content:     buf.Bytes(),
+		ContentType: "text/vnd.graphviz; charset=utf-8",
+		Filename:    "org-chart.dot",
+	}
+	if format == employeeuc.OrgChartFormatJSON {
+		resp.ContentType = "application/json"
+		resp.Filename = "org-chart.json"
+	}
+	return resp, nil
+}
+
+// WatchEmployees sends the employee events after the requested sequence,
+// then new ones as they are committed, until the client goes away.
+func (s *employeeServer) WatchEmployees(req *employeev1.WatchEmployeesRequest, stream grpc.ServerStreamingServer[employeev1.EmployeeEvent]) error {
+	params := employeeuc.WatchParams{
+		AfterSequence: req.GetAfterSequence(),
+		Country:       req.GetCountry(),
+	}
+	for _, eventType := range req.GetTypes() {
+		params.Types = append(params.Types, employeeEventTypeFromProto(eventType))
+	}
+
+	err := s.service.Watch(stream.Context(), params, func(event *entity.EmployeeEvent) error {
+		return stream.Send(employeeEventToProto(event))
+	})
+	return ToGRPCError(err)
+}
+
+func orgChartFormatFromProto(format employeev1.OrgChartFormat) (employeeuc.OrgChartFormat, error) {
+	switch format {
+	case employeev1.OrgChartFormat_ORG_CHART_FORMAT_UNSPECIFIED, employeev1.OrgChartFormat_ORG_CHART_FORMAT_DOT:
+		return employeeuc.OrgChartFormatDOT, nil
+	case employeev1.OrgChartFormat_ORG_CHART_FORMAT_JSON:
+		return employeeuc.OrgChartFormatJSON, nil
+	default:
+		return "", errors.NewValidationError("unsupported org chart format")
+	}
+}
+
+func auditEntryToProto(e *entity.EmployeeAuditEntry) *employeev1.EmployeeChange {
+	change := &employeev1.EmployeeChange{
+		Id:         e.ID.String(),
+		EmployeeId: e.EmployeeID.String(),
+		Action:     auditActionToProto(e.Action),
+		OccurredAt: timestamppb.New(e.OccurredAt),
+		Changes:    make([]*employeev1.FieldChange, 0, len(e.Changes)),
+	}
+	if e.ActorID != nil {
+		change.ActorId = e.ActorID.String()
+	}
+	for _, c := range e.Changes {
+		change.Changes = append(change.Changes, &employeev1.FieldChange{
+			Field:    c.Field,
+			OldValue: c.Before,
+			NewValue: c.After,
+		})
+	}
+	return change
+}
+
+func auditActionToProto(action entity.AuditAction) employeev1.ChangeAction {
+	switch action {
+	case entity.AuditActionCreated:
+		return employeev1.ChangeAction_CHANGE_ACTION_CREATED
+	case entity.AuditActionUpdated:
+		return employeev1.ChangeAction_CHANGE_ACTION_UPDATED
+	case entity.AuditActionDeleted:
+		return employeev1.ChangeAction_CHANGE_ACTION_DELETED
+	case entity.AuditActionRestored:
+		return employeev1.ChangeAction_CHANGE_ACTION_RESTORED
+	case entity.AuditActionPurged:
+		return employeev1.ChangeAction_CHANGE_ACTION_PURGED
+	default:
+		return employeev1.ChangeAction_CHANGE_ACTION_UNSPECIFIED
+	}
+}
+
+var compensationReasons = map[entity.CompensationReason]employeev1.CompensationReason{
+	entity.CompensationReasonHire:       employeev1.CompensationReason_COMPENSATION_REASON_HIRE,
+	entity.CompensationReasonPromotion:  employeev1.CompensationReason_COMPENSATION_REASON_PROMOTION,
+	entity.CompensationReasonMerit:      employeev1.CompensationReason_COMPENSATION_REASON_MERIT,
+	entity.CompensationReasonAdjustment: employeev1.CompensationReason_COMPENSATION_REASON_ADJUSTMENT,
+}
+
+// compensationReasonFromProto returns an empty, invalid reason for
+// COMPENSATION_REASON_UNSPECIFIED; the service rejects it.
+func compensationReasonFromProto(reason employeev1.CompensationReason) entity.CompensationReason {
+	for r, p := range compensationReasons {
+		if p == reason {
+			return r
+		}
+	}
+	return ""
+}
+
+var employmentTypes = map[entity.EmploymentType]employeev1.EmploymentType{
+	entity.EmploymentTypeFullTime:   employeev1.EmploymentType_EMPLOYMENT_TYPE_FULL_TIME,
+	entity.EmploymentTypePartTime:   employeev1.EmploymentType_EMPLOYMENT_TYPE_PART_TIME,
+	entity.EmploymentTypeContractor: employeev1.EmploymentType_EMPLOYMENT_TYPE_CONTRACTOR,
+}
+
+// employmentTypeFromProto returns an empty type for
+// EMPLOYMENT_TYPE_UNSPECIFIED, which the service treats as the default.
+func employmentTypeFromProto(employmentType employeev1.EmploymentType) entity.EmploymentType {
+	for t, p := range employmentTypes {
+		if p == employmentType {
+			return t
+		}
+	}
+	return ""
+}
+
+var employmentStatuses = map[entity.EmploymentStatus]employeev1.EmploymentStatus{
+	entity.EmploymentStatusPending:    employeev1.EmploymentStatus_EMPLOYMENT_STATUS_PENDING,
+	entity.EmploymentStatusActive:     employeev1.EmploymentStatus_EMPLOYMENT_STATUS_ACTIVE,
+	entity.EmploymentStatusOnLeave:    employeev1.EmploymentStatus_EMPLOYMENT_STATUS_ON_LEAVE,
+	entity.EmploymentStatusTerminated: employeev1.EmploymentStatus_EMPLOYMENT_STATUS_TERMINATED,
+}
+
+// employmentStatusFromProto returns an empty, invalid status for
+// EMPLOYMENT_STATUS_UNSPECIFIED; the service rejects it.
+func employmentStatusFromProto(status employeev1.EmploymentStatus) entity.EmploymentStatus {
+	for s, p := range employmentStatuses {
+		if p == status {
+			return s
+		}
+	}
+	return ""
+}
+
+var terminationReasons = map[entity.TerminationReason]employeev1.TerminationReason{
+	entity.TerminationReasonResignation: employeev1.TerminationReason_TERMINATION_REASON_RESIGNATION,
+	entity.TerminationReasonDismissal:   employeev1.TerminationReason_TERMINATION_REASON_DISMISSAL,
+	entity.TerminationReasonRedundancy:  employeev1.TerminationReason_TERMINATION_REASON_REDUNDANCY,
+	entity.TerminationReasonRetirement:  employeev1.TerminationReason_TERMINATION_REASON_RETIREMENT,
+	entity.TerminationReasonContractEnd: employeev1.TerminationReason_TERMINATION_REASON_CONTRACT_END,
+	entity.TerminationReasonOther:       employeev1.TerminationReason_TERMINATION_REASON_OTHER,
+}
+
+// terminationReasonFromProto returns an empty, invalid reason for
+// TERMINATION_REASON_UNSPECIFIED; the service rejects it.
+func terminationReasonFromProto(reason employeev1.TerminationReason) entity.TerminationReason {
+	for r, p := range terminationReasons {
+		if p == reason {
+			return r
+		}
+	}
+	return ""
+}
+
+var employeeEventTypes = map[entity.EmployeeEventType]employeev1.EmployeeEventType{
+	entity.EmployeeEventCreated: employeev1.EmployeeEventType_EMPLOYEE_EVENT_TYPE_CREATED,
+	entity.EmployeeEventUpdated: employeev1.EmployeeEventType_EMPLOYEE_EVENT_TYPE_UPDATED,
+	entity.EmployeeEventDeleted: employeev1.EmployeeEventType_EMPLOYEE_EVENT_TYPE_DELETED,
+}
+
+func employeeEventTypeFromProto(eventType employeev1.EmployeeEventType) entity.EmployeeEventType {
+	for t, p := range employeeEventTypes {
+		if p == eventType {
+			return t
+		}
+	}
+	return ""
+}
+
+func employeeEventToProto(e *entity.EmployeeEvent) *employeev1.EmployeeEvent {
+	employee := entity.Employee(e.Employee)
+	event := &employeev1.EmployeeEvent{
+		Sequence:        e.Sequence,
+		Type:            employeeEventTypes[e.Type],
+		Employee:        entityToProto(&employee),
+		OccurredAt:      timestamppb.New(e.OccurredAt),
+		Changes:         make([]*employeev1.FieldChange, 0, len(e.Changes)),
+		PreviousCountry: e.PreviousCountry,
+	}
+	if e.ActorID != nil {
+		event.ActorId = e.ActorID.String()
+	}
+	for _, c := range e.Changes {
+		event.Changes = append(event.Changes, &employeev1.FieldChange{
+			Field:    c.Field,
+			OldValue: c.Before,
+			NewValue: c.After,
+		})
+	}
+	return event
+}
+
+func compensationRecordToProto(r *entity.CompensationRecord) *employeev1.CompensationRecord {
+	record := &employeev1.CompensationRecord{
+		Id:            r.ID.String(),
+		EmployeeId:    r.EmployeeID.String(),
+		GrossSalary:   r.GrossSalary.String(),
+		Currency:      r.Currency,
+		Reason:        compensationReasons[r.Reason],
+		EffectiveFrom: timestamppb.New(r.EffectiveFrom),
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+	}
+	if r.ApproverID != nil {
+		record.ApproverId = r.ApproverID.String()
+	}
+	if r.EffectiveTo != nil {
+		record.EffectiveTo = timestamppb.New(*r.EffectiveTo)
+	}
+	return record
+}
+
 func entityToProto(e *entity.Employee) *employeev1.Employee {
-	return &employeev1.Employee{
-		Id:          e.ID.String(),
-		FullName:    e.FullName,
-		JobTitle:    e.JobTitle,
-		Country:     e.Country,
-		GrossSalary: e.GrossSalary.String(),
-		CreatedAt:   timestamppb.New(e.CreatedAt),
-		UpdatedAt:   timestamppb.New(e.UpdatedAt),
+	employee := &employeev1.Employee{
+		Id:                e.ID.String(),
+		FullName:          e.FullName,
+		JobTitle:          e.JobTitle,
+		Country:           e.Country,
+		GrossSalary:       e.GrossSalary.String(),
+		Currency:          e.Currency,
+		CreatedAt:         timestamppb.New(e.CreatedAt),
+		UpdatedAt:         timestamppb.New(e.UpdatedAt),
+		Version:           e.Version,
+		HireDate:          timestamppb.New(e.HireDate),
+		EmploymentType:    employmentTypes[e.EmploymentType],
+		Status:            employmentStatuses[e.Status],
+		TerminationReason: terminationReasons[e.TerminationReason],
+	}
+	if e.ManagerID != nil {
+		employee.ManagerId = e.ManagerID.String()
+	}
+	if e.DepartmentID != nil {
+		employee.DepartmentId = e.DepartmentID.String()
+	}
+	if e.CostCenterID != nil {
+		employee.CostCenterId = e.CostCenterID.String()
+	}
+	if e.LastWorkingDay != nil {
+		employee.LastWorkingDay = timestamppb.New(*e.LastWorkingDay)
+	}
+	if e.DeletedAt.Valid {
+		employee.DeletedAt = timestamppb.New(e.DeletedAt.Time)
+	}
+	return employee
+}
+
+func entitiesToProto(employees []*entity.Employee) []*employeev1.Employee {
+	result := make([]*employeev1.Employee, 0, len(employees))
+	for _, e := range employees {
+		result = append(result, entityToProto(e))
+	}
+	return result
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func optionalDecimal(value string) (*decimal.Decimal, error) {
+	if value == "" {
+		return nil, nil
+	}
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func optionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
 	}
+	return &id, nil
 }
@@ -14,6 +14,11 @@ func ToGRPCError(err error) error {
 	if err == nil {
 		return nil
 	}
+	// Errors that already carry a gRPC status, such as a cancelled stream,
+	// are passed through unchanged.
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
 
 	code := errors.GetStatusCode(err)
 	message := errors.GetMessage(err)
@@ -35,6 +40,8 @@ func httpStatusToGRPCCode(httpStatus int) codes.Code {
 		return codes.NotFound
 	case http.StatusConflict:
 		return codes.AlreadyExists
+	case http.StatusPreconditionFailed:
+		return codes.Aborted
 	case http.StatusUnprocessableEntity:
 		return codes.InvalidArgument
 	case http.StatusTooManyRequests:
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	exchangerateuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/exchangerate"
+	exchangeratev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/exchangerate/v1"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exchangeRateServer implements the ExchangeRateServiceServer interface.
+type exchangeRateServer struct {
+	exchangeratev1.UnimplementedExchangeRateServiceServer
+	service exchangerateuc.Service
+}
+
+// NewExchangeRateServer creates a new gRPC exchange rate server.
+func NewExchangeRateServer(service exchangerateuc.Service) exchangeratev1.ExchangeRateServiceServer {
+	return &exchangeRateServer{
+		service: service,
+	}
+}
+
+// SetExchangeRates stores a batch of rates.
+func (s *exchangeRateServer) SetExchangeRates(ctx context.Context, req *exchangeratev1.SetExchangeRatesRequest) (*exchangeratev1.SetExchangeRatesResponse, error) {
+	rates := make([]*entity.ExchangeRate, 0, len(req.GetRates()))
+	for _, r := range req.GetRates() {
+		rate, err := decimal.NewFromString(r.GetRate())
+		if err != nil {
+			return nil, ToGRPCError(errors.NewValidationError("invalid rate format"))
+		}
+		if r.GetValidFrom() == nil {
+			return nil, ToGRPCError(errors.NewValidationError("valid_from is required"))
+		}
+		rates = append(rates, entity.NewExchangeRate(r.GetBaseCurrency(), r.GetQuoteCurrency(), rate, r.GetValidFrom().AsTime()))
+	}
+
+	stored, err := s.service.SetRates(ctx, rates)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &exchangeratev1.SetExchangeRatesResponse{
+		Stored: int32(len(stored)),
+	}, nil
+}
+
+// ListExchangeRates returns stored rates.
+func (s *exchangeRateServer) ListExchangeRates(ctx context.Context, req *exchangeratev1.ListExchangeRatesRequest) (*exchangeratev1.ListExchangeRatesResponse, error) {
+	rates, err := s.service.List(ctx, req.GetBaseCurrency())
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	resp := &exchangeratev1.ListExchangeRatesResponse{
+		Rates: make([]*exchangeratev1.ExchangeRate, 0, len(rates)),
+	}
+	for _, r := range rates {
+		resp.Rates = append(resp.Rates, exchangeRateToProto(r))
+	}
+	return resp, nil
+}
+
+func exchangeRateToProto(r *entity.ExchangeRate) *exchangeratev1.ExchangeRate {
+	return &exchangeratev1.ExchangeRate{
+		BaseCurrency:  r.BaseCurrency,
+		QuoteCurrency: r.QuoteCurrency,
+		Rate:          r.Rate.String(),
+		ValidFrom:     timestamppb.New(r.ValidFrom),
+	}
+}
@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/health"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthWatchInterval is how often Watch re-runs the checks.
+const healthWatchInterval = 5 * time.Second
+
+// healthServer implements grpc.health.v1.Health on top of the process-wide
+// readiness checks. Every service shares the same status because they all
+// depend on the same database.
+type healthServer struct {
+	healthpb.UnimplementedHealthServer
+	checker  *health.Checker
+	services map[string]bool
+}
+
+// NewHealthServer creates the standard gRPC health service for the services
+// registered on server. The empty service name reports the overall status.
+func NewHealthServer(checker *health.Checker, server *grpc.Server) healthpb.HealthServer {
+	services := map[string]bool{"": true}
+	for name := range server.GetServiceInfo() {
+		services[name] = true
+	}
+	return &healthServer{checker: checker, services: services}
+}
+
+// Check reports whether the requested service is serving.
+func (s *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !s.services[req.GetService()] {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+	return &healthpb.HealthCheckResponse{Status: s.status(ctx)}, nil
+}
+
+// List reports the status of every registered service.
+func (s *healthServer) List(ctx context.Context, _ *healthpb.HealthListRequest) (*healthpb.HealthListResponse, error) {
+	st := s.status(ctx)
+	resp := &healthpb.HealthListResponse{Statuses: make(map[string]*healthpb.HealthCheckResponse, len(s.services))}
+	for name := range s.services {
+		resp.Statuses[name] = &healthpb.HealthCheckResponse{Status: st}
+	}
+	return resp, nil
+}
+
+// Watch streams the status of a service, sending a message whenever it changes.
+func (s *healthServer) Watch(req *healthpb.HealthCheckRequest, stream grpc.ServerStreamingServer[healthpb.HealthCheckResponse]) error {
+	ctx := stream.Context()
+	if !s.services[req.GetService()] {
+		if err := stream.Send(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVICE_UNKNOWN}); err != nil {
+			return err
+		}
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		if st := s.status(ctx); st != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: st}); err != nil {
+				return err
+			}
+			last = st
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *healthServer) status(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	if s.checker.Check(ctx).Ready() {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -6,7 +6,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/auth"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/metrics"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/authctx"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 	"google.golang.org/grpc"
@@ -15,13 +18,6 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-type contextKey string
-
-const (
-	UserIDKey contextKey = "user_id"
-	EmailKey  contextKey = "email"
-)
-
 // LoggingInterceptor creates a unary server interceptor for logging.
 func LoggingInterceptor(logger log.Logger) grpc.UnaryServerInterceptor {
 	return func(
@@ -53,6 +49,79 @@ func LoggingInterceptor(logger log.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
+// LoggingStreamInterceptor is the streaming counterpart of LoggingInterceptor.
+func LoggingStreamInterceptor(logger log.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		_ = level.Info(logger).Log(
+			"method", info.FullMethod,
+			"duration", time.Since(start).String(),
+			"code", status.Code(err).String(),
+			"error", err,
+		)
+
+		return err
+	}
+}
+
+// MetricsInterceptor creates a unary server interceptor that records request
+// counts, status codes and latency per method.
+func MetricsInterceptor(m *metrics.RPCMetrics) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		service, method := splitMethodName(info.FullMethod)
+		m.Started(service, method)
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		m.Handled(service, method, status.Code(err).String(), time.Since(start))
+		return resp, err
+	}
+}
+
+// MetricsStreamInterceptor is the streaming counterpart of MetricsInterceptor.
+// Latency covers the whole life of the stream.
+func MetricsStreamInterceptor(m *metrics.RPCMetrics) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		service, method := splitMethodName(info.FullMethod)
+		m.Started(service, method)
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		m.Handled(service, method, status.Code(err).String(), time.Since(start))
+		return err
+	}
+}
+
+// splitMethodName splits "/package.Service/Method" into its service and
+// method parts.
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
 // RecoveryInterceptor creates a unary server interceptor for panic recovery.
 func RecoveryInterceptor(logger log.Logger) grpc.UnaryServerInterceptor {
 	return func(
@@ -77,17 +146,28 @@ func RecoveryInterceptor(logger log.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
-func isPublicMethod(method string) bool {
-	publicMethods := []string{
-		"/auth.v1.AuthService/Register",
-		"/auth.v1.AuthService/Login",
-	}
-	for _, m := range publicMethods {
-		if method == m {
-			return true
-		}
+// RecoveryStreamInterceptor is the streaming counterpart of RecoveryInterceptor.
+func RecoveryStreamInterceptor(logger log.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				_ = level.Error(logger).Log(
+					"msg", "panic recovered",
+					"method", info.FullMethod,
+					"panic", r,
+					"stack", string(debug.Stack()),
+				)
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+
+		return handler(srv, ss)
 	}
-	return false
 }
 
 func extractToken(ctx context.Context) (string, error) {
@@ -114,6 +194,8 @@ func extractToken(ctx context.Context) (string, error) {
 	return token, nil
 }
 
+// AuthInterceptor creates a unary server interceptor that authenticates the
+// caller and checks the method permission table.
 func AuthInterceptor(jwtManager *auth.JWTManager) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -121,27 +203,79 @@ func AuthInterceptor(jwtManager *auth.JWTManager) grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// Skip auth for public methods
-		if isPublicMethod(info.FullMethod) {
-			return handler(ctx, req)
-		}
-
-		// Extract token from metadata
-		token, err := extractToken(ctx)
+		ctx, err := authenticate(ctx, jwtManager, info.FullMethod)
 		if err != nil {
 			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		// Validate token
-		claims, err := jwtManager.ValidateToken(token)
+// AuthStreamInterceptor is the streaming counterpart of AuthInterceptor.
+func AuthStreamInterceptor(jwtManager *auth.JWTManager) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticate(ss.Context(), jwtManager, info.FullMethod)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid token")
+			return err
 		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate validates the caller's token for method and returns a context
+// carrying the caller's identity.
+func authenticate(ctx context.Context, jwtManager *auth.JWTManager, method string) (context.Context, error) {
+	// Skip auth for public methods
+	if isPublicMethod(method) {
+		return ctx, nil
+	}
 
-		// Inject user info into context
-		ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, EmailKey, claims.Email)
+	// Extract token from metadata
+	token, err := extractToken(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		return handler(ctx, req)
+	// Validate token
+	claims, err := jwtManager.ValidateToken(ctx, token)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	// Tokens issued before roles existed carry no role
+	role := entity.Role(claims.Role)
+	if role == "" {
+		role = entity.RoleViewer
 	}
+
+	// Check the caller's role against the method permission table
+	if !isAllowed(method, role) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	// Inject user info into context
+	ctx = context.WithValue(ctx, authctx.UserIDKey, claims.UserID)
+	ctx = context.WithValue(ctx, authctx.EmailKey, claims.Email)
+	ctx = context.WithValue(ctx, authctx.RoleKey, role)
+	ctx = context.WithValue(ctx, authctx.TokenIDKey, claims.ID)
+	if claims.ExpiresAt != nil {
+		ctx = context.WithValue(ctx, authctx.TokenExpiresAtKey, claims.ExpiresAt.Time)
+	}
+
+	return ctx, nil
+}
+
+// contextStream overrides the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
 }
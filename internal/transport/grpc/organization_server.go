@@ -0,0 +1,211 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	organizationuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/organization"
+	organizationv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/organization/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// organizationServer implements the OrganizationServiceServer interface.
+type organizationServer struct {
+	organizationv1.UnimplementedOrganizationServiceServer
+	service organizationuc.Service
+}
+
+// NewOrganizationServer creates a new gRPC organization server.
+func NewOrganizationServer(service organizationuc.Service) organizationv1.OrganizationServiceServer {
+	return &organizationServer{
+		service: service,
+	}
+}
+
+// CreateDepartment adds a department.
+func (s *organizationServer) CreateDepartment(ctx context.Context, req *organizationv1.CreateDepartmentRequest) (*organizationv1.Department, error) {
+	parentID, err := optionalUUID(req.GetParentId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid parent_id format"))
+	}
+
+	department, err := s.service.CreateDepartment(ctx, req.GetName(), parentID)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return departmentToProto(department), nil
+}
+
+// GetDepartment returns a department by id.
+func (s *organizationServer) GetDepartment(ctx context.Context, req *organizationv1.GetDepartmentRequest) (*organizationv1.Department, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid department id format"))
+	}
+
+	department, err := s.service.GetDepartment(ctx, id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return departmentToProto(department), nil
+}
+
+// ListDepartments returns every department.
+func (s *organizationServer) ListDepartments(ctx context.Context, req *organizationv1.ListDepartmentsRequest) (*organizationv1.ListDepartmentsResponse, error) {
+	departments, err := s.service.ListDepartments(ctx)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	result := make([]*organizationv1.Department, 0, len(departments))
+	for _, d := range departments {
+		result = append(result, departmentToProto(d))
+	}
+
+	return &organizationv1.ListDepartmentsResponse{
+		Departments: result,
+	}, nil
+}
+
+// UpdateDepartment renames or moves a department.
+func (s *organizationServer) UpdateDepartment(ctx context.Context, req *organizationv1.UpdateDepartmentRequest) (*organizationv1.Department, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid department id format"))
+	}
+	parentID, err := optionalUUID(req.GetParentId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid parent_id format"))
+	}
+
+	department, err := s.service.UpdateDepartment(ctx, id, req.GetName(), parentID)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return departmentToProto(department), nil
+}
+
+// DeleteDepartment removes a department.
+func (s *organizationServer) DeleteDepartment(ctx context.Context, req *organizationv1.DeleteDepartmentRequest) (*organizationv1.DeleteDepartmentResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid department id format"))
+	}
+	reassignTo, err := optionalUUID(req.GetReassignTo())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid reassign_to format"))
+	}
+
+	if err := s.service.DeleteDepartment(ctx, id, reassignTo); err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &organizationv1.DeleteDepartmentResponse{
+		Success: true,
+	}, nil
+}
+
+// CreateCostCenter adds a cost center.
+func (s *organizationServer) CreateCostCenter(ctx context.Context, req *organizationv1.CreateCostCenterRequest) (*organizationv1.CostCenter, error) {
+	costCenter, err := s.service.CreateCostCenter(ctx, req.GetCode(), req.GetName())
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return costCenterToProto(costCenter), nil
+}
+
+// GetCostCenter returns a cost center by id.
+func (s *organizationServer) GetCostCenter(ctx context.Context, req *organizationv1.GetCostCenterRequest) (*organizationv1.CostCenter, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid cost center id format"))
+	}
+
+	costCenter, err := s.service.GetCostCenter(ctx, id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return costCenterToProto(costCenter), nil
+}
+
+// ListCostCenters returns every cost center.
+func (s *organizationServer) ListCostCenters(ctx context.Context, req *organizationv1.ListCostCentersRequest) (*organizationv1.ListCostCentersResponse, error) {
+	costCenters, err := s.service.ListCostCenters(ctx)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	result := make([]*organizationv1.CostCenter, 0, len(costCenters))
+	for _, c := range costCenters {
+		result = append(result, costCenterToProto(c))
+	}
+
+	return &organizationv1.ListCostCentersResponse{
+		CostCenters: result,
+	}, nil
+}
+
+// UpdateCostCenter changes the code or name of a cost center.
+func (s *organizationServer) UpdateCostCenter(ctx context.Context, req *organizationv1.UpdateCostCenterRequest) (*organizationv1.CostCenter, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid cost center id format"))
+	}
+
+	costCenter, err := s.service.UpdateCostCenter(ctx, id, req.GetCode(), req.GetName())
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return costCenterToProto(costCenter), nil
+}
+
+// DeleteCostCenter removes a cost center.
+func (s *organizationServer) DeleteCostCenter(ctx context.Context, req *organizationv1.DeleteCostCenterRequest) (*organizationv1.DeleteCostCenterResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid cost center id format"))
+	}
+	reassignTo, err := optionalUUID(req.GetReassignTo())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid reassign_to format"))
+	}
+
+	if err := s.service.DeleteCostCenter(ctx, id, reassignTo); err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &organizationv1.DeleteCostCenterResponse{
+		Success: true,
+	}, nil
+}
+
+func departmentToProto(d *entity.Department) *organizationv1.Department {
+	department := &organizationv1.Department{
+		Id:        d.ID.String(),
+		Name:      d.Name,
+		CreatedAt: timestamppb.New(d.CreatedAt),
+		UpdatedAt: timestamppb.New(d.UpdatedAt),
+	}
+	if d.ParentID != nil {
+		department.ParentId = d.ParentID.String()
+	}
+	return department
+}
+
+func costCenterToProto(c *entity.CostCenter) *organizationv1.CostCenter {
+	return &organizationv1.CostCenter{
+		Id:        c.ID.String(),
+		Code:      c.Code,
+		Name:      c.Name,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+	}
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	payslipuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/payslip"
+	payslipv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/payslip/v1"
+	"github.com/google/uuid"
+)
+
+// payslipServer implements the PayslipServiceServer interface.
+type payslipServer struct {
+	payslipv1.UnimplementedPayslipServiceServer
+	service payslipuc.Service
+}
+
+// NewPayslipServer creates a new gRPC payslip server.
+func NewPayslipServer(service payslipuc.Service) payslipv1.PayslipServiceServer {
+	return &payslipServer{
+		service: service,
+	}
+}
+
+// GeneratePayslip renders an employee's payslip for a month.
+func (s *payslipServer) GeneratePayslip(ctx context.Context, req *payslipv1.GeneratePayslipRequest) (*payslipv1.GeneratePayslipResponse, error) {
+	employeeID, err := uuid.Parse(req.GetEmployeeId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid employee_id format"))
+	}
+
+	format, err := payslipFormatFromProto(req.GetFormat())
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	doc, err := s.service.Generate(ctx, payslipuc.Params{
+		EmployeeID: employeeID,
+		Year:       int(req.GetYear()),
+		Month:      time.Month(req.GetMonth()),
+		Format:     format,
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &payslipv1.GeneratePayslipResponse{
+		Content:     doc.Content,
+		ContentType: doc.ContentType,
+		Filename:    doc.Filename,
+	}, nil
+}
+
+func payslipFormatFromProto(format payslipv1.PayslipFormat) (payslipuc.Format, error) {
+	switch format {
+	case payslipv1.PayslipFormat_PAYSLIP_FORMAT_UNSPECIFIED, payslipv1.PayslipFormat_PAYSLIP_FORMAT_PDF:
+		return payslipuc.FormatPDF, nil
+	case payslipv1.PayslipFormat_PAYSLIP_FORMAT_TEXT:
+		return payslipuc.FormatText, nil
+	default:
+		return "", errors.NewValidationError("unsupported payslip format")
+	}
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+)
+
+// publicMethods can be called without a token.
+var publicMethods = map[string]bool{
+	"/auth.v1.AuthService/Register":     true,
+	"/auth.v1.AuthService/Login":        true,
+	"/auth.v1.AuthService/RefreshToken": true,
+
+	"/grpc.health.v1.Health/Check": true,
+	"/grpc.health.v1.Health/List":  true,
+	"/grpc.health.v1.Health/Watch": true,
+
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      true,
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
+}
+
+// methodRoles lists the roles allowed to call each method. Admins may call
+// every method; a method missing from this table is admin-only.
+var methodRoles = map[string][]entity.Role{
+	"/auth.v1.AuthService/AssignRole": {},
+	"/auth.v1.AuthService/Logout":     {entity.RoleHR, entity.RoleManager, entity.RoleViewer},
+
+	"/employee.v1.EmployeeService/CreateEmployee":     {entity.RoleHR},
+	"/employee.v1.EmployeeService/GetEmployee":        {entity.RoleHR, entity.RoleManager},
+	"/employee.v1.EmployeeService/ListEmployees":      {entity.RoleHR, entity.RoleManager},
+	"/employee.v1.EmployeeService/SearchEmployees":    {entity.RoleHR, entity.RoleManager},
+	"/employee.v1.EmployeeService/UpdateEmployee":     {entity.RoleHR},
+	"/employee.v1.EmployeeService/DeleteEmployee":     {entity.RoleHR},
+	"/employee.v1.EmployeeService/TerminateEmployee":  {entity.RoleHR},
+	"/employee.v1.EmployeeService/RehireEmployee":     {entity.RoleHR},
+	"/employee.v1.EmployeeService/GetEmployeeHistory": {entity.RoleHR},
+	"/employee.v1.EmployeeService/ImportEmployees":    {entity.RoleHR},
+	"/employee.v1.EmployeeService/ExportEmployees":    {entity.RoleHR},
+	"/employee.v1.EmployeeService/WatchEmployees":     {entity.RoleHR},
+
+	"/employee.v1.EmployeeService/ScheduleCompensationChange": {entity.RoleHR},
+	"/employee.v1.EmployeeService/ListCompensationHistory":    {entity.RoleHR},
+
+	"/employee.v1.EmployeeService/ListDirectReports":  {entity.RoleHR, entity.RoleManager},
+	"/employee.v1.EmployeeService/ListReports":        {entity.RoleHR, entity.RoleManager},
+	"/employee.v1.EmployeeService/GetManagementChain": {entity.RoleHR, entity.RoleManager},
+	"/employee.v1.EmployeeService/ExportOrgChart":     {entity.RoleHR, entity.RoleManager},
+
+	"/employee.v1.EmployeeService/ListDeletedEmployees": {},
+	"/employee.v1.EmployeeService/RestoreEmployee":      {},
+	"/employee.v1.EmployeeService/PurgeEmployee":        {},
+
+	"/salary.v1.SalaryService/CalculateNetSalary":      {entity.RoleHR},
+	"/salary.v1.SalaryService/GetSalaryStatsByCountry": {entity.RoleHR, entity.RoleManager},
+	"/salary.v1.SalaryService/GetAvgSalaryByJobTitle":  {entity.RoleHR, entity.RoleManager},
+	"/salary.v1.SalaryService/GetSalaryDistribution":   {entity.RoleHR, entity.RoleManager},
+	"/salary.v1.SalaryService/AggregateSalaries":       {entity.RoleHR, entity.RoleManager},
+	"/salary.v1.SalaryService/GetDepartmentPayroll":    {entity.RoleHR, entity.RoleManager},
+
+	"/taxrule.v1.TaxRuleService/CreateTaxRule": {},
+	"/taxrule.v1.TaxRuleService/GetTaxRule":    {entity.RoleHR, entity.RoleManager, entity.RoleViewer},
+	"/taxrule.v1.TaxRuleService/ListTaxRules":  {entity.RoleHR, entity.RoleManager, entity.RoleViewer},
+	"/taxrule.v1.TaxRuleService/RetireTaxRule": {},
+
+	"/organization.v1.OrganizationService/CreateDepartment": {entity.RoleHR},
+	"/organization.v1.OrganizationService/GetDepartment":    {entity.RoleHR, entity.RoleManager, entity.RoleViewer},
+	"/organization.v1.OrganizationService/ListDepartments":  {entity.RoleHR, entity.RoleManager, entity.RoleViewer},
+	"/organization.v1.OrganizationService/UpdateDepartment": {entity.RoleHR},
+	"/organization.v1.OrganizationService/DeleteDepartment": {entity.RoleHR},
+	"/organization.v1.OrganizationService/CreateCostCenter": {entity.RoleHR},
+	"/organization.v1.OrganizationService/GetCostCenter":    {entity.RoleHR, entity.RoleManager, entity.RoleViewer},
+	"/organization.v1.OrganizationService/ListCostCenters":  {entity.RoleHR, entity.RoleManager, entity.RoleViewer},
+	"/organization.v1.OrganizationService/UpdateCostCenter": {entity.RoleHR},
+	"/organization.v1.OrganizationService/DeleteCostCenter": {entity.RoleHR},
+
+	"/exchangerate.v1.ExchangeRateService/SetExchangeRates":  {},
+	"/exchangerate.v1.ExchangeRateService/ListExchangeRates": {entity.RoleHR, entity.RoleManager, entity.RoleViewer},
+
+	"/payslip.v1.PayslipService/GeneratePayslip": {entity.RoleHR},
+}
+
+func isPublicMethod(method string) bool {
+	return publicMethods[method]
+}
+
+func isAllowed(method string, role entity.Role) bool {
+	if role == entity.RoleAdmin {
+		return true
+	}
+	for _, r := range methodRoles[method] {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -2,11 +2,15 @@ package grpc
 
 import (
 	"context"
+	"time"
 
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
 	salaryuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/salary"
 	salaryv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/salary/v1"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // salaryServer implements the SalaryServiceServer interface.
@@ -29,22 +33,53 @@ func (s *salaryServer) CalculateNetSalary(ctx context.Context, req *salaryv1.Cal
 		return nil, ToGRPCError(errors.NewValidationError("invalid employee_id format"))
 	}
 
-	salary, err := s.service.CalculateNetSalary(ctx, employeeID)
+	var asOf time.Time
+	if req.GetAsOf() != nil {
+		asOf = req.GetAsOf().AsTime()
+	}
+
+	salary, err := s.service.CalculateNetSalary(ctx, employeeID, asOf)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
 
+	brackets := make([]*salaryv1.TaxBracketBreakdown, 0, len(salary.Brackets))
+	for _, b := range salary.Brackets {
+		upperBound := ""
+		if b.UpperBound != nil {
+			upperBound = b.UpperBound.String()
+		}
+		brackets = append(brackets, &salaryv1.TaxBracketBreakdown{
+			LowerBound:    b.LowerBound.String(),
+			UpperBound:    upperBound,
+			Rate:          b.Rate.String(),
+			TaxableAmount: b.TaxableAmount.String(),
+			TaxAmount:     b.TaxAmount.String(),
+		})
+	}
+
 	return &salaryv1.CalculateNetSalaryResponse{
-		GrossSalary: salary.GrossSalary.String(),
-		TaxRate:     salary.TaxRate.String(),
-		TaxAmount:   salary.TaxAmount.String(),
-		NetSalary:   salary.NetSalary.String(),
+		Currency:          salary.Currency.String(),
+		GrossSalary:       salary.GrossSalary.String(),
+		TaxRate:           salary.TaxRate.String(),
+		TaxAmount:         salary.TaxAmount.String(),
+		NetSalary:         salary.NetSalary.String(),
+		StandardDeduction: salary.StandardDeduction.String(),
+		TaxableIncome:     salary.TaxableIncome.String(),
+		Brackets:          brackets,
+		CapAdjustment:     salary.CapAdjustment.String(),
 	}, nil
 }
 
 // GetSalaryStatsByCountry returns salary statistics for a country.
 func (s *salaryServer) GetSalaryStatsByCountry(ctx context.Context, req *salaryv1.GetSalaryStatsByCountryRequest) (*salaryv1.SalaryStatsResponse, error) {
-	stats, err := s.service.GetSalaryStatsByCountry(ctx, req.GetCountry())
+	reportsTo, err := optionalUUID(req.GetReportsTo())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid reports_to format"))
+	}
+
+	reporting := reportingFromProto(req.GetReportingCurrency(), req.GetAsOf())
+	stats, err := s.service.GetSalaryStatsByCountry(ctx, req.GetCountry(), reportsTo, reporting)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
@@ -54,12 +89,19 @@ func (s *salaryServer) GetSalaryStatsByCountry(ctx context.Context, req *salaryv
 		MaxSalary: stats.MaxSalary.String(),
 		AvgSalary: stats.AvgSalary.String(),
 		Count:     stats.Count,
+		Currency:  stats.Currency.String(),
 	}, nil
 }
 
 // GetAvgSalaryByJobTitle returns average salary for a job title.
 func (s *salaryServer) GetAvgSalaryByJobTitle(ctx context.Context, req *salaryv1.GetAvgSalaryByJobTitleRequest) (*salaryv1.JobTitleSalaryStatsResponse, error) {
-	stats, err := s.service.GetAvgSalaryByJobTitle(ctx, req.GetJobTitle())
+	reportsTo, err := optionalUUID(req.GetReportsTo())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid reports_to format"))
+	}
+
+	reporting := reportingFromProto(req.GetReportingCurrency(), req.GetAsOf())
+	stats, err := s.service.GetAvgSalaryByJobTitle(ctx, req.GetJobTitle(), reportsTo, reporting)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
@@ -68,5 +110,253 @@ func (s *salaryServer) GetAvgSalaryByJobTitle(ctx context.Context, req *salaryv1
 		JobTitle:  stats.JobTitle,
 		AvgSalary: stats.AvgSalary.String(),
 		Count:     stats.Count,
+		Currency:  stats.Currency.String(),
 	}, nil
 }
+
+// GetSalaryDistribution returns the distribution of the salaries of the
+// employees matching the filter.
+func (s *salaryServer) GetSalaryDistribution(ctx context.Context, req *salaryv1.GetSalaryDistributionRequest) (*salaryv1.SalaryDistributionResponse, error) {
+	filter, err := employeeFilterFromProto(req)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+	filter.Statuses = statusesFromSalaryProto(req.GetStatuses())
+
+	distribution, err := s.service.GetSalaryDistribution(ctx, salaryuc.DistributionParams{
+		Filter:    filter,
+		Reporting: reportingFromProto(req.GetReportingCurrency(), req.GetAsOf()),
+		Buckets:   int(req.GetHistogramBuckets()),
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	histogram := make([]*salaryv1.SalaryBucket, 0, len(distribution.Histogram))
+	for _, b := range distribution.Histogram {
+		histogram = append(histogram, &salaryv1.SalaryBucket{
+			LowerBound: b.LowerBound.String(),
+			UpperBound: b.UpperBound.String(),
+			Count:      b.Count,
+		})
+	}
+
+	return &salaryv1.SalaryDistributionResponse{
+		Currency:     distribution.Currency.String(),
+		Count:        distribution.Count,
+		MinSalary:    distribution.MinSalary.String(),
+		MaxSalary:    distribution.MaxSalary.String(),
+		AvgSalary:    distribution.AvgSalary.String(),
+		MedianSalary: distribution.MedianSalary.String(),
+		P10Salary:    distribution.P10Salary.String(),
+		P25Salary:    distribution.P25Salary.String(),
+		P75Salary:    distribution.P75Salary.String(),
+		P90Salary:    distribution.P90Salary.String(),
+		Stddev:       distribution.StdDev.String(),
+		Histogram:    histogram,
+	}, nil
+}
+
+// AggregateSalaries returns the requested metrics for each group of
+// employees matching the filter.
+func (s *salaryServer) AggregateSalaries(ctx context.Context, req *salaryv1.AggregateSalariesRequest) (*salaryv1.AggregateSalariesResponse, error) {
+	filter, err := employeeFilterFromProto(req)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+	filter.Statuses = statusesFromSalaryProto(req.GetStatuses())
+
+	params := salaryuc.AggregateParams{
+		Filter:    filter,
+		Reporting: reportingFromProto(req.GetReportingCurrency(), req.GetAsOf()),
+	}
+	for _, d := range req.GetGroupBy() {
+		params.Dimensions = append(params.Dimensions, salaryDimensionFromProto(d))
+	}
+	for _, m := range req.GetMetrics() {
+		params.Metrics = append(params.Metrics, salaryMetricFromProto(m))
+	}
+
+	aggregate, err := s.service.AggregateSalaries(ctx, params)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	resp := &salaryv1.AggregateSalariesResponse{
+		Groups: make([]*salaryv1.SalaryGroup, 0, len(aggregate.Groups)),
+	}
+	for _, d := range aggregate.Dimensions {
+		resp.GroupBy = append(resp.GroupBy, salaryDimensions[d])
+	}
+	for _, m := range aggregate.Metrics {
+		resp.Metrics = append(resp.Metrics, salaryMetrics[m])
+	}
+	for _, g := range aggregate.Groups {
+		values := make([]string, 0, len(g.Values))
+		for _, v := range g.Values {
+			values = append(values, v.String())
+		}
+		resp.Groups = append(resp.Groups, &salaryv1.SalaryGroup{
+			Keys:     g.Keys,
+			Values:   values,
+			Currency: g.Currency.String(),
+		})
+	}
+	return resp, nil
+}
+
+// GetDepartmentPayroll returns the payroll cost of each department.
+func (s *salaryServer) GetDepartmentPayroll(ctx context.Context, req *salaryv1.GetDepartmentPayrollRequest) (*salaryv1.DepartmentPayrollResponse, error) {
+	root, err := optionalUUID(req.GetDepartmentId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid department_id format"))
+	}
+
+	payroll, err := s.service.GetDepartmentPayroll(ctx, salaryuc.PayrollParams{
+		Root:      root,
+		Statuses:  statusesFromSalaryProto(req.GetStatuses()),
+		Reporting: reportingFromProto(req.GetReportingCurrency(), req.GetAsOf()),
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	resp := &salaryv1.DepartmentPayrollResponse{
+		Currency:    payroll.Currency.String(),
+		Departments: make([]*salaryv1.DepartmentPayroll, 0, len(payroll.Departments)),
+	}
+	for _, d := range payroll.Departments {
+		row := &salaryv1.DepartmentPayroll{
+			Depth:          int32(d.Depth),
+			Headcount:      d.Headcount,
+			Cost:           d.Cost.String(),
+			TotalHeadcount: d.TotalHeadcount,
+			TotalCost:      d.TotalCost.String(),
+		}
+		if d.Department != nil {
+			row.DepartmentId = d.Department.ID.String()
+			row.Name = d.Department.Name
+			if d.Department.ParentID != nil {
+				row.ParentId = d.Department.ParentID.String()
+			}
+		}
+		resp.Departments = append(resp.Departments, row)
+	}
+	return resp, nil
+}
+
+// employeeFilterRequest is implemented by requests that filter employees.
+type employeeFilterRequest interface {
+	GetCountry() string
+	GetJobTitle() string
+	GetMinSalary() string
+	GetMaxSalary() string
+	GetCreatedAfter() *timestamppb.Timestamp
+	GetCreatedBefore() *timestamppb.Timestamp
+	GetUpdatedAfter() *timestamppb.Timestamp
+	GetUpdatedBefore() *timestamppb.Timestamp
+	GetReportsTo() string
+	GetDepartmentId() string
+	GetCostCenterId() string
+}
+
+func employeeFilterFromProto(req employeeFilterRequest) (repository.EmployeeFilter, error) {
+	filter := repository.EmployeeFilter{
+		Country:       req.GetCountry(),
+		JobTitle:      req.GetJobTitle(),
+		CreatedAfter:  optionalTime(req.GetCreatedAfter()),
+		CreatedBefore: optionalTime(req.GetCreatedBefore()),
+		UpdatedAfter:  optionalTime(req.GetUpdatedAfter()),
+		UpdatedBefore: optionalTime(req.GetUpdatedBefore()),
+	}
+
+	var err error
+	if filter.MinSalary, err = optionalDecimal(req.GetMinSalary()); err != nil {
+		return filter, errors.NewValidationError("invalid min_salary format")
+	}
+	if filter.MaxSalary, err = optionalDecimal(req.GetMaxSalary()); err != nil {
+		return filter, errors.NewValidationError("invalid max_salary format")
+	}
+	if filter.ReportsTo, err = optionalUUID(req.GetReportsTo()); err != nil {
+		return filter, errors.NewValidationError("invalid reports_to format")
+	}
+	if filter.DepartmentID, err = optionalUUID(req.GetDepartmentId()); err != nil {
+		return filter, errors.NewValidationError("invalid department_id format")
+	}
+	if filter.CostCenterID, err = optionalUUID(req.GetCostCenterId()); err != nil {
+		return filter, errors.NewValidationError("invalid cost_center_id format")
+	}
+	return filter, nil
+}
+
+var salaryEmploymentStatuses = map[entity.EmploymentStatus]salaryv1.EmploymentStatus{
+	entity.EmploymentStatusPending:    salaryv1.EmploymentStatus_EMPLOYMENT_STATUS_PENDING,
+	entity.EmploymentStatusActive:     salaryv1.EmploymentStatus_EMPLOYMENT_STATUS_ACTIVE,
+	entity.EmploymentStatusOnLeave:    salaryv1.EmploymentStatus_EMPLOYMENT_STATUS_ON_LEAVE,
+	entity.EmploymentStatusTerminated: salaryv1.EmploymentStatus_EMPLOYMENT_STATUS_TERMINATED,
+}
+
+// statusesFromSalaryProto maps EMPLOYMENT_STATUS_UNSPECIFIED to an empty,
+// invalid status; the service rejects it.
+func statusesFromSalaryProto(statuses []salaryv1.EmploymentStatus) []entity.EmploymentStatus {
+	var result []entity.EmploymentStatus
+	for _, status := range statuses {
+		var match entity.EmploymentStatus
+		for s, p := range salaryEmploymentStatuses {
+			if p == status {
+				match = s
+			}
+		}
+		result = append(result, match)
+	}
+	return result
+}
+
+var salaryDimensions = map[repository.SalaryDimension]salaryv1.SalaryDimension{
+	repository.DimensionCountry:    salaryv1.SalaryDimension_SALARY_DIMENSION_COUNTRY,
+	repository.DimensionJobTitle:   salaryv1.SalaryDimension_SALARY_DIMENSION_JOB_TITLE,
+	repository.DimensionHireYear:   salaryv1.SalaryDimension_SALARY_DIMENSION_HIRE_YEAR,
+	repository.DimensionCurrency:   salaryv1.SalaryDimension_SALARY_DIMENSION_CURRENCY,
+	repository.DimensionDepartment: salaryv1.SalaryDimension_SALARY_DIMENSION_DEPARTMENT,
+	repository.DimensionCostCenter: salaryv1.SalaryDimension_SALARY_DIMENSION_COST_CENTER,
+}
+
+// salaryDimensionFromProto returns an empty, invalid dimension for
+// SALARY_DIMENSION_UNSPECIFIED; the service rejects it.
+func salaryDimensionFromProto(dimension salaryv1.SalaryDimension) repository.SalaryDimension {
+	for d, p := range salaryDimensions {
+		if p == dimension {
+			return d
+		}
+	}
+	return ""
+}
+
+var salaryMetrics = map[repository.SalaryMetric]salaryv1.SalaryMetric{
+	repository.MetricCount:  salaryv1.SalaryMetric_SALARY_METRIC_COUNT,
+	repository.MetricSum:    salaryv1.SalaryMetric_SALARY_METRIC_SUM,
+	repository.MetricMin:    salaryv1.SalaryMetric_SALARY_METRIC_MIN,
+	repository.MetricMax:    salaryv1.SalaryMetric_SALARY_METRIC_MAX,
+	repository.MetricAvg:    salaryv1.SalaryMetric_SALARY_METRIC_AVG,
+	repository.MetricMedian: salaryv1.SalaryMetric_SALARY_METRIC_MEDIAN,
+	repository.MetricStdDev: salaryv1.SalaryMetric_SALARY_METRIC_STDDEV,
+}
+
+// salaryMetricFromProto returns an empty, invalid metric for
+// SALARY_METRIC_UNSPECIFIED; the service rejects it.
+func salaryMetricFromProto(metric salaryv1.SalaryMetric) repository.SalaryMetric {
+	for m, p := range salaryMetrics {
+		if p == metric {
+			return m
+		}
+	}
+	return ""
+}
+
+func reportingFromProto(currency string, asOf *timestamppb.Timestamp) salaryuc.Reporting {
+	reporting := salaryuc.Reporting{Currency: currency}
+	if asOf != nil {
+		reporting.AsOf = asOf.AsTime()
+	}
+	return reporting
+}
@@ -2,40 +2,71 @@ package grpc
 
 import (
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/auth"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/health"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/metrics"
 	authuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/auth"
 	employeeuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/employee"
+	exchangerateuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/exchangerate"
+	organizationuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/organization"
+	payslipuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/payslip"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/salary"
+	taxruleuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/taxrule"
 	authv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/auth/v1"
 	employeev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/employee/v1"
+	exchangeratev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/exchangerate/v1"
+	organizationv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/organization/v1"
+	payslipv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/payslip/v1"
 	salaryv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/salary/v1"
+	taxrulev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/taxrule/v1"
 	"github.com/go-kit/log"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 // ServerConfig holds the configuration for the gRPC server.
 type ServerConfig struct {
-	AuthService     authuc.Service
-	EmployeeService employeeuc.Service
-	SalaryService   salary.Service
-	Logger          log.Logger
-	JWTManager      *auth.JWTManager
+	AuthService         authuc.Service
+	EmployeeService     employeeuc.Service
+	SalaryService       salary.Service
+	TaxRuleService      taxruleuc.Service
+	ExchangeRateService exchangerateuc.Service
+	PayslipService      payslipuc.Service
+	OrganizationService organizationuc.Service
+	Logger              log.Logger
+	JWTManager          *auth.JWTManager
+	Health              *health.Checker
+	Metrics             *metrics.RPCMetrics
 }
 
 // NewServer creates a new gRPC server with all services registered.
 func NewServer(cfg ServerConfig) *grpc.Server {
 	// Create interceptor chain
 	interceptors := grpc.ChainUnaryInterceptor(
+		MetricsInterceptor(cfg.Metrics),
 		RecoveryInterceptor(cfg.Logger),
 		LoggingInterceptor(cfg.Logger),
 		AuthInterceptor(cfg.JWTManager),
 	)
 
 	// Create gRPC server with interceptors
-	server := grpc.NewServer(interceptors)
+	server := grpc.NewServer(
+		interceptors,
+		grpc.ChainStreamInterceptor(
+			MetricsStreamInterceptor(cfg.Metrics),
+			RecoveryStreamInterceptor(cfg.Logger),
+			LoggingStreamInterceptor(cfg.Logger),
+			AuthStreamInterceptor(cfg.JWTManager),
+		),
+	)
 	authv1.RegisterAuthServiceServer(server, NewAuthServer(cfg.AuthService))
 	employeev1.RegisterEmployeeServiceServer(server, NewEmployeeServer(cfg.EmployeeService))
 	salaryv1.RegisterSalaryServiceServer(server, NewSalaryServer(cfg.SalaryService))
+	taxrulev1.RegisterTaxRuleServiceServer(server, NewTaxRuleServer(cfg.TaxRuleService))
+	exchangeratev1.RegisterExchangeRateServiceServer(server, NewExchangeRateServer(cfg.ExchangeRateService))
+	payslipv1.RegisterPayslipServiceServer(server, NewPayslipServer(cfg.PayslipService))
+	organizationv1.RegisterOrganizationServiceServer(server, NewOrganizationServer(cfg.OrganizationService))
+	healthpb.RegisterHealthServer(server, NewHealthServer(cfg.Health, server))
 	// Enable reflection for grpcurl and other tools
 	reflection.Register(server)
 
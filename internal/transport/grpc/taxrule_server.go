@@ -0,0 +1,180 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	taxruleuc "github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/taxrule"
+	taxrulev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/taxrule/v1"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// taxRuleServer implements the TaxRuleServiceServer interface.
+type taxRuleServer struct {
+	taxrulev1.UnimplementedTaxRuleServiceServer
+	service taxruleuc.Service
+}
+
+// NewTaxRuleServer creates a new gRPC tax rule server.
+func NewTaxRuleServer(service taxruleuc.Service) taxrulev1.TaxRuleServiceServer {
+	return &taxRuleServer{
+		service: service,
+	}
+}
+
+// CreateTaxRule adds a new effective-dated tax rule.
+func (s *taxRuleServer) CreateTaxRule(ctx context.Context, req *taxrulev1.CreateTaxRuleRequest) (*taxrulev1.TaxRule, error) {
+	var ruleType entity.TaxRuleType
+	switch req.GetRuleType() {
+	case taxrulev1.TaxRuleType_TAX_RULE_TYPE_FLAT:
+		ruleType = entity.TaxRuleTypeFlat
+	case taxrulev1.TaxRuleType_TAX_RULE_TYPE_PROGRESSIVE:
+		ruleType = entity.TaxRuleTypeProgressive
+	}
+
+	rate, err := decimalOrZero(req.GetRate())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid rate format"))
+	}
+	standardDeduction, err := decimalOrZero(req.GetStandardDeduction())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid standard_deduction format"))
+	}
+	maxTax, err := optionalDecimal(req.GetMaxTax())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid max_tax format"))
+	}
+
+	bands := make(entity.TaxBands, 0, len(req.GetBands()))
+	for _, b := range req.GetBands() {
+		upTo, err := optionalDecimal(b.GetUpTo())
+		if err != nil {
+			return nil, ToGRPCError(errors.NewValidationError("invalid band up_to format"))
+		}
+		bandRate, err := decimal.NewFromString(b.GetRate())
+		if err != nil {
+			return nil, ToGRPCError(errors.NewValidationError("invalid band rate format"))
+		}
+		bands = append(bands, entity.TaxBand{UpTo: upTo, Rate: bandRate})
+	}
+
+	var effectiveFrom time.Time
+	if req.GetEffectiveFrom() != nil {
+		effectiveFrom = req.GetEffectiveFrom().AsTime()
+	}
+
+	nullMaxTax := decimal.NullDecimal{}
+	if maxTax != nil {
+		nullMaxTax = decimal.NewNullDecimal(*maxTax)
+	}
+
+	rule := entity.NewTaxRule(req.GetCountry(), ruleType, rate, bands, standardDeduction, nullMaxTax, effectiveFrom)
+	rule, err = s.service.Create(ctx, rule)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return taxRuleToProto(rule), nil
+}
+
+// GetTaxRule returns a tax rule by id.
+func (s *taxRuleServer) GetTaxRule(ctx context.Context, req *taxrulev1.GetTaxRuleRequest) (*taxrulev1.TaxRule, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid tax rule id format"))
+	}
+
+	rule, err := s.service.GetByID(ctx, id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return taxRuleToProto(rule), nil
+}
+
+// ListTaxRules returns the tax rule history.
+func (s *taxRuleServer) ListTaxRules(ctx context.Context, req *taxrulev1.ListTaxRulesRequest) (*taxrulev1.ListTaxRulesResponse, error) {
+	rules, err := s.service.List(ctx, req.GetCountry())
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	result := make([]*taxrulev1.TaxRule, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, taxRuleToProto(r))
+	}
+
+	return &taxrulev1.ListTaxRulesResponse{
+		TaxRules: result,
+	}, nil
+}
+
+// RetireTaxRule ends a tax rule.
+func (s *taxRuleServer) RetireTaxRule(ctx context.Context, req *taxrulev1.RetireTaxRuleRequest) (*taxrulev1.TaxRule, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ToGRPCError(errors.NewValidationError("invalid tax rule id format"))
+	}
+
+	var effectiveTo time.Time
+	if req.GetEffectiveTo() != nil {
+		effectiveTo = req.GetEffectiveTo().AsTime()
+	}
+
+	rule, err := s.service.Retire(ctx, id, effectiveTo)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return taxRuleToProto(rule), nil
+}
+
+func taxRuleToProto(r *entity.TaxRule) *taxrulev1.TaxRule {
+	ruleType := taxrulev1.TaxRuleType_TAX_RULE_TYPE_FLAT
+	if r.RuleType == entity.TaxRuleTypeProgressive {
+		ruleType = taxrulev1.TaxRuleType_TAX_RULE_TYPE_PROGRESSIVE
+	}
+
+	bands := make([]*taxrulev1.TaxBand, 0, len(r.Bands))
+	for _, b := range r.Bands {
+		upTo := ""
+		if b.UpTo != nil {
+			upTo = b.UpTo.String()
+		}
+		bands = append(bands, &taxrulev1.TaxBand{UpTo: upTo, Rate: b.Rate.String()})
+	}
+
+	maxTax := ""
+	if r.MaxTax.Valid {
+		maxTax = r.MaxTax.Decimal.String()
+	}
+
+	var effectiveTo *timestamppb.Timestamp
+	if r.EffectiveTo != nil {
+		effectiveTo = timestamppb.New(*r.EffectiveTo)
+	}
+
+	return &taxrulev1.TaxRule{
+		Id:                r.ID.String(),
+		Country:           r.Country,
+		RuleType:          ruleType,
+		Rate:              r.Rate.String(),
+		Bands:             bands,
+		StandardDeduction: r.StandardDeduction.String(),
+		MaxTax:            maxTax,
+		EffectiveFrom:     timestamppb.New(r.EffectiveFrom),
+		EffectiveTo:       effectiveTo,
+		CreatedAt:         timestamppb.New(r.CreatedAt),
+	}
+}
+
+func decimalOrZero(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
@@ -0,0 +1,95 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	employeev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/employee/v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// exportFormat describes how one ?format= value of the export endpoint is
+// served.
+type exportFormat struct {
+	format      employeev1.ExportFormat
+	contentType string
+	extension   string
+}
+
+var exportFormats = map[string]exportFormat{
+	"csv":     {employeev1.ExportFormat_EXPORT_FORMAT_CSV, "text/csv; charset=utf-8", "csv"},
+	"jsonl":   {employeev1.ExportFormat_EXPORT_FORMAT_JSONL, "application/jsonl", "jsonl"},
+	"parquet": {employeev1.ExportFormat_EXPORT_FORMAT_PARQUET, "application/vnd.apache.parquet", "parquet"},
+}
+
+// exportHandler relays the server-streaming ExportEmployees RPC as a file
+// download, writing each chunk as it arrives.
+type exportHandler struct {
+	client employeev1.EmployeeServiceClient
+}
+
+func (h *exportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	name := params.Get("format")
+	if name == "" {
+		name = "csv"
+	}
+	format, ok := exportFormats[name]
+	if !ok {
+		writeError(w, http.StatusBadRequest, `invalid value for "format"; expected csv, jsonl or parquet`)
+		return
+	}
+	params.Del("format")
+
+	req := &employeev1.ExportEmployeesRequest{Format: format.format}
+	if err := mergeParams(req, params); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	stream, err := h.client.ExportEmployees(ctx, req)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+
+	// Wait for the first chunk so that a rejected request still gets a JSON
+	// error with the right status.
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		writeRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="employees.`+format.extension+`"`)
+	w.WriteHeader(http.StatusOK)
+	if first == nil {
+		return
+	}
+
+	flusher := http.NewResponseController(w)
+	msg := first
+	for {
+		if _, err := w.Write(msg.GetChunk()); err != nil {
+			return
+		}
+		_ = flusher.Flush()
+
+		msg, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			// The status line has been sent, so the only way to tell the
+			// client the file is incomplete is to break the connection.
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
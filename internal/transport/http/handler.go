@@ -0,0 +1,183 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const maxBodyBytes = 1 << 20
+
+var pathParamRegex = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+var marshalOptions = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: true,
+}
+
+// rpcHandler forwards one REST route to its gRPC method.
+type rpcHandler struct {
+	conn       grpc.ClientConnInterface
+	route      route
+	input      protoreflect.MessageType
+	output     protoreflect.MessageType
+	pathParams []string
+}
+
+func newRPCHandler(conn grpc.ClientConnInterface, rt route) (*rpcHandler, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(rt.rpc, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a method", name)
+	}
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		return nil, fmt.Errorf("%s is a streaming method", name)
+	}
+
+	input, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return nil, err
+	}
+	output, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParams []string
+	for _, m := range pathParamRegex.FindAllStringSubmatch(rt.pattern, -1) {
+		pathParams = append(pathParams, m[1])
+	}
+
+	return &rpcHandler{
+		conn:       conn,
+		route:      rt,
+		input:      input,
+		output:     output,
+		pathParams: pathParams,
+	}, nil
+}
+
+func (h *rpcHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req := h.input.New().Interface()
+	if err := h.decode(r, req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	resp := h.output.New().Interface()
+	if err := h.conn.Invoke(ctx, h.route.rpc, req, resp); err != nil {
+		writeRPCError(w, err)
+		return
+	}
+
+	writeMessage(w, h.route.status, resp)
+}
+
+// decode fills req from the JSON body, then the query string, then the path;
+// later sources win.
+func (h *rpcHandler) decode(r *http.Request, req proto.Message) error {
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodyBytes))
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+		if len(body) > 0 {
+			if err := protojson.Unmarshal(body, req); err != nil {
+				return fmt.Errorf("invalid request body: %w", err)
+			}
+		}
+	}
+
+	params := make(map[string][]string)
+	for key, values := range r.URL.Query() {
+		params[key] = values
+	}
+	for _, name := range h.pathParams {
+		params[name] = []string{r.PathValue(name)}
+	}
+
+	return mergeParams(req, params)
+}
+
+// mergeParams sets top-level fields of msg from string parameters. Values are
+// converted to JSON and decoded with protojson so enums, 64-bit integers and
+// timestamps follow the same rules as request bodies.
+func mergeParams(msg proto.Message, params map[string][]string) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	values := make(map[string]interface{}, len(params))
+	for key, raw := range params {
+		fd := fields.ByName(protoreflect.Name(key))
+		if fd == nil {
+			fd = fields.ByJSONName(key)
+		}
+		if fd == nil {
+			return fmt.Errorf("unknown parameter %q", key)
+		}
+
+		converted := make([]interface{}, 0, len(raw))
+		for _, v := range raw {
+			if fd.Kind() == protoreflect.BoolKind {
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return fmt.Errorf("invalid value for %q", key)
+				}
+				converted = append(converted, b)
+				continue
+			}
+			converted = append(converted, v)
+		}
+
+		if fd.IsList() {
+			values[string(fd.Name())] = converted
+		} else if len(converted) > 0 {
+			values[string(fd.Name())] = converted[len(converted)-1]
+		}
+	}
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	decoded := msg.ProtoReflect().New().Interface()
+	if err := protojson.Unmarshal(raw, decoded); err != nil {
+		return fmt.Errorf("invalid parameters: %w", err)
+	}
+	proto.Merge(msg, decoded)
+
+	return nil
+}
+
+func writeMessage(w http.ResponseWriter, status int, msg proto.Message) {
+	body, err := marshalOptions.Marshal(msg)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/health"
+)
+
+// livenessHandler reports that the process is up. It runs no dependency
+// checks, so a database outage does not get the container restarted.
+func livenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, health.Report{Status: health.StatusUp})
+	})
+}
+
+// readinessHandler reports the status of every dependency and answers 503
+// while any of them is down or the server is shutting down.
+func readinessHandler(checker *health.Checker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := checker.Check(r.Context())
+		status := http.StatusOK
+		if !report.Ready() {
+			status = http.StatusServiceUnavailable
+		}
+		writeJSON(w, status, report)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	employeev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/employee/v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// importChunkSize is the size of the CSV chunks forwarded to ImportEmployees.
+const importChunkSize = 32 << 10
+
+// importHandler streams a CSV request body to the client-streaming
+// ImportEmployees RPC without buffering the whole file.
+type importHandler struct {
+	client employeev1.EmployeeServiceClient
+}
+
+func (h *importHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, `invalid value for "dry_run"`)
+			return
+		}
+		dryRun = b
+	}
+
+	ctx := r.Context()
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	stream, err := h.client.ImportEmployees(ctx)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+
+	options := &employeev1.ImportEmployeesRequest{
+		Payload: &employeev1.ImportEmployeesRequest_Options{Options: &employeev1.ImportOptions{DryRun: dryRun}},
+	}
+	if err := stream.Send(options); err != nil && !errors.Is(err, io.EOF) {
+		writeRPCError(w, err)
+		return
+	}
+
+	buf := make([]byte, importChunkSize)
+	for {
+		n, readErr := r.Body.Read(buf)
+		if n > 0 {
+			chunk := &employeev1.ImportEmployeesRequest{
+				Payload: &employeev1.ImportEmployeesRequest_Chunk{Chunk: append([]byte(nil), buf[:n]...)},
+			}
+			// io.EOF means the server has already ended the call; its
+			// status is returned by CloseAndRecv.
+			if err := stream.Send(chunk); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				writeRPCError(w, err)
+				return
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+
+	writeMessage(w, http.StatusOK, resp)
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer, so
+// streaming handlers can flush through the middleware.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// LoggingMiddleware logs every HTTP request.
+func LoggingMiddleware(logger log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rec, r)
+
+			_ = level.Info(logger).Log(
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", rec.status,
+				"duration", time.Since(start).String(),
+			)
+		})
+	}
+}
+
+// RecoveryMiddleware turns panics into 500 responses. http.ErrAbortHandler
+// is re-raised so the server aborts the response without logging it.
+func RecoveryMiddleware(logger log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if rec := recover(); rec != nil {
+					if rec == http.ErrAbortHandler {
+						panic(rec)
+					}
+					_ = level.Error(logger).Log(
+						"msg", "panic recovered",
+						"path", r.URL.Path,
+						"panic", rec,
+						"stack", string(debug.Stack()),
+					)
+					writeError(w, http.StatusInternalServerError, "internal server error")
+				}
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
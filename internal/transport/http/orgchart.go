@@ -0,0 +1,51 @@
+package http
+
+import (
+	"net/http"
+
+	employeev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/employee/v1"
+	"google.golang.org/grpc/metadata"
+)
+
+var orgChartFormats = map[string]employeev1.OrgChartFormat{
+	"dot":  employeev1.OrgChartFormat_ORG_CHART_FORMAT_DOT,
+	"json": employeev1.OrgChartFormat_ORG_CHART_FORMAT_JSON,
+}
+
+// orgChartHandler serves the ExportOrgChart RPC as a file download.
+type orgChartHandler struct {
+	client employeev1.EmployeeServiceClient
+}
+
+func (h *orgChartHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	name := params.Get("format")
+	if name == "" {
+		name = "dot"
+	}
+	format, ok := orgChartFormats[name]
+	if !ok {
+		writeError(w, http.StatusBadRequest, `invalid value for "format"; expected dot or json`)
+		return
+	}
+	params.Del("format")
+
+	req := &employeev1.ExportOrgChartRequest{Format: format}
+	if err := mergeParams(req, params); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	resp, err := h.client.ExportOrgChart(ctx, req)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+
+	writeAttachment(w, resp.GetContentType(), resp.GetFilename(), resp.GetContent())
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	payslipv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/payslip/v1"
+	"google.golang.org/grpc/metadata"
+)
+
+var payslipFormats = map[string]payslipv1.PayslipFormat{
+	"pdf":  payslipv1.PayslipFormat_PAYSLIP_FORMAT_PDF,
+	"text": payslipv1.PayslipFormat_PAYSLIP_FORMAT_TEXT,
+}
+
+// payslipHandler serves the GeneratePayslip RPC as a file download rather
+// than a JSON document with base64 content.
+type payslipHandler struct {
+	client payslipv1.PayslipServiceClient
+}
+
+func (h *payslipHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	name := params.Get("format")
+	if name == "" {
+		name = "pdf"
+	}
+	format, ok := payslipFormats[name]
+	if !ok {
+		writeError(w, http.StatusBadRequest, `invalid value for "format"; expected pdf or text`)
+		return
+	}
+	params.Del("format")
+
+	req := &payslipv1.GeneratePayslipRequest{EmployeeId: r.PathValue("employee_id"), Format: format}
+	if err := mergeParams(req, params); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	resp, err := h.client.GeneratePayslip(ctx, req)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+
+	writeAttachment(w, resp.GetContentType(), resp.GetFilename(), resp.GetContent())
+}
+
+// writeAttachment sends content as a file to download.
+func writeAttachment(w http.ResponseWriter, contentType, filename string, content []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
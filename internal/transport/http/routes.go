@@ -0,0 +1,68 @@
+package http
+
+import (
+	"net/http"
+)
+
+// route exposes a unary gRPC method as a REST endpoint. Path wildcards and
+// query parameters are copied into request fields of the same name; for
+// methods with a body the JSON body is decoded into the request message.
+type route struct {
+	method  string
+	pattern string
+	rpc     string
+	status  int
+}
+
+var routes = []route{
+	{http.MethodPost, "/api/v1/auth/register", "/auth.v1.AuthService/Register", http.StatusCreated},
+	{http.MethodPost, "/api/v1/auth/login", "/auth.v1.AuthService/Login", http.StatusOK},
+	{http.MethodPost, "/api/v1/auth/refresh", "/auth.v1.AuthService/RefreshToken", http.StatusOK},
+	{http.MethodPost, "/api/v1/auth/logout", "/auth.v1.AuthService/Logout", http.StatusOK},
+	{http.MethodPut, "/api/v1/users/{user_id}/role", "/auth.v1.AuthService/AssignRole", http.StatusOK},
+
+	{http.MethodPost, "/api/v1/employees", "/employee.v1.EmployeeService/CreateEmployee", http.StatusCreated},
+	{http.MethodGet, "/api/v1/employees", "/employee.v1.EmployeeService/ListEmployees", http.StatusOK},
+	{http.MethodGet, "/api/v1/employees/search", "/employee.v1.EmployeeService/SearchEmployees", http.StatusOK},
+	{http.MethodGet, "/api/v1/employees/{id}", "/employee.v1.EmployeeService/GetEmployee", http.StatusOK},
+	{http.MethodPut, "/api/v1/employees/{id}", "/employee.v1.EmployeeService/UpdateEmployee", http.StatusOK},
+	{http.MethodPatch, "/api/v1/employees/{id}", "/employee.v1.EmployeeService/UpdateEmployee", http.StatusOK},
+	{http.MethodDelete, "/api/v1/employees/{id}", "/employee.v1.EmployeeService/DeleteEmployee", http.StatusOK},
+	{http.MethodGet, "/api/v1/employees/{employee_id}/history", "/employee.v1.EmployeeService/GetEmployeeHistory", http.StatusOK},
+	{http.MethodGet, "/api/v1/employees/deleted", "/employee.v1.EmployeeService/ListDeletedEmployees", http.StatusOK},
+	{http.MethodPost, "/api/v1/employees/{id}/restore", "/employee.v1.EmployeeService/RestoreEmployee", http.StatusOK},
+	{http.MethodPost, "/api/v1/employees/{id}/purge", "/employee.v1.EmployeeService/PurgeEmployee", http.StatusOK},
+	{http.MethodPost, "/api/v1/employees/{id}/terminate", "/employee.v1.EmployeeService/TerminateEmployee", http.StatusOK},
+	{http.MethodPost, "/api/v1/employees/{id}/rehire", "/employee.v1.EmployeeService/RehireEmployee", http.StatusOK},
+	{http.MethodPost, "/api/v1/employees/{employee_id}/compensation", "/employee.v1.EmployeeService/ScheduleCompensationChange", http.StatusCreated},
+	{http.MethodGet, "/api/v1/employees/{employee_id}/compensation", "/employee.v1.EmployeeService/ListCompensationHistory", http.StatusOK},
+	{http.MethodGet, "/api/v1/employees/{manager_id}/direct-reports", "/employee.v1.EmployeeService/ListDirectReports", http.StatusOK},
+	{http.MethodGet, "/api/v1/employees/{manager_id}/reports", "/employee.v1.EmployeeService/ListReports", http.StatusOK},
+	{http.MethodGet, "/api/v1/employees/{employee_id}/management-chain", "/employee.v1.EmployeeService/GetManagementChain", http.StatusOK},
+
+	{http.MethodGet, "/api/v1/employees/{employee_id}/net-salary", "/salary.v1.SalaryService/CalculateNetSalary", http.StatusOK},
+	{http.MethodGet, "/api/v1/salaries/stats/countries/{country}", "/salary.v1.SalaryService/GetSalaryStatsByCountry", http.StatusOK},
+	{http.MethodGet, "/api/v1/salaries/stats/job-titles/{job_title}", "/salary.v1.SalaryService/GetAvgSalaryByJobTitle", http.StatusOK},
+	{http.MethodGet, "/api/v1/salaries/distribution", "/salary.v1.SalaryService/GetSalaryDistribution", http.StatusOK},
+	{http.MethodGet, "/api/v1/salaries/aggregate", "/salary.v1.SalaryService/AggregateSalaries", http.StatusOK},
+	{http.MethodGet, "/api/v1/salaries/payroll/departments", "/salary.v1.SalaryService/GetDepartmentPayroll", http.StatusOK},
+
+	{http.MethodPost, "/api/v1/tax-rules", "/taxrule.v1.TaxRuleService/CreateTaxRule", http.StatusCreated},
+	{http.MethodGet, "/api/v1/tax-rules", "/taxrule.v1.TaxRuleService/ListTaxRules", http.StatusOK},
+	{http.MethodGet, "/api/v1/tax-rules/{id}", "/taxrule.v1.TaxRuleService/GetTaxRule", http.StatusOK},
+	{http.MethodPost, "/api/v1/tax-rules/{id}/retire", "/taxrule.v1.TaxRuleService/RetireTaxRule", http.StatusOK},
+
+	{http.MethodPost, "/api/v1/departments", "/organization.v1.OrganizationService/CreateDepartment", http.StatusCreated},
+	{http.MethodGet, "/api/v1/departments", "/organization.v1.OrganizationService/ListDepartments", http.StatusOK},
+	{http.MethodGet, "/api/v1/departments/{id}", "/organization.v1.OrganizationService/GetDepartment", http.StatusOK},
+	{http.MethodPut, "/api/v1/departments/{id}", "/organization.v1.OrganizationService/UpdateDepartment", http.StatusOK},
+	{http.MethodDelete, "/api/v1/departments/{id}", "/organization.v1.OrganizationService/DeleteDepartment", http.StatusOK},
+	{http.MethodPost, "/api/v1/cost-centers", "/organization.v1.OrganizationService/CreateCostCenter", http.StatusCreated},
+	{http.MethodGet, "/api/v1/cost-centers", "/organization.v1.OrganizationService/ListCostCenters", http.StatusOK},
+	{http.MethodGet, "/api/v1/cost-centers/{id}", "/organization.v1.OrganizationService/GetCostCenter", http.StatusOK},
+	{http.MethodPut, "/api/v1/cost-centers/{id}", "/organization.v1.OrganizationService/UpdateCostCenter", http.StatusOK},
+	{http.MethodDelete, "/api/v1/cost-centers/{id}", "/organization.v1.OrganizationService/DeleteCostCenter", http.StatusOK},
+
+	{http.MethodPut, "/api/v1/exchange-rates", "/exchangerate.v1.ExchangeRateService/SetExchangeRates", http.StatusOK},
+	{http.MethodGet, "/api/v1/exchange-rates", "/exchangerate.v1.ExchangeRateService/ListExchangeRates", http.StatusOK},
+}
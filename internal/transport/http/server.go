@@ -0,0 +1,61 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/health"
+	employeev1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/employee/v1"
+	payslipv1 "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/payslip/v1"
+	"github.com/go-kit/log"
+	"google.golang.org/grpc"
+
+	// Register the message types the gateway decodes requests into.
+	_ "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/auth/v1"
+	_ "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/exchangerate/v1"
+	_ "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/organization/v1"
+	_ "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/salary/v1"
+	_ "github.com/SRINIVAS-B-SINDAGI/employee-api/proto/taxrule/v1"
+)
+
+// ServerConfig holds the configuration for the HTTP gateway.
+type ServerConfig struct {
+	Addr string
+	// Conn is a client connection to this process's gRPC server. Requests
+	// are forwarded over it so they pass through the same interceptors
+	// (authentication, permissions, logging) as native gRPC calls.
+	Conn   grpc.ClientConnInterface
+	Health *health.Checker
+	Logger log.Logger
+}
+
+// NewServer creates an HTTP server exposing the gRPC services as JSON REST
+// endpoints.
+func NewServer(cfg ServerConfig) (*http.Server, error) {
+	mux := http.NewServeMux()
+	for _, rt := range routes {
+		h, err := newRPCHandler(cfg.Conn, rt)
+		if err != nil {
+			return nil, fmt.Errorf("route %s %s: %w", rt.method, rt.pattern, err)
+		}
+		mux.Handle(rt.method+" "+rt.pattern, h)
+	}
+
+	employees := employeev1.NewEmployeeServiceClient(cfg.Conn)
+	mux.Handle("POST /api/v1/employees/import", &importHandler{client: employees})
+	mux.Handle("GET /api/v1/employees/export", &exportHandler{client: employees})
+	mux.Handle("GET /api/v1/org-chart", &orgChartHandler{client: employees})
+	mux.Handle("GET /api/v1/employees/{employee_id}/payslip", &payslipHandler{client: payslipv1.NewPayslipServiceClient(cfg.Conn)})
+
+	mux.Handle("GET /healthz", livenessHandler())
+	mux.Handle("GET /readyz", readinessHandler(cfg.Health))
+	// /health is what the Docker health checks probe.
+	mux.Handle("GET /health", readinessHandler(cfg.Health))
+
+	return &http.Server{
+		Addr:              cfg.Addr,
+		Handler:           RecoveryMiddleware(cfg.Logger)(LoggingMiddleware(cfg.Logger)(mux)),
+		ReadHeaderTimeout: 10 * time.Second,
+	}, nil
+}
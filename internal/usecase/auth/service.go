@@ -2,29 +2,63 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
 
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/auth"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/validator"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type Service interface {
 	Register(ctx context.Context, email, password string) (*entity.User, error)
-	Login(ctx context.Context, email, password string) (string, error)
+	Login(ctx context.Context, email, password string) (*TokenPair, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error)
+	Logout(ctx context.Context, userID uuid.UUID, accessTokenID string, accessExpiresAt time.Time, refreshToken string) error
+	AssignRole(ctx context.Context, userID uuid.UUID, role entity.Role) (*entity.User, error)
+	BootstrapAdmin(ctx context.Context) error
+}
+
+// TokenPair is a short-lived access token and the refresh token that renews it.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
 }
 
 type service struct {
-	userRepo   repository.UserRepository
-	jwtManager *auth.JWTManager
+	userRepo            repository.UserRepository
+	refreshTokenRepo    repository.RefreshTokenRepository
+	revokedTokenRepo    repository.RevokedTokenRepository
+	jwtManager          *auth.JWTManager
+	bootstrapAdminEmail string
+	now                 func() time.Time
 }
 
-func NewService(userRepo repository.UserRepository, jwtManager *auth.JWTManager) Service {
+// NewService creates the auth service. bootstrapAdminEmail, when set, is the
+// account that becomes admin while the system has no admin yet.
+func NewService(
+	userRepo repository.UserRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	revokedTokenRepo repository.RevokedTokenRepository,
+	jwtManager *auth.JWTManager,
+	bootstrapAdminEmail string,
+) Service {
 	return &service{
-		userRepo:   userRepo,
-		jwtManager: jwtManager,
+		userRepo:            userRepo,
+		refreshTokenRepo:    refreshTokenRepo,
+		revokedTokenRepo:    revokedTokenRepo,
+		jwtManager:          jwtManager,
+		bootstrapAdminEmail: strings.TrimSpace(bootstrapAdminEmail),
+		now:                 time.Now,
 	}
 }
 
@@ -50,6 +84,15 @@ func (s *service) Register(ctx context.Context, email, password string) (*entity
 	}
 
 	user := entity.NewUser(email, string(hashedPassword))
+
+	bootstrap, err := s.needsBootstrapAdmin(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if bootstrap {
+		user.Role = entity.RoleAdmin
+	}
+
 	if err := s.userRepo.Create(ctx, user); err != nil {
 		return nil, err
 	}
@@ -57,30 +100,203 @@ func (s *service) Register(ctx context.Context, email, password string) (*entity
 	return user, nil
 }
 
-func (s *service) Login(ctx context.Context, email, password string) (string, error) {
+func (s *service) Login(ctx context.Context, email, password string) (*TokenPair, error) {
 	if err := validator.ValidateEmail(email); err != nil {
-		return "", err
+		return nil, err
 	}
 	if password == "" {
-		return "", errors.NewValidationError("password is required")
+		return nil, errors.NewValidationError("password is required")
 	}
 
 	user, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
 		if errors.IsNotFoundError(err) {
-			return "", errors.NewUnauthorizedError("invalid credentials")
+			return nil, errors.NewUnauthorizedError("invalid credentials")
 		}
-		return "", err
+		return nil, err
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
-		return "", errors.NewUnauthorizedError("invalid credentials")
+		return nil, errors.NewUnauthorizedError("invalid credentials")
+	}
+
+	refreshToken, record, err := s.newRefreshToken(user.ID, uuid.New())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenRepo.Create(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return s.issue(user, refreshToken)
+}
+
+// RefreshToken exchanges a refresh token for a new token pair. The presented
+// token is rotated out; presenting it again is treated as theft and revokes
+// every token of its login.
+func (s *service) RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	if refreshToken == "" {
+		return nil, errors.NewValidationError("refresh_token is required")
+	}
+
+	current, err := s.refreshTokenRepo.FindByHash(ctx, hashToken(refreshToken))
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.NewUnauthorizedError("invalid refresh token")
+		}
+		return nil, err
+	}
+
+	if current.IsRevoked() {
+		if err := s.refreshTokenRepo.RevokeFamily(ctx, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, errors.NewUnauthorizedError("refresh token reuse detected")
+	}
+	if current.IsExpired(s.now()) {
+		return nil, errors.NewUnauthorizedError("refresh token expired")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, current.UserID)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.NewUnauthorizedError("invalid refresh token")
+		}
+		return nil, err
+	}
+
+	next, record, err := s.newRefreshToken(user.ID, current.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenRepo.Rotate(ctx, current.ID, record); err != nil {
+		if !errors.IsConflictError(err) {
+			return nil, err
+		}
+		// Lost a race with another refresh of the same token: that is reuse too.
+		if err := s.refreshTokenRepo.RevokeFamily(ctx, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, errors.NewUnauthorizedError("refresh token reuse detected")
+	}
+
+	return s.issue(user, next)
+}
+
+// Logout revokes the caller's access token and, when given, the refresh
+// token family it belongs to.
+func (s *service) Logout(ctx context.Context, userID uuid.UUID, accessTokenID string, accessExpiresAt time.Time, refreshToken string) error {
+	if refreshToken != "" {
+		current, err := s.refreshTokenRepo.FindByHash(ctx, hashToken(refreshToken))
+		if err != nil && !errors.IsNotFoundError(err) {
+			return err
+		}
+		if current != nil {
+			if current.UserID != userID {
+				return errors.NewForbiddenError("refresh token belongs to another user")
+			}
+			if err := s.refreshTokenRepo.RevokeFamily(ctx, current.FamilyID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if accessTokenID == "" {
+		return nil
+	}
+	return s.revokedTokenRepo.Revoke(ctx, accessTokenID, accessExpiresAt)
+}
+
+func (s *service) issue(user *entity.User, refreshToken string) (*TokenPair, error) {
+	accessToken, err := s.jwtManager.GenerateToken(user.ID, user.Email, user.Role)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    s.jwtManager.Expiration(),
+	}, nil
+}
+
+// newRefreshToken generates an opaque refresh token and the record storing
+// its hash.
+func (s *service) newRefreshToken(userID, familyID uuid.UUID) (string, *entity.RefreshToken, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, errors.NewInternalError(err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	record := entity.NewRefreshToken(userID, familyID, hashToken(token), s.now().Add(s.jwtManager.RefreshExpiration()))
+	return token, record, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *service) AssignRole(ctx context.Context, userID uuid.UUID, role entity.Role) (*entity.User, error) {
+	if !role.IsValid() {
+		return nil, errors.NewValidationError("invalid role")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role == entity.RoleAdmin && role != entity.RoleAdmin {
+		admins, err := s.userRepo.CountByRole(ctx, entity.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if admins <= 1 {
+			return nil, errors.NewConflictError("cannot remove the last admin")
+		}
+	}
+
+	if err := s.userRepo.UpdateRole(ctx, user.ID, role); err != nil {
+		return nil, err
 	}
+	user.Role = role
+
+	return user, nil
+}
 
-	token, err := s.jwtManager.GenerateToken(user.ID, user.Email)
+// BootstrapAdmin promotes the configured bootstrap account to admin if it
+// is already registered and no admin exists yet. It is a no-op otherwise.
+func (s *service) BootstrapAdmin(ctx context.Context) error {
+	if s.bootstrapAdminEmail == "" {
+		return nil
+	}
+
+	bootstrap, err := s.needsBootstrapAdmin(ctx, s.bootstrapAdminEmail)
+	if err != nil || !bootstrap {
+		return err
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, s.bootstrapAdminEmail)
 	if err != nil {
-		return "", errors.NewInternalError(err)
+		if errors.IsNotFoundError(err) {
+			return nil
+		}
+		return err
 	}
 
-	return token, nil
+	return s.userRepo.UpdateRole(ctx, user.ID, entity.RoleAdmin)
+}
+
+func (s *service) needsBootstrapAdmin(ctx context.Context, email string) (bool, error) {
+	if s.bootstrapAdminEmail == "" || !strings.EqualFold(email, s.bootstrapAdminEmail) {
+		return false, nil
+	}
+
+	admins, err := s.userRepo.CountByRole(ctx, entity.RoleAdmin)
+	if err != nil {
+		return false, err
+	}
+	return admins == 0, nil
 }
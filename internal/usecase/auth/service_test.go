@@ -2,8 +2,8 @@ package auth
 
 import (
 	"context"
-
 	"testing"
+	"time"
 
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/infrastructure/auth"
@@ -45,17 +45,68 @@ func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (b
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockUserRepository) CountByRole(ctx context.Context, role entity.Role) (int64, error) {
+	args := m.Called(ctx, role)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockUserRepository) UpdateRole(ctx context.Context, id uuid.UUID, role entity.Role) error {
+	args := m.Called(ctx, id, role)
+	return args.Error(0)
+}
+
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) Rotate(ctx context.Context, oldID uuid.UUID, next *entity.RefreshToken) error {
+	args := m.Called(ctx, oldID, next)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	args := m.Called(ctx, familyID)
+	return args.Error(0)
+}
+
+type MockRevokedTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockRevokedTokenRepository) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	args := m.Called(ctx, jti, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockRevokedTokenRepository) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	args := m.Called(ctx, jti)
+	return args.Bool(0), args.Error(1)
+}
+
 func TestAuthService_Register(t *testing.T) {
 	ctx := context.Background()
 	jwtManager := auth.NewJWTManager(config.JWTConfig{
 		Secret:     "test-secret",
 		Expiration: 24 * 60 * 60,
 		Issuer:     "test",
-	})
+	}, nil)
 
 	t.Run("successful registration", func(t *testing.T) {
 		mockRepo := new(MockUserRepository)
-		svc := NewService(mockRepo, jwtManager)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "")
 
 		mockRepo.On("ExistsByEmail", ctx, "test@example.com").Return(false, nil)
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.User")).Return(nil)
@@ -68,9 +119,53 @@ func TestAuthService_Register(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("new users are viewers", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "admin@example.com")
+
+		mockRepo.On("ExistsByEmail", ctx, "test@example.com").Return(false, nil)
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.User")).Return(nil)
+
+		user, err := svc.Register(ctx, "test@example.com", "password123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.RoleViewer, user.Role)
+		mockRepo.AssertNotCalled(t, "CountByRole", mock.Anything, mock.Anything)
+	})
+
+	t.Run("bootstrap admin", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "admin@example.com")
+
+		mockRepo.On("ExistsByEmail", ctx, "admin@example.com").Return(false, nil)
+		mockRepo.On("CountByRole", ctx, entity.RoleAdmin).Return(int64(0), nil)
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.User")).Return(nil)
+
+		user, err := svc.Register(ctx, "admin@example.com", "password123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.RoleAdmin, user.Role)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("bootstrap email after an admin exists", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "admin@example.com")
+
+		mockRepo.On("ExistsByEmail", ctx, "admin@example.com").Return(false, nil)
+		mockRepo.On("CountByRole", ctx, entity.RoleAdmin).Return(int64(1), nil)
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.User")).Return(nil)
+
+		user, err := svc.Register(ctx, "admin@example.com", "password123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.RoleViewer, user.Role)
+		mockRepo.AssertExpectations(t)
+	})
+
 	t.Run("email already exists", func(t *testing.T) {
 		mockRepo := new(MockUserRepository)
-		svc := NewService(mockRepo, jwtManager)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "")
 
 		mockRepo.On("ExistsByEmail", ctx, "existing@example.com").Return(true, nil)
 
@@ -84,7 +179,7 @@ func TestAuthService_Register(t *testing.T) {
 
 	t.Run("invalid email", func(t *testing.T) {
 		mockRepo := new(MockUserRepository)
-		svc := NewService(mockRepo, jwtManager)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "")
 
 		user, err := svc.Register(ctx, "invalid-email", "password123")
 
@@ -95,7 +190,7 @@ func TestAuthService_Register(t *testing.T) {
 
 	t.Run("password too short", func(t *testing.T) {
 		mockRepo := new(MockUserRepository)
-		svc := NewService(mockRepo, jwtManager)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "")
 
 		user, err := svc.Register(ctx, "test@example.com", "short")
 
@@ -111,11 +206,12 @@ func TestAuthService_Login(t *testing.T) {
 		Secret:     "test-secret",
 		Expiration: 24 * 60 * 60,
 		Issuer:     "test",
-	})
+	}, nil)
 
 	t.Run("successful login", func(t *testing.T) {
 		mockRepo := new(MockUserRepository)
-		svc := NewService(mockRepo, jwtManager)
+		mockTokens := new(MockRefreshTokenRepository)
+		svc := NewService(mockRepo, mockTokens, new(MockRevokedTokenRepository), jwtManager, "")
 
 		hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
 		user := &entity.User{
@@ -125,31 +221,36 @@ func TestAuthService_Login(t *testing.T) {
 		}
 
 		mockRepo.On("FindByEmail", ctx, "test@example.com").Return(user, nil)
+		mockTokens.On("Create", ctx, mock.MatchedBy(func(rt *entity.RefreshToken) bool {
+			return rt.UserID == user.ID && rt.TokenHash != ""
+		})).Return(nil)
 
-		token, err := svc.Login(ctx, "test@example.com", "password123")
+		tokens, err := svc.Login(ctx, "test@example.com", "password123")
 
 		assert.NoError(t, err)
-		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, tokens.AccessToken)
+		assert.NotEmpty(t, tokens.RefreshToken)
 		mockRepo.AssertExpectations(t)
+		mockTokens.AssertExpectations(t)
 	})
 
 	t.Run("user not found", func(t *testing.T) {
 		mockRepo := new(MockUserRepository)
-		svc := NewService(mockRepo, jwtManager)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "")
 
 		mockRepo.On("FindByEmail", ctx, "notfound@example.com").Return(nil, errors.NewNotFoundError("user"))
 
-		token, err := svc.Login(ctx, "notfound@example.com", "password123")
+		tokens, err := svc.Login(ctx, "notfound@example.com", "password123")
 
 		assert.Error(t, err)
-		assert.Empty(t, token)
+		assert.Nil(t, tokens)
 		assert.True(t, errors.IsUnauthorizedError(err))
 		mockRepo.AssertExpectations(t)
 	})
 
 	t.Run("wrong password", func(t *testing.T) {
 		mockRepo := new(MockUserRepository)
-		svc := NewService(mockRepo, jwtManager)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "")
 
 		hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("correctpassword"), bcrypt.DefaultCost)
 		user := &entity.User{
@@ -160,11 +261,185 @@ func TestAuthService_Login(t *testing.T) {
 
 		mockRepo.On("FindByEmail", ctx, "test@example.com").Return(user, nil)
 
-		token, err := svc.Login(ctx, "test@example.com", "wrongpassword")
+		tokens, err := svc.Login(ctx, "test@example.com", "wrongpassword")
 
 		assert.Error(t, err)
-		assert.Empty(t, token)
+		assert.Nil(t, tokens)
 		assert.True(t, errors.IsUnauthorizedError(err))
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestAuthService_AssignRole(t *testing.T) {
+	ctx := context.Background()
+	jwtManager := auth.NewJWTManager(config.JWTConfig{
+		Secret:     "test-secret",
+		Expiration: 24 * 60 * 60,
+		Issuer:     "test",
+	}, nil)
+
+	t.Run("successful assignment", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "")
+
+		user := &entity.User{ID: uuid.New(), Email: "test@example.com", Role: entity.RoleViewer}
+		mockRepo.On("FindByID", ctx, user.ID).Return(user, nil)
+		mockRepo.On("UpdateRole", ctx, user.ID, entity.RoleHR).Return(nil)
+
+		updated, err := svc.AssignRole(ctx, user.ID, entity.RoleHR)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.RoleHR, updated.Role)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "")
+
+		updated, err := svc.AssignRole(ctx, uuid.New(), entity.Role("superuser"))
+
+		assert.Error(t, err)
+		assert.Nil(t, updated)
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("cannot demote the last admin", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		svc := NewService(mockRepo, new(MockRefreshTokenRepository), new(MockRevokedTokenRepository), jwtManager, "")
+
+		user := &entity.User{ID: uuid.New(), Email: "admin@example.com", Role: entity.RoleAdmin}
+		mockRepo.On("FindByID", ctx, user.ID).Return(user, nil)
+		mockRepo.On("CountByRole", ctx, entity.RoleAdmin).Return(int64(1), nil)
+
+		updated, err := svc.AssignRole(ctx, user.ID, entity.RoleViewer)
+
+		assert.Error(t, err)
+		assert.Nil(t, updated)
+		mockRepo.AssertNotCalled(t, "UpdateRole", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthService_RefreshToken(t *testing.T) {
+	ctx := context.Background()
+	jwtManager := auth.NewJWTManager(config.JWTConfig{
+		Secret:            "test-secret",
+		Expiration:        15 * time.Minute,
+		RefreshExpiration: 24 * time.Hour,
+		Issuer:            "test",
+	}, nil)
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com", Role: entity.RoleHR}
+
+	t.Run("rotates the token", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		mockTokens := new(MockRefreshTokenRepository)
+		svc := NewService(mockRepo, mockTokens, new(MockRevokedTokenRepository), jwtManager, "")
+
+		current := entity.NewRefreshToken(user.ID, uuid.New(), hashToken("old-token"), time.Now().Add(time.Hour))
+		mockTokens.On("FindByHash", ctx, hashToken("old-token")).Return(current, nil)
+		mockRepo.On("FindByID", ctx, user.ID).Return(user, nil)
+		mockTokens.On("Rotate", ctx, current.ID, mock.MatchedBy(func(next *entity.RefreshToken) bool {
+			return next.FamilyID == current.FamilyID && next.TokenHash != current.TokenHash
+		})).Return(nil)
+
+		tokens, err := svc.RefreshToken(ctx, "old-token")
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, tokens.AccessToken)
+		assert.NotEqual(t, "old-token", tokens.RefreshToken)
+		assert.Equal(t, 15*time.Minute, tokens.ExpiresIn)
+		mockTokens.AssertExpectations(t)
+	})
+
+	t.Run("reuse revokes the family", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		mockTokens := new(MockRefreshTokenRepository)
+		svc := NewService(mockRepo, mockTokens, new(MockRevokedTokenRepository), jwtManager, "")
+
+		current := entity.NewRefreshToken(user.ID, uuid.New(), hashToken("used-token"), time.Now().Add(time.Hour))
+		revokedAt := time.Now().Add(-time.Minute)
+		current.RevokedAt = &revokedAt
+		mockTokens.On("FindByHash", ctx, hashToken("used-token")).Return(current, nil)
+		mockTokens.On("RevokeFamily", ctx, current.FamilyID).Return(nil)
+
+		tokens, err := svc.RefreshToken(ctx, "used-token")
+
+		assert.Error(t, err)
+		assert.Nil(t, tokens)
+		assert.True(t, errors.IsUnauthorizedError(err))
+		mockTokens.AssertExpectations(t)
+		mockTokens.AssertNotCalled(t, "Rotate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		mockTokens := new(MockRefreshTokenRepository)
+		svc := NewService(mockRepo, mockTokens, new(MockRevokedTokenRepository), jwtManager, "")
+
+		current := entity.NewRefreshToken(user.ID, uuid.New(), hashToken("stale-token"), time.Now().Add(-time.Hour))
+		mockTokens.On("FindByHash", ctx, hashToken("stale-token")).Return(current, nil)
+
+		tokens, err := svc.RefreshToken(ctx, "stale-token")
+
+		assert.Error(t, err)
+		assert.Nil(t, tokens)
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		mockTokens := new(MockRefreshTokenRepository)
+		svc := NewService(mockRepo, mockTokens, new(MockRevokedTokenRepository), jwtManager, "")
+
+		mockTokens.On("FindByHash", ctx, hashToken("nope")).Return(nil, errors.NewNotFoundError("refresh token"))
+
+		tokens, err := svc.RefreshToken(ctx, "nope")
+
+		assert.Error(t, err)
+		assert.Nil(t, tokens)
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	ctx := context.Background()
+	jwtManager := auth.NewJWTManager(config.JWTConfig{
+		Secret:     "test-secret",
+		Expiration: 15 * time.Minute,
+		Issuer:     "test",
+	}, nil)
+
+	t.Run("revokes access and refresh tokens", func(t *testing.T) {
+		mockTokens := new(MockRefreshTokenRepository)
+		mockRevoked := new(MockRevokedTokenRepository)
+		svc := NewService(new(MockUserRepository), mockTokens, mockRevoked, jwtManager, "")
+
+		userID := uuid.New()
+		expiresAt := time.Now().Add(10 * time.Minute)
+		current := entity.NewRefreshToken(userID, uuid.New(), hashToken("refresh"), time.Now().Add(time.Hour))
+		mockTokens.On("FindByHash", ctx, hashToken("refresh")).Return(current, nil)
+		mockTokens.On("RevokeFamily", ctx, current.FamilyID).Return(nil)
+		mockRevoked.On("Revoke", ctx, "jti-1", expiresAt).Return(nil)
+
+		err := svc.Logout(ctx, userID, "jti-1", expiresAt, "refresh")
+
+		assert.NoError(t, err)
+		mockTokens.AssertExpectations(t)
+		mockRevoked.AssertExpectations(t)
+	})
+
+	t.Run("refresh token of another user", func(t *testing.T) {
+		mockTokens := new(MockRefreshTokenRepository)
+		mockRevoked := new(MockRevokedTokenRepository)
+		svc := NewService(new(MockUserRepository), mockTokens, mockRevoked, jwtManager, "")
+
+		current := entity.NewRefreshToken(uuid.New(), uuid.New(), hashToken("refresh"), time.Now().Add(time.Hour))
+		mockTokens.On("FindByHash", ctx, hashToken("refresh")).Return(current, nil)
+
+		err := svc.Logout(ctx, uuid.New(), "jti-1", time.Now(), "refresh")
+
+		assert.Error(t, err)
+		assert.True(t, errors.IsForbiddenError(err))
+		mockTokens.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything)
+	})
+}
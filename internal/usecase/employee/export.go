@@ -0,0 +1,281 @@
+package employee
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/salary"
+	"github.com/parquet-go/parquet-go"
+	"github.com/shopspring/decimal"
+)
+
+// ExportFormat is the file format of an export.
+type ExportFormat string
+
+const (
+	ExportFormatCSV     ExportFormat = "csv"
+	ExportFormatJSONL   ExportFormat = "jsonl"
+	ExportFormatParquet ExportFormat = "parquet"
+)
+
+func (f ExportFormat) IsValid() bool {
+	switch f {
+	case ExportFormatCSV, ExportFormatJSONL, ExportFormatParquet:
+		return true
+	}
+	return false
+}
+
+// ExportParams selects the employees to export and how they are written.
+// IncludeNetSalary adds the tax_amount, tax_rate and net_salary columns.
+type ExportParams struct {
+	Filter           repository.EmployeeFilter
+	Format           ExportFormat
+	IncludeNetSalary bool
+}
+
+// Export writes every employee matching the filter to w, oldest first. Rows
+// are encoded as they are read from the database, so the export is never
+// held in memory. Net salaries are calculated as CalculateNetSalary does
+// today, on each employee's current salary, with the tax rule and exchange
+// rate of each country resolved once per export.
+func (s *service) Export(ctx context.Context, params ExportParams, w io.Writer) error {
+	if params.Format == "" {
+		params.Format = ExportFormatCSV
+	}
+	if !params.Format.IsValid() {
+		return errors.NewValidationError("unsupported export format: " + string(params.Format))
+	}
+	if err := params.Filter.Validate(); err != nil {
+		return err
+	}
+
+	encoder, err := newExportEncoder(params.Format, w, params.IncludeNetSalary)
+	if err != nil {
+		return err
+	}
+
+	var netSalaries salary.NetSalaryCalculator
+	if params.IncludeNetSalary {
+		netSalaries = s.salaryService.NewNetSalaryCalculator(s.now())
+	}
+	err = s.repo.Stream(ctx, params.Filter, func(employee *entity.Employee) error {
+		var salary *valueobject.Salary
+		if netSalaries != nil {
+			var err error
+			salary, err = netSalaries.NetSalary(ctx, employee.Country, employee.GrossSalary, valueobject.Currency(employee.Currency))
+			if err != nil {
+				return err
+			}
+		}
+		return encoder.Encode(employee, salary)
+	})
+	if err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// exportEncoder writes export rows in one format. salary is nil unless net
+// salaries were requested. Close flushes whatever the format buffers.
+type exportEncoder interface {
+	Encode(employee *entity.Employee, salary *valueobject.Salary) error
+	Close() error
+}
+
+func newExportEncoder(format ExportFormat, w io.Writer, netSalary bool) (exportEncoder, error) {
+	switch format {
+	case ExportFormatJSONL:
+		return &jsonExportEncoder{encoder: json.NewEncoder(w)}, nil
+	case ExportFormatParquet:
+		if netSalary {
+			return newParquetExportEncoder(w, parquetEmployeeWithNetSalaryOf), nil
+		}
+		return newParquetExportEncoder(w, parquetEmployeeOf), nil
+	default:
+		return newCSVExportEncoder(w, netSalary)
+	}
+}
+
+var (
+	exportColumns    = []string{"id", "full_name", "job_title", "country", "gross_salary", "currency", "created_at", "updated_at"}
+	netSalaryColumns = []string{"tax_amount", "tax_rate", "net_salary"}
+)
+
+type csvExportEncoder struct {
+	writer *csv.Writer
+	record []string
+}
+
+func newCSVExportEncoder(w io.Writer, netSalary bool) (*csvExportEncoder, error) {
+	header := exportColumns
+	if netSalary {
+		header = append(append([]string(nil), exportColumns...), netSalaryColumns...)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	return &csvExportEncoder{writer: writer, record: make([]string, 0, len(header))}, nil
+}
+
+func (e *csvExportEncoder) Encode(employee *entity.Employee, salary *valueobject.Salary) error {
+	e.record = append(e.record[:0],
+		employee.ID.String(),
+		employee.FullName,
+		employee.JobTitle,
+		employee.Country,
+		employee.GrossSalary.StringFixed(2),
+		employee.Currency,
+		formatExportTime(employee.CreatedAt),
+		formatExportTime(employee.UpdatedAt),
+	)
+	if salary != nil {
+		e.record = append(e.record,
+			salary.TaxAmount.StringFixed(2),
+			salary.TaxRate.String(),
+			salary.NetSalary.StringFixed(2),
+		)
+	}
+	return e.writer.Write(e.record)
+}
+
+func (e *csvExportEncoder) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// jsonExportRecord is one line of a JSON Lines export. Decimals are strings,
+// as in the API, so no precision is lost.
+type jsonExportRecord struct {
+	ID          string  `json:"id"`
+	FullName    string  `json:"full_name"`
+	JobTitle    string  `json:"job_title"`
+	Country     string  `json:"country"`
+	GrossSalary string  `json:"gross_salary"`
+	Currency    string  `json:"currency"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	TaxAmount   *string `json:"tax_amount,omitempty"`
+	TaxRate     *string `json:"tax_rate,omitempty"`
+	NetSalary   *string `json:"net_salary,omitempty"`
+}
+
+type jsonExportEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e *jsonExportEncoder) Encode(employee *entity.Employee, salary *valueobject.Salary) error {
+	record := jsonExportRecord{
+		ID:          employee.ID.String(),
+		FullName:    employee.FullName,
+		JobTitle:    employee.JobTitle,
+		Country:     employee.Country,
+		GrossSalary: employee.GrossSalary.StringFixed(2),
+		Currency:    employee.Currency,
+		CreatedAt:   formatExportTime(employee.CreatedAt),
+		UpdatedAt:   formatExportTime(employee.UpdatedAt),
+	}
+	if salary != nil {
+		taxAmount, taxRate, netSalary := salary.TaxAmount.StringFixed(2), salary.TaxRate.String(), salary.NetSalary.StringFixed(2)
+		record.TaxAmount, record.TaxRate, record.NetSalary = &taxAmount, &taxRate, &netSalary
+	}
+	// Encode terminates each record with a newline.
+	return e.encoder.Encode(&record)
+}
+
+func (e *jsonExportEncoder) Close() error {
+	return nil
+}
+
+func formatExportTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// parquetRowGroupSize bounds the rows a Parquet export buffers before
+// writing them out as a row group.
+const parquetRowGroupSize = 10000
+
+// parquetEmployee is one row of a Parquet export. Amounts are stored as
+// decimals with the precision of the employees table.
+type parquetEmployee struct {
+	ID          string    `parquet:"id"`
+	FullName    string    `parquet:"full_name"`
+	JobTitle    string    `parquet:"job_title"`
+	Country     string    `parquet:"country"`
+	GrossSalary int64     `parquet:"gross_salary,decimal(2:15)"`
+	Currency    string    `parquet:"currency"`
+	CreatedAt   time.Time `parquet:"created_at,timestamp(microsecond)"`
+	UpdatedAt   time.Time `parquet:"updated_at,timestamp(microsecond)"`
+}
+
+type parquetEmployeeWithNetSalary struct {
+	parquetEmployee
+	TaxAmount int64 `parquet:"tax_amount,decimal(2:15)"`
+	TaxRate   int64 `parquet:"tax_rate,decimal(4:9)"`
+	NetSalary int64 `parquet:"net_salary,decimal(2:15)"`
+}
+
+func parquetEmployeeOf(employee *entity.Employee, _ *valueobject.Salary) parquetEmployee {
+	return parquetEmployee{
+		ID:          employee.ID.String(),
+		FullName:    employee.FullName,
+		JobTitle:    employee.JobTitle,
+		Country:     employee.Country,
+		GrossSalary: unscaled(employee.GrossSalary, 2),
+		Currency:    employee.Currency,
+		CreatedAt:   employee.CreatedAt.UTC(),
+		UpdatedAt:   employee.UpdatedAt.UTC(),
+	}
+}
+
+func parquetEmployeeWithNetSalaryOf(employee *entity.Employee, salary *valueobject.Salary) parquetEmployeeWithNetSalary {
+	return parquetEmployeeWithNetSalary{
+		parquetEmployee: parquetEmployeeOf(employee, nil),
+		TaxAmount:       unscaled(salary.TaxAmount, 2),
+		TaxRate:         unscaled(salary.TaxRate, 4),
+		NetSalary:       unscaled(salary.NetSalary, 2),
+	}
+}
+
+// unscaled returns d as the integer a Parquet decimal with the given scale
+// stores.
+func unscaled(d decimal.Decimal, scale int32) int64 {
+	return d.Round(scale).Shift(scale).IntPart()
+}
+
+type parquetExportEncoder[T any] struct {
+	writer  *parquet.GenericWriter[T]
+	convert func(*entity.Employee, *valueobject.Salary) T
+	row     []T
+}
+
+func newParquetExportEncoder[T any](w io.Writer, convert func(*entity.Employee, *valueobject.Salary) T) *parquetExportEncoder[T] {
+	return &parquetExportEncoder[T]{
+		writer: parquet.NewGenericWriter[T](w,
+			parquet.Compression(&parquet.Snappy),
+			parquet.MaxRowsPerRowGroup(parquetRowGroupSize),
+		),
+		convert: convert,
+		row:     make([]T, 1),
+	}
+}
+
+func (e *parquetExportEncoder[T]) Encode(employee *entity.Employee, salary *valueobject.Salary) error {
+	e.row[0] = e.convert(employee, salary)
+	_, err := e.writer.Write(e.row)
+	return err
+}
+
+// Close writes the last row group and the file footer.
+func (e *parquetExportEncoder[T]) Close() error {
+	return e.writer.Close()
+}
@@ -0,0 +1,280 @@
+package employee
+
+import (
+	"context"
+	"encoding/csv"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// importBatchSize is the number of rows inserted per statement.
+	importBatchSize = 500
+	// maxReportedImportErrors bounds the errors returned for one import; the
+	// total is still counted.
+	maxReportedImportErrors = 1000
+)
+
+// errImportRolledBack aborts the import transaction without being reported.
+var errImportRolledBack = stderrors.New("import rolled back")
+
+// ImportRow is one employee read from an import file. Line is the line the
+// row starts on, for error reporting.
+type ImportRow struct {
+	Line        int
+	FullName    string
+	JobTitle    string
+	Country     string
+	GrossSalary string
+	// Currency is optional; empty is the default currency of Country.
+	Currency string
+	// HireDate (YYYY-MM-DD) and EmploymentType are optional; empty is today
+	// and full time.
+	HireDate       string
+	EmploymentType string
+}
+
+// RowError reports why one row of an import was rejected.
+type RowError struct {
+	Line    int
+	Message string
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// RowReader yields import rows until it returns io.EOF. A *RowError rejects
+// a single malformed row; any other error aborts the import.
+type RowReader interface {
+	Read() (*ImportRow, error)
+}
+
+// ImportResult summarises an import. Nothing is written unless Committed.
+type ImportResult struct {
+	TotalRows    int
+	ImportedRows int
+	DryRun       bool
+	Committed    bool
+	ErrorCount   int
+	Errors       []RowError
+}
+
+func (r *ImportResult) addError(line int, message string) {
+	r.ErrorCount++
+	if len(r.Errors) < maxReportedImportErrors {
+		r.Errors = append(r.Errors, RowError{Line: line, Message: message})
+	}
+}
+
+// Import creates an employee for every row, validated with the same rules as
+// Create. All rows are committed in one transaction, or none are if any row
+// is invalid. Rows are inserted in batches as they are read, so the input is
+// never held in memory. A dry run only validates.
+func (s *service) Import(ctx context.Context, rows RowReader, dryRun bool) (*ImportResult, error) {
+	result := &ImportResult{DryRun: dryRun}
+	batch := make([]*entity.Employee, 0, importBatchSize)
+
+	flush := func(ctx context.Context) error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := s.repo.CreateBatch(ctx, batch); err != nil {
+			return err
+		}
+		now := s.now().UTC()
+		entries := make([]*entity.EmployeeAuditEntry, 0, len(batch))
+		events := make([]*entity.EmployeeEvent, 0, len(batch))
+		hires := make([]*entity.CompensationRecord, 0, len(batch))
+		for _, employee := range batch {
+			entry := s.auditEntry(ctx, employee.ID, entity.AuditActionCreated, entity.DiffEmployee(nil, employee))
+			entries = append(entries, entry)
+			events = append(events, entity.NewEmployeeEvent(entry, employee))
+			hires = append(hires, entity.NewCompensationRecord(employee.ID, employee.GrossSalary, employee.Currency, entity.CompensationReasonHire, actorID(ctx), now))
+		}
+		if err := s.auditRepo.CreateBatch(ctx, entries); err != nil {
+			return err
+		}
+		if err := s.compensationRepo.CreateBatch(ctx, hires); err != nil {
+			return err
+		}
+		if err := s.eventRepo.Append(ctx, events); err != nil {
+			return err
+		}
+		result.ImportedRows += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for {
+			row, err := rows.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				var rowErr *RowError
+				if !stderrors.As(err, &rowErr) {
+					return err
+				}
+				result.TotalRows++
+				result.addError(rowErr.Line, rowErr.Message)
+				continue
+			}
+			result.TotalRows++
+
+			employee, err := s.employeeFromRow(row)
+			if err != nil {
+				result.addError(row.Line, errors.GetMessage(err))
+				continue
+			}
+
+			// Keep validating after the first error so every problem is
+			// reported, but stop writing rows that will be rolled back.
+			if dryRun || result.ErrorCount > 0 {
+				continue
+			}
+			batch = append(batch, employee)
+			if len(batch) == importBatchSize {
+				if err := flush(ctx); err != nil {
+					return err
+				}
+			}
+		}
+
+		if dryRun || result.ErrorCount > 0 {
+			return errImportRolledBack
+		}
+		return flush(ctx)
+	})
+	if err != nil && !stderrors.Is(err, errImportRolledBack) {
+		return nil, err
+	}
+
+	if err != nil {
+		result.ImportedRows = 0
+		return result, nil
+	}
+	result.Committed = true
+	return result, nil
+}
+
+func (s *service) employeeFromRow(row *ImportRow) (*entity.Employee, error) {
+	grossSalary, err := decimal.NewFromString(row.GrossSalary)
+	if err != nil {
+		return nil, errors.NewValidationError("gross_salary must be a decimal number")
+	}
+	currency := defaultCurrency(row.Currency, row.Country)
+	if err := s.validateEmployee(row.FullName, row.JobTitle, row.Country, grossSalary, currency); err != nil {
+		return nil, err
+	}
+
+	employment := Employment{Type: entity.EmploymentType(row.EmploymentType)}
+	if row.HireDate != "" {
+		employment.HireDate, err = time.Parse(time.DateOnly, row.HireDate)
+		if err != nil {
+			return nil, errors.NewValidationError("hire_date must be a date in YYYY-MM-DD format")
+		}
+	}
+	employee := entity.NewEmployee(row.FullName, row.JobTitle, row.Country, grossSalary, currency)
+	if err := s.startEmployment(employee, employment); err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
+
+var (
+	// importColumns are the columns an import file must have, in any order.
+	importColumns = []string{"full_name", "job_title", "country", "gross_salary"}
+	// optionalImportColumns may also appear.
+	optionalImportColumns = []string{"currency", "hire_date", "employment_type"}
+)
+
+type csvRowReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+// NewCSVRowReader reads import rows from CSV with a header line naming the
+// columns full_name, job_title, country and gross_salary, and optionally
+// currency, hire_date and employment_type.
+func NewCSVRowReader(r io.Reader) (RowReader, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.NewValidationError("import file is empty")
+	}
+	if err != nil {
+		var parseErr *csv.ParseError
+		if stderrors.As(err, &parseErr) {
+			return nil, errors.NewValidationError("invalid header: " + parseErr.Err.Error())
+		}
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if i == 0 {
+			// Spreadsheet exports often start with a byte order mark.
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		if _, ok := columns[name]; ok {
+			return nil, errors.NewValidationError("duplicate column " + name)
+		}
+		columns[name] = i
+	}
+	for _, name := range importColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, errors.NewValidationError("missing column " + name)
+		}
+	}
+	for name := range columns {
+		if !slices.Contains(importColumns, name) && !slices.Contains(optionalImportColumns, name) {
+			return nil, errors.NewValidationError("unexpected column " + name + "; expected " + strings.Join(importColumns, ", ") + " and optionally " + strings.Join(optionalImportColumns, ", "))
+		}
+	}
+
+	return &csvRowReader{reader: reader, columns: columns}, nil
+}
+
+func (c *csvRowReader) Read() (*ImportRow, error) {
+	record, err := c.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if stderrors.As(err, &parseErr) {
+			return nil, &RowError{Line: parseErr.StartLine, Message: parseErr.Err.Error()}
+		}
+		return nil, err
+	}
+
+	line, _ := c.reader.FieldPos(0)
+	field := func(name string) string {
+		i, ok := c.columns[name]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	return &ImportRow{
+		Line:           line,
+		FullName:       field("full_name"),
+		JobTitle:       field("job_title"),
+		Country:        field("country"),
+		GrossSalary:    field("gross_salary"),
+		Currency:       field("currency"),
+		HireDate:       field("hire_date"),
+		EmploymentType: field("employment_type"),
+	}, nil
+}
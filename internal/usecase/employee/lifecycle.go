@@ -0,0 +1,199 @@
+package employee
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// Employment is the contract of a new employee. A zero HireDate is today and
+// an empty Type is full time. An employee hired for a later date is pending
+// until it is activated.
+type Employment struct {
+	HireDate time.Time
+	Type     entity.EmploymentType
+}
+
+// Termination ends an employment for Reason after LastWorkingDay, which is
+// today when zero.
+type Termination struct {
+	Reason         entity.TerminationReason
+	LastWorkingDay time.Time
+}
+
+// Rehire starts a new employment for a terminated employee on HireDate,
+// which is today when zero. An empty Type keeps the previous employment
+// type.
+type Rehire struct {
+	HireDate time.Time
+	Type     entity.EmploymentType
+}
+
+// statusTransitions lists the statuses an employee can move to from each
+// status. A pending employee who never starts is deleted rather than
+// terminated.
+var statusTransitions = map[entity.EmploymentStatus][]entity.EmploymentStatus{
+	entity.EmploymentStatusPending:    {entity.EmploymentStatusActive},
+	entity.EmploymentStatusActive:     {entity.EmploymentStatusOnLeave, entity.EmploymentStatusTerminated},
+	entity.EmploymentStatusOnLeave:    {entity.EmploymentStatusActive, entity.EmploymentStatusTerminated},
+	entity.EmploymentStatusTerminated: {entity.EmploymentStatusPending, entity.EmploymentStatusActive},
+}
+
+// checkStatusTransition fails with a conflict error when an employee cannot
+// move from one status to the other.
+func checkStatusTransition(from, to entity.EmploymentStatus) error {
+	if from == to || slices.Contains(statusTransitions[from], to) {
+		return nil
+	}
+	return errors.NewConflictError("employee cannot go from " + string(from) + " to " + string(to))
+}
+
+// checkStatusUpdate checks a status change made by an update. Terminating
+// and rehiring record more than the status, so they have their own methods.
+func checkStatusUpdate(from, to entity.EmploymentStatus) error {
+	if from == to {
+		return nil
+	}
+	if to == entity.EmploymentStatusTerminated {
+		return errors.NewValidationError("status cannot be set to terminated; terminate the employee instead")
+	}
+	if from == entity.EmploymentStatusTerminated {
+		return errors.NewConflictError("employee is terminated; rehire it instead")
+	}
+	return checkStatusTransition(from, to)
+}
+
+// startEmployment sets the hire date, employment type and status of an
+// employee starting a new employment.
+func (s *service) startEmployment(employee *entity.Employee, employment Employment) error {
+	if employment.Type == "" {
+		employment.Type = entity.EmploymentTypeFullTime
+	}
+	if !employment.Type.IsValid() {
+		return errEmploymentType
+	}
+	today := s.today()
+	if employment.HireDate.IsZero() {
+		employment.HireDate = today
+	}
+
+	employee.HireDate = utcDate(employment.HireDate)
+	employee.EmploymentType = employment.Type
+	employee.Status = entity.EmploymentStatusActive
+	if employee.HireDate.After(today) {
+		employee.Status = entity.EmploymentStatusPending
+	}
+	return nil
+}
+
+// Terminate ends the employment of an active or on-leave employee. The
+// employee keeps its record and history, and no longer counts towards
+// salary statistics. Compensation changes scheduled after now are cancelled.
+func (s *service) Terminate(ctx context.Context, id uuid.UUID, termination Termination) (*entity.Employee, error) {
+	if !termination.Reason.IsValid() {
+		return nil, errors.NewValidationError("reason must be resignation, dismissal, redundancy, retirement, contract_end or other")
+	}
+	lastWorkingDay := s.today()
+	if !termination.LastWorkingDay.IsZero() {
+		lastWorkingDay = utcDate(termination.LastWorkingDay)
+	}
+
+	var employee *entity.Employee
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		employee, err = s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if employee.Status == entity.EmploymentStatusTerminated {
+			return errors.NewConflictError("employee is already terminated")
+		}
+		if err := checkStatusTransition(employee.Status, entity.EmploymentStatusTerminated); err != nil {
+			return err
+		}
+		if lastWorkingDay.Before(employee.HireDate) {
+			return errors.NewValidationError("last_working_day cannot be before hire_date")
+		}
+
+		before := *employee
+		employee.Status = entity.EmploymentStatusTerminated
+		employee.TerminationReason = termination.Reason
+		employee.LastWorkingDay = &lastWorkingDay
+		if err := s.updateEmployment(ctx, &before, employee, []repository.EmployeeField{repository.EmployeeFieldStatus}); err != nil {
+			return err
+		}
+		return s.compensationRepo.CancelScheduled(ctx, employee.ID, s.now().UTC())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
+
+// Rehire starts a new employment for a terminated employee, after its last
+// working day. The termination details are cleared; the history keeps them.
+func (s *service) Rehire(ctx context.Context, id uuid.UUID, rehire Rehire) (*entity.Employee, error) {
+	if rehire.Type != "" && !rehire.Type.IsValid() {
+		return nil, errEmploymentType
+	}
+
+	var employee *entity.Employee
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		employee, err = s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if employee.Status != entity.EmploymentStatusTerminated {
+			return errors.NewConflictError("only terminated employees can be rehired")
+		}
+
+		before := *employee
+		if rehire.Type == "" {
+			rehire.Type = employee.EmploymentType
+		}
+		if err := s.startEmployment(employee, Employment(rehire)); err != nil {
+			return err
+		}
+		if before.LastWorkingDay != nil && !employee.HireDate.After(*before.LastWorkingDay) {
+			return errors.NewValidationError("hire_date must be after the last working day")
+		}
+		employee.TerminationReason = ""
+		employee.LastWorkingDay = nil
+		return s.updateEmployment(ctx, &before, employee, []repository.EmployeeField{
+			repository.EmployeeFieldHireDate,
+			repository.EmployeeFieldEmploymentType,
+			repository.EmployeeFieldStatus,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
+
+// updateEmployment writes the given fields of employee, at the version it
+// was read at, and audits the change from before.
+func (s *service) updateEmployment(ctx context.Context, before, employee *entity.Employee, fields []repository.EmployeeField) error {
+	if err := s.repo.Update(ctx, employee, fields); err != nil {
+		return err
+	}
+	return s.audit(ctx, employee, entity.AuditActionUpdated, entity.DiffEmployee(before, employee))
+}
+
+var errEmploymentType = errors.NewValidationError("employment_type must be full_time, part_time or contractor")
+
+// today returns the current UTC date.
+func (s *service) today() time.Time {
+	return utcDate(s.now())
+}
+
+// utcDate truncates t to the start of its UTC date.
+func utcDate(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
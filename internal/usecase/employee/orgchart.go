@@ -0,0 +1,183 @@
+package employee
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// MaxOrgTreeSize bounds the employees a reporting tree query returns.
+const MaxOrgTreeSize = 10000
+
+// OrgChartFormat is the file format of an org chart.
+type OrgChartFormat string
+
+const (
+	// OrgChartFormatDOT is a Graphviz digraph with an edge from each manager
+	// to each direct report.
+	OrgChartFormatDOT OrgChartFormat = "dot"
+	// OrgChartFormatJSON nests each employee's direct reports under it.
+	OrgChartFormatJSON OrgChartFormat = "json"
+)
+
+func (f OrgChartFormat) IsValid() bool {
+	switch f {
+	case OrgChartFormatDOT, OrgChartFormatJSON:
+		return true
+	}
+	return false
+}
+
+// OrgChartParams selects the part of the organisation to chart: the
+// employees under Root, or everyone when it is nil, down to MaxDepth levels
+// (all levels when zero).
+type OrgChartParams struct {
+	Root     *uuid.UUID
+	MaxDepth int
+	Format   OrgChartFormat
+}
+
+// DirectReports returns the employees reporting directly to a manager,
+// ordered by name.
+func (s *service) DirectReports(ctx context.Context, managerID uuid.UUID) ([]*entity.Employee, error) {
+	nodes, err := s.orgTree(ctx, &managerID, 1)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entity.Employee, 0, len(nodes)-1)
+	for _, node := range nodes[1:] {
+		reports = append(reports, node.Employee)
+	}
+	return reports, nil
+}
+
+// Reports returns everyone below a manager, down to maxDepth levels (all
+// levels when zero). Direct reports are at depth 1; nodes are ordered by
+// depth, then name.
+func (s *service) Reports(ctx context.Context, managerID uuid.UUID, maxDepth int) ([]repository.OrgNode, error) {
+	nodes, err := s.orgTree(ctx, &managerID, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[1:], nil
+}
+
+// ManagementChain returns the managers above an employee, its direct manager
+// first.
+func (s *service) ManagementChain(ctx context.Context, id uuid.UUID) ([]*entity.Employee, error) {
+	return s.repo.GetManagementChain(ctx, id)
+}
+
+// ExportOrgChart writes the reporting lines of the employees selected by
+// params to w. Employees appear in the order of the tree: by depth, then
+// name.
+func (s *service) ExportOrgChart(ctx context.Context, params OrgChartParams, w io.Writer) error {
+	if !params.Format.IsValid() {
+		return errors.NewValidationError("unsupported org chart format: " + string(params.Format))
+	}
+
+	nodes, err := s.orgTree(ctx, params.Root, params.MaxDepth)
+	if err != nil {
+		return err
+	}
+
+	if params.Format == OrgChartFormatDOT {
+		return writeOrgChartDOT(w, nodes)
+	}
+	return writeOrgChartJSON(w, nodes)
+}
+
+// orgTree reads a reporting tree, rejecting trees larger than
+// MaxOrgTreeSize.
+func (s *service) orgTree(ctx context.Context, root *uuid.UUID, maxDepth int) ([]repository.OrgNode, error) {
+	if maxDepth < 0 {
+		return nil, errors.NewValidationError("max_depth cannot be negative")
+	}
+
+	nodes, err := s.repo.ListOrgTree(ctx, root, maxDepth, MaxOrgTreeSize+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) > MaxOrgTreeSize {
+		return nil, errors.NewValidationError(fmt.Sprintf("more than %d employees; choose a root or a max_depth", MaxOrgTreeSize))
+	}
+	return nodes, nil
+}
+
+// dotEscaper escapes text for a double-quoted DOT string. Newlines become
+// line breaks in labels.
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", `\n`)
+
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
+
+func writeOrgChartDOT(w io.Writer, nodes []repository.OrgNode) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "digraph org_chart {")
+	fmt.Fprintln(out, "  node [shape=box];")
+	for _, node := range nodes {
+		e := node.Employee
+		fmt.Fprintf(out, "  %s [label=%s];\n", dotQuote(e.ID.String()), dotQuote(e.FullName+"\n"+e.JobTitle))
+	}
+	for _, node := range nodes {
+		// The roots' managers are outside the chart.
+		if node.Depth == 0 || node.Employee.ManagerID == nil {
+			continue
+		}
+		fmt.Fprintf(out, "  %s -> %s;\n", dotQuote(node.Employee.ManagerID.String()), dotQuote(node.Employee.ID.String()))
+	}
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
+
+// orgChartEmployee is an employee in a JSON org chart.
+type orgChartEmployee struct {
+	ID       string              `json:"id"`
+	FullName string              `json:"full_name"`
+	JobTitle string              `json:"job_title"`
+	Country  string              `json:"country"`
+	Reports  []*orgChartEmployee `json:"reports"`
+}
+
+func writeOrgChartJSON(w io.Writer, nodes []repository.OrgNode) error {
+	// Nodes come ordered by depth, so every manager is seen before its
+	// reports.
+	roots := make([]*orgChartEmployee, 0)
+	byID := make(map[uuid.UUID]*orgChartEmployee, len(nodes))
+	for _, node := range nodes {
+		e := node.Employee
+		chart := &orgChartEmployee{
+			ID:       e.ID.String(),
+			FullName: e.FullName,
+			JobTitle: e.JobTitle,
+			Country:  e.Country,
+			Reports:  []*orgChartEmployee{},
+		}
+		byID[e.ID] = chart
+
+		manager, ok := (*orgChartEmployee)(nil), false
+		if node.Depth > 0 && e.ManagerID != nil {
+			manager, ok = byID[*e.ManagerID]
+		}
+		if ok {
+			manager.Reports = append(manager.Reports, chart)
+		} else {
+			roots = append(roots, chart)
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		Employees []*orgChartEmployee `json:"employees"`
+	}{roots})
+}
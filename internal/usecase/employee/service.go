@@ -2,37 +2,156 @@ package employee
 
 import (
 	"context"
+	"io"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/authctx"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/validator"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/salary"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
 type Service interface {
-	Create(ctx context.Context, fullName, jobTitle, country string, grossSalary decimal.Decimal) (*entity.Employee, error)
+	Create(ctx context.Context, fullName, jobTitle, country string, grossSalary decimal.Decimal, currency string, placement Placement, employment Employment) (*entity.Employee, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Employee, error)
-	Update(ctx context.Context, id uuid.UUID, fullName, jobTitle, country string, grossSalary decimal.Decimal) (*entity.Employee, error)
+	List(ctx context.Context, params repository.EmployeeListParams) (*repository.EmployeePage, error)
+	Search(ctx context.Context, query string, limit int) ([]repository.EmployeeMatch, error)
+	Update(ctx context.Context, id uuid.UUID, update EmployeeUpdate) (*entity.Employee, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	Terminate(ctx context.Context, id uuid.UUID, termination Termination) (*entity.Employee, error)
+	Rehire(ctx context.Context, id uuid.UUID, rehire Rehire) (*entity.Employee, error)
+	ListDeleted(ctx context.Context, page, pageSize int) (*repository.EmployeePage, error)
+	Restore(ctx context.Context, id uuid.UUID) (*entity.Employee, error)
+	Purge(ctx context.Context, id uuid.UUID) error
+	PurgeDeleted(ctx context.Context, cutoff time.Time) (int, error)
+	History(ctx context.Context, id uuid.UUID, page, pageSize int) (*repository.EmployeeAuditPage, error)
+	ScheduleCompensation(ctx context.Context, id uuid.UUID, change CompensationChange) (*entity.CompensationRecord, error)
+	CompensationHistory(ctx context.Context, id uuid.UUID) ([]*entity.CompensationRecord, error)
+	ApplyDueCompensation(ctx context.Context) (int, error)
+	Import(ctx context.Context, rows RowReader, dryRun bool) (*ImportResult, error)
+	Export(ctx context.Context, params ExportParams, w io.Writer) error
+	DirectReports(ctx context.Context, managerID uuid.UUID) ([]*entity.Employee, error)
+	Reports(ctx context.Context, managerID uuid.UUID, maxDepth int) ([]repository.OrgNode, error)
+	ManagementChain(ctx context.Context, id uuid.UUID) ([]*entity.Employee, error)
+	ExportOrgChart(ctx context.Context, params OrgChartParams, w io.Writer) error
+	Watch(ctx context.Context, params WatchParams, fn func(*entity.EmployeeEvent) error) error
+}
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	// purgeBatchSize is the number of employees PurgeDeleted removes per
+	// transaction.
+	purgeBatchSize = 500
+	// compensationBatchSize is the number of due compensation changes
+	// ApplyDueCompensation reads at a time.
+	compensationBatchSize = 100
+	// maxSearchQueryLength is the longest search query, in characters.
+	maxSearchQueryLength = 100
+)
+
+// Placement is where an employee sits in the organisation. Each nil field
+// leaves the employee without a manager, department or cost center.
+type Placement struct {
+	ManagerID    *uuid.UUID
+	DepartmentID *uuid.UUID
+	CostCenterID *uuid.UUID
+}
+
+// EmployeeUpdate is a change to an employee. Version must be the version the
+// caller last read. Only the fields named in UpdateMask are validated and
+// written; an empty mask, or "*", replaces them all except the placement and
+// employment fields. An empty Currency is the default currency of Country,
+// and a nil ManagerID, DepartmentID or CostCenterID leaves the employee
+// without one. Status can move an employee between pending, active and on
+// leave; terminating and rehiring have their own methods.
+type EmployeeUpdate struct {
+	Version        int64
+	FullName       string
+	JobTitle       string
+	Country        string
+	GrossSalary    decimal.Decimal
+	Currency       string
+	ManagerID      *uuid.UUID
+	DepartmentID   *uuid.UUID
+	CostCenterID   *uuid.UUID
+	HireDate       time.Time
+	EmploymentType entity.EmploymentType
+	Status         entity.EmploymentStatus
+	UpdateMask     []string
+}
+
+// CompensationChange is a new gross salary for an employee from
+// EffectiveFrom on, or from now when it is zero. An empty Currency keeps the
+// employee's current currency.
+type CompensationChange struct {
+	GrossSalary   decimal.Decimal
+	Currency      string
+	Reason        entity.CompensationReason
+	EffectiveFrom time.Time
 }
 
 type service struct {
-	repo repository.EmployeeRepository
+	repo             repository.EmployeeRepository
+	auditRepo        repository.EmployeeAuditRepository
+	compensationRepo repository.CompensationRepository
+	eventRepo        repository.EmployeeEventRepository
+	salaryService    salary.Service
+	transactor       repository.Transactor
+	now              func() time.Time
+	// pollInterval is how often Watch looks for new events once it has
+	// caught up.
+	pollInterval time.Duration
 }
 
-func NewService(repo repository.EmployeeRepository) Service {
-	return &service{repo: repo}
+func NewService(repo repository.EmployeeRepository, auditRepo repository.EmployeeAuditRepository, compensationRepo repository.CompensationRepository, eventRepo repository.EmployeeEventRepository, salaryService salary.Service, transactor repository.Transactor) Service {
+	return &service{
+		repo:             repo,
+		auditRepo:        auditRepo,
+		compensationRepo: compensationRepo,
+		eventRepo:        eventRepo,
+		salaryService:    salaryService,
+		transactor:       transactor,
+		now:              time.Now,
+		pollInterval:     defaultWatchPollInterval,
+	}
 }
 
-func (s *service) Create(ctx context.Context, fullName, jobTitle, country string, grossSalary decimal.Decimal) (*entity.Employee, error) {
-	if err := s.validateEmployee(fullName, jobTitle, country, grossSalary); err != nil {
+// Create adds an employee at the given placement. An empty currency is the
+// default currency of the country.
+func (s *service) Create(ctx context.Context, fullName, jobTitle, country string, grossSalary decimal.Decimal, currency string, placement Placement, employment Employment) (*entity.Employee, error) {
+	currency = defaultCurrency(currency, country)
+	if err := s.validateEmployee(fullName, jobTitle, country, grossSalary, currency); err != nil {
 		return nil, err
 	}
 
-	employee := entity.NewEmployee(fullName, jobTitle, country, grossSalary)
-	if err := s.repo.Create(ctx, employee); err != nil {
+	employee := entity.NewEmployee(fullName, jobTitle, country, grossSalary, currency)
+	if err := s.startEmployment(employee, employment); err != nil {
+		return nil, err
+	}
+	employee.ManagerID = placement.ManagerID
+	employee.DepartmentID = placement.DepartmentID
+	employee.CostCenterID = placement.CostCenterID
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, employee); err != nil {
+			return err
+		}
+		hire := entity.NewCompensationRecord(employee.ID, grossSalary, currency, entity.CompensationReasonHire, actorID(ctx), s.now().UTC())
+		if err := s.compensationRepo.Insert(ctx, hire); err != nil {
+			return err
+		}
+		return s.audit(ctx, employee, entity.AuditActionCreated, entity.DiffEmployee(nil, employee))
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -43,38 +162,250 @@ func (s *service) GetByID(ctx context.Context, id uuid.UUID) (*entity.Employee,
 	return s.repo.FindByID(ctx, id)
 }
 
-func (s *service) validateEmployee(fullName, jobTitle, country string, grossSalary decimal.Decimal) error {
-	if err := validator.ValidateRequired(fullName, "full_name"); err != nil {
-		return err
+func (s *service) List(ctx context.Context, params repository.EmployeeListParams) (*repository.EmployeePage, error) {
+	var err error
+	params.Page, params.PageSize, err = normalizePage(params.Page, params.PageSize)
+	if err != nil {
+		return nil, err
 	}
-	if err := validator.ValidateRequired(jobTitle, "job_title"); err != nil {
-		return err
+
+	if params.SortBy == "" {
+		params.SortBy = repository.SortByCreatedAt
 	}
-	if err := validator.ValidateRequired(country, "country"); err != nil {
+	if !params.SortBy.IsValid() {
+		return nil, errors.NewValidationError("unsupported sort_by field: " + string(params.SortBy))
+	}
+
+	if err := params.Filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.repo.List(ctx, params)
+}
+
+// Search finds employees by partial or misspelled full name or job title,
+// returning up to limit of them, 20 by default and at most 100.
+func (s *service) Search(ctx context.Context, query string, limit int) ([]repository.EmployeeMatch, error) {
+	query = strings.TrimSpace(query)
+	if !strings.ContainsFunc(query, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) {
+		return nil, errors.NewValidationError("query must contain a letter or digit")
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return nil, errors.NewValidationError("query cannot be longer than 100 characters")
+	}
+	if limit < 0 {
+		return nil, errors.NewValidationError("limit cannot be negative")
+	}
+	_, limit, _ = normalizePage(1, limit)
+
+	return s.repo.Search(ctx, query, limit)
+}
+
+func (s *service) validateEmployee(fullName, jobTitle, country string, grossSalary decimal.Decimal, currency string) error {
+	employee := &entity.Employee{FullName: fullName, JobTitle: jobTitle, Country: country, GrossSalary: grossSalary, Currency: currency}
+	for _, field := range repository.UpdatableEmployeeFields {
+		if err := validateEmployeeField(employee, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateEmployeeField(employee *entity.Employee, field repository.EmployeeField) error {
+	switch field {
+	case repository.EmployeeFieldFullName:
+		return validator.ValidateRequired(employee.FullName, "full_name")
+	case repository.EmployeeFieldJobTitle:
+		return validator.ValidateRequired(employee.JobTitle, "job_title")
+	case repository.EmployeeFieldCountry:
+		return validator.ValidateRequired(employee.Country, "country")
+	case repository.EmployeeFieldGrossSalary:
+		if employee.GrossSalary.LessThan(decimal.Zero) {
+			return errors.NewValidationError("gross_salary cannot be negative")
+		}
+	case repository.EmployeeFieldCurrency:
+		return validateCurrency(employee.Currency)
+	case repository.EmployeeFieldManagerID:
+		if employee.ManagerID != nil && *employee.ManagerID == employee.ID {
+			return errors.NewValidationError("an employee cannot be its own manager")
+		}
+	case repository.EmployeeFieldHireDate:
+		if employee.HireDate.IsZero() {
+			return errors.NewValidationError("hire_date is required")
+		}
+	case repository.EmployeeFieldEmploymentType:
+		if !employee.EmploymentType.IsValid() {
+			return errEmploymentType
+		}
+	case repository.EmployeeFieldStatus:
+		if !employee.Status.IsValid() {
+			return errors.NewValidationError("status must be pending, active or on_leave")
+		}
+	}
+	return nil
+}
+
+func validateCurrency(currency string) error {
+	if err := validator.ValidateRequired(currency, "currency"); err != nil {
 		return err
 	}
-	if grossSalary.LessThan(decimal.Zero) {
-		return errors.NewValidationError("gross_salary cannot be negative")
+	if _, err := valueobject.ParseCurrency(currency); err != nil {
+		return errors.NewValidationError("currency must be an ISO 4217 code")
 	}
 	return nil
 }
 
-func (s *service) Update(ctx context.Context, id uuid.UUID, fullName, jobTitle, country string, grossSalary decimal.Decimal) (*entity.Employee, error) {
-	if err := s.validateEmployee(fullName, jobTitle, country, grossSalary); err != nil {
-		return nil, err
+// defaultCurrency returns currency in upper case, or the default currency of
+// the country when it is empty.
+func defaultCurrency(currency, country string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return valueobject.Country(country).Currency().String()
+	}
+	return currency
+}
+
+// copyEmployeeField sets one field of dst to its value in src.
+func copyEmployeeField(dst, src *entity.Employee, field repository.EmployeeField) {
+	switch field {
+	case repository.EmployeeFieldFullName:
+		dst.FullName = src.FullName
+	case repository.EmployeeFieldJobTitle:
+		dst.JobTitle = src.JobTitle
+	case repository.EmployeeFieldCountry:
+		dst.Country = src.Country
+	case repository.EmployeeFieldGrossSalary:
+		dst.GrossSalary = src.GrossSalary
+	case repository.EmployeeFieldCurrency:
+		dst.Currency = src.Currency
+	case repository.EmployeeFieldManagerID:
+		dst.ManagerID = src.ManagerID
+	case repository.EmployeeFieldDepartmentID:
+		dst.DepartmentID = src.DepartmentID
+	case repository.EmployeeFieldCostCenterID:
+		dst.CostCenterID = src.CostCenterID
+	case repository.EmployeeFieldHireDate:
+		dst.HireDate = src.HireDate
+	case repository.EmployeeFieldEmploymentType:
+		dst.EmploymentType = src.EmploymentType
+	case repository.EmployeeFieldStatus:
+		dst.Status = src.Status
+	}
+}
+
+// updateMaskFields resolves an update mask to the fields it names. An empty
+// mask, or "*", names UpdatableEmployeeFields.
+func updateMaskFields(mask []string) ([]repository.EmployeeField, error) {
+	if len(mask) == 0 || (len(mask) == 1 && mask[0] == "*") {
+		return repository.UpdatableEmployeeFields, nil
 	}
 
-	employee, err := s.repo.FindByID(ctx, id)
+	fields := make([]repository.EmployeeField, 0, len(mask))
+	seen := make(map[repository.EmployeeField]bool, len(mask))
+	for _, path := range mask {
+		field := repository.EmployeeField(path)
+		if !field.IsValid() {
+			return nil, errors.NewValidationError("unknown update_mask path: " + path)
+		}
+		if !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+// Update changes the fields of an employee named by the update mask. If the
+// employee is no longer at update.Version the update fails with a
+// precondition failed error and nothing is written. As with
+// ScheduleCompensation, a terminated employee's salary and currency cannot
+// change.
+func (s *service) Update(ctx context.Context, id uuid.UUID, update EmployeeUpdate) (*entity.Employee, error) {
+	if update.Version < 1 {
+		return nil, errors.NewValidationError("version is required")
+	}
+	fields, err := updateMaskFields(update.UpdateMask)
 	if err != nil {
 		return nil, err
 	}
 
-	employee.FullName = fullName
-	employee.JobTitle = jobTitle
-	employee.Country = country
-	employee.GrossSalary = grossSalary
+	changed := &entity.Employee{
+		ID:             id,
+		FullName:       update.FullName,
+		JobTitle:       update.JobTitle,
+		Country:        update.Country,
+		GrossSalary:    update.GrossSalary,
+		Currency:       defaultCurrency(update.Currency, update.Country),
+		ManagerID:      update.ManagerID,
+		DepartmentID:   update.DepartmentID,
+		CostCenterID:   update.CostCenterID,
+		EmploymentType: update.EmploymentType,
+		Status:         update.Status,
+	}
+	if !update.HireDate.IsZero() {
+		changed.HireDate = utcDate(update.HireDate)
+	}
+	// Without a new country, an omitted currency defaults to that of the
+	// country the employee stays in, so it is checked once that is known.
+	keepsCountry := !slices.Contains(fields, repository.EmployeeFieldCountry)
+	for _, field := range fields {
+		if field == repository.EmployeeFieldCurrency && keepsCountry {
+			continue
+		}
+		if err := validateEmployeeField(changed, field); err != nil {
+			return nil, err
+		}
+	}
+
+	var employee *entity.Employee
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		employee, err = s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		before := *employee
+		if slices.Contains(fields, repository.EmployeeFieldStatus) {
+			if err := checkStatusUpdate(before.Status, changed.Status); err != nil {
+				return err
+			}
+		}
+		if keepsCountry && slices.Contains(fields, repository.EmployeeFieldCurrency) {
+			changed.Currency = defaultCurrency(update.Currency, employee.Country)
+			if err := validateCurrency(changed.Currency); err != nil {
+				return err
+			}
+		}
 
-	if err := s.repo.Update(ctx, employee); err != nil {
+		// The repository only writes the row if it is still at the
+		// caller's version.
+		employee.Version = update.Version
+		for _, field := range fields {
+			copyEmployeeField(employee, changed, field)
+		}
+		compensationChanged := !employee.GrossSalary.Equal(before.GrossSalary) || employee.Currency != before.Currency
+		if compensationChanged && before.Status == entity.EmploymentStatusTerminated {
+			return errors.NewConflictError("cannot change the compensation of a terminated employee")
+		}
+
+		if err := s.repo.Update(ctx, employee, fields); err != nil {
+			return err
+		}
+
+		if compensationChanged {
+			adjustment := entity.NewCompensationRecord(employee.ID, employee.GrossSalary, employee.Currency, entity.CompensationReasonAdjustment, actorID(ctx), s.now().UTC())
+			if err := s.compensationRepo.Insert(ctx, adjustment); err != nil {
+				return err
+			}
+		}
+
+		changes := entity.DiffEmployee(&before, employee)
+		if len(changes) == 0 {
+			return nil
+		}
+		return s.audit(ctx, employee, entity.AuditActionUpdated, changes)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -82,5 +413,271 @@ func (s *service) Update(ctx context.Context, id uuid.UUID, fullName, jobTitle,
 }
 
 func (s *service) Delete(ctx context.Context, id uuid.UUID) error {
-	return s.repo.Delete(ctx, id)
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		employee, err := s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.audit(ctx, employee, entity.AuditActionDeleted, entity.DiffEmployee(employee, nil))
+	})
+}
+
+// ListDeleted returns soft-deleted employees, most recently deleted first.
+func (s *service) ListDeleted(ctx context.Context, page, pageSize int) (*repository.EmployeePage, error) {
+	page, pageSize, err := normalizePage(page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListDeleted(ctx, page, pageSize)
+}
+
+// Restore undoes the delete of an employee.
+func (s *service) Restore(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	var employee *entity.Employee
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		employee, err = s.repo.Restore(ctx, id)
+		if err != nil {
+			return err
+		}
+		return s.audit(ctx, employee, entity.AuditActionRestored, entity.DiffEmployee(nil, employee))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
+
+// Purge permanently removes a deleted employee. Its history is kept.
+func (s *service) Purge(ctx context.Context, id uuid.UUID) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Purge(ctx, id); err != nil {
+			return err
+		}
+		return s.auditRepo.Create(ctx, s.auditEntry(ctx, id, entity.AuditActionPurged, entity.FieldChanges{}))
+	})
+}
+
+// PurgeDeleted permanently removes every employee deleted before cutoff and
+// returns how many were removed. It is run by the retention job, so the
+// purges are recorded without an actor.
+func (s *service) PurgeDeleted(ctx context.Context, cutoff time.Time) (int, error) {
+	purged := 0
+	for {
+		var ids []uuid.UUID
+		err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			ids, err = s.repo.PurgeDeletedBefore(ctx, cutoff, purgeBatchSize)
+			if err != nil || len(ids) == 0 {
+				return err
+			}
+			entries := make([]*entity.EmployeeAuditEntry, 0, len(ids))
+			for _, id := range ids {
+				entries = append(entries, s.auditEntry(ctx, id, entity.AuditActionPurged, entity.FieldChanges{}))
+			}
+			return s.auditRepo.CreateBatch(ctx, entries)
+		})
+		if err != nil {
+			return purged, err
+		}
+		purged += len(ids)
+		if len(ids) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// History returns an employee's audit trail, newest change first. It remains
+// available after the employee is deleted or purged. An employee changed only
+// before the audit log existed has an empty history.
+func (s *service) History(ctx context.Context, id uuid.UUID, page, pageSize int) (*repository.EmployeeAuditPage, error) {
+	page, pageSize, err := normalizePage(page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.auditRepo.ListByEmployee(ctx, id, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if history.TotalCount == 0 {
+		if _, err := s.repo.FindByIDWithDeleted(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	return history, nil
+}
+
+// ScheduleCompensation adds a change to an employee's compensation timeline.
+// Like tax rules, changes cannot take effect in the past, so the salary on a
+// past date never changes. A change effective now also updates the
+// employee's gross salary; later ones are applied by ApplyDueCompensation.
+func (s *service) ScheduleCompensation(ctx context.Context, id uuid.UUID, change CompensationChange) (*entity.CompensationRecord, error) {
+	if change.GrossSalary.LessThan(decimal.Zero) {
+		return nil, errors.NewValidationError("gross_salary cannot be negative")
+	}
+	change.Currency = strings.ToUpper(strings.TrimSpace(change.Currency))
+	if change.Currency != "" {
+		if err := validateCurrency(change.Currency); err != nil {
+			return nil, err
+		}
+	}
+	if !change.Reason.IsValid() || change.Reason == entity.CompensationReasonHire {
+		return nil, errors.NewValidationError("reason must be promotion, merit or adjustment")
+	}
+	now := s.now().UTC()
+	if change.EffectiveFrom.IsZero() {
+		change.EffectiveFrom = now
+	}
+	if change.EffectiveFrom.Before(now) {
+		return nil, errors.NewValidationError("effective_from cannot be in the past")
+	}
+
+	var record *entity.CompensationRecord
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		employee, err := s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if employee.Status == entity.EmploymentStatusTerminated {
+			return errors.NewConflictError("cannot change the compensation of a terminated employee")
+		}
+		currency := change.Currency
+		if currency == "" {
+			currency = employee.Currency
+		}
+		record = entity.NewCompensationRecord(id, change.GrossSalary, currency, change.Reason, actorID(ctx), change.EffectiveFrom.UTC())
+		if err := s.compensationRepo.Insert(ctx, record); err != nil {
+			return err
+		}
+		if record.EffectiveFrom.After(now) {
+			return nil
+		}
+		return s.applyCompensation(ctx, employee, record)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// CompensationHistory returns an employee's compensation timeline, oldest
+// first, including changes scheduled for the future.
+func (s *service) CompensationHistory(ctx context.Context, id uuid.UUID) ([]*entity.CompensationRecord, error) {
+	if _, err := s.repo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.compensationRepo.ListByEmployee(ctx, id)
+}
+
+// errInactiveEmployee skips a due compensation change whose employee left
+// active employment after the change was listed.
+var errInactiveEmployee = errors.NewPreconditionFailedError("employee is not active")
+
+// ApplyDueCompensation copies the salary of every compensation change that
+// has taken effect onto its active employee and returns how many employees
+// were updated. Employees changed concurrently are skipped; they are still
+// due on the next run. Changes for employees on leave or not yet started are
+// applied once they are active.
+func (s *service) ApplyDueCompensation(ctx context.Context) (int, error) {
+	applied := 0
+	for {
+		due, err := s.compensationRepo.ListDue(ctx, s.now().UTC(), compensationBatchSize)
+		if err != nil {
+			return applied, err
+		}
+
+		appliedBefore := applied
+		for _, record := range due {
+			err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+				employee, err := s.repo.FindByID(ctx, record.EmployeeID)
+				if err != nil {
+					return err
+				}
+				if employee.Status != entity.EmploymentStatusActive {
+					return errInactiveEmployee
+				}
+				return s.applyCompensation(ctx, employee, record)
+			})
+			if errors.IsPreconditionFailedError(err) || errors.IsNotFoundError(err) {
+				continue
+			}
+			if err != nil {
+				return applied, err
+			}
+			applied++
+		}
+
+		if len(due) < compensationBatchSize || applied == appliedBefore {
+			return applied, nil
+		}
+	}
+}
+
+// applyCompensation sets the employee's gross salary and currency to those
+// of record. The change is audited as made by the record's approver.
+func (s *service) applyCompensation(ctx context.Context, employee *entity.Employee, record *entity.CompensationRecord) error {
+	if employee.GrossSalary.Equal(record.GrossSalary) && employee.Currency == record.Currency {
+		return nil
+	}
+	before := *employee
+	employee.GrossSalary = record.GrossSalary
+	employee.Currency = record.Currency
+	fields := []repository.EmployeeField{repository.EmployeeFieldGrossSalary, repository.EmployeeFieldCurrency}
+	if err := s.repo.Update(ctx, employee, fields); err != nil {
+		return err
+	}
+	entry := entity.NewEmployeeAuditEntry(employee.ID, entity.AuditActionUpdated, record.ApproverID, entity.DiffEmployee(&before, employee), s.now().UTC())
+	return s.record(ctx, entry, employee)
+}
+
+// normalizePage applies the default and maximum page size.
+func normalizePage(page, pageSize int) (int, int, error) {
+	if page < 0 || pageSize < 0 {
+		return 0, 0, errors.NewValidationError("page and page_size cannot be negative")
+	}
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return page, pageSize, nil
+}
+
+// audit records a change to employee made by the user in ctx. Calls without
+// an authenticated user, such as background jobs, are recorded with no actor.
+func (s *service) audit(ctx context.Context, employee *entity.Employee, action entity.AuditAction, changes entity.FieldChanges) error {
+	return s.record(ctx, s.auditEntry(ctx, employee.ID, action, changes), employee)
+}
+
+// record stores an audit entry for a change to employee and publishes it to
+// watchers, in the transaction making the change.
+func (s *service) record(ctx context.Context, entry *entity.EmployeeAuditEntry, employee *entity.Employee) error {
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		return err
+	}
+	if event := entity.NewEmployeeEvent(entry, employee); event != nil {
+		return s.eventRepo.Append(ctx, []*entity.EmployeeEvent{event})
+	}
+	return nil
+}
+
+func (s *service) auditEntry(ctx context.Context, employeeID uuid.UUID, action entity.AuditAction, changes entity.FieldChanges) *entity.EmployeeAuditEntry {
+	return entity.NewEmployeeAuditEntry(employeeID, action, actorID(ctx), changes, s.now().UTC())
+}
+
+// actorID returns the authenticated user in ctx, or nil when there is none.
+func actorID(ctx context.Context) *uuid.UUID {
+	id, err := uuid.Parse(authctx.UserID(ctx))
+	if err != nil {
+		return nil
+	}
+	return &id
 }
@@ -1,13 +1,21 @@
 package employee
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/authctx"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/salary"
 	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -22,6 +30,11 @@ func (m *MockEmployeeRepository) Create(ctx context.Context, employee *entity.Em
 	return args.Error(0)
 }
 
+func (m *MockEmployeeRepository) CreateBatch(ctx context.Context, employees []*entity.Employee) error {
+	args := m.Called(ctx, employees)
+	return args.Error(0)
+}
+
 func (m *MockEmployeeRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -30,8 +43,32 @@ func (m *MockEmployeeRepository) FindByID(ctx context.Context, id uuid.UUID) (*e
 	return args.Get(0).(*entity.Employee), args.Error(1)
 }
 
-func (m *MockEmployeeRepository) Update(ctx context.Context, employee *entity.Employee) error {
-	args := m.Called(ctx, employee)
+func (m *MockEmployeeRepository) FindByIDWithDeleted(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) List(ctx context.Context, params repository.EmployeeListParams) (*repository.EmployeePage, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.EmployeePage), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Search(ctx context.Context, query string, limit int) ([]repository.EmployeeMatch, error) {
+	args := m.Called(ctx, query, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.EmployeeMatch), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Update(ctx context.Context, employee *entity.Employee, fields []repository.EmployeeField) error {
+	args := m.Called(ctx, employee, fields)
 	return args.Error(0)
 }
 
@@ -40,46 +77,471 @@ func (m *MockEmployeeRepository) Delete(ctx context.Context, id uuid.UUID) error
 	return args.Error(0)
 }
 
-func (m *MockEmployeeRepository) GetSalaryStatsByCountry(ctx context.Context, country string) (*valueobject.SalaryStats, error) {
-	args := m.Called(ctx, country)
+func (m *MockEmployeeRepository) ListDeleted(ctx context.Context, page, pageSize int) (*repository.EmployeePage, error) {
+	args := m.Called(ctx, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.EmployeePage), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Restore(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, cutoff, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) ListOrgTree(ctx context.Context, root *uuid.UUID, maxDepth, limit int) ([]repository.OrgNode, error) {
+	args := m.Called(ctx, root, maxDepth, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.OrgNode), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetManagementChain(ctx context.Context, id uuid.UUID) ([]*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetSalaryTotals(ctx context.Context, filter repository.EmployeeFilter) ([]valueobject.SalaryTotals, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]valueobject.SalaryTotals), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetDepartmentSalaryTotals(ctx context.Context, statuses []entity.EmploymentStatus) ([]repository.DepartmentSalaryTotals, error) {
+	args := m.Called(ctx, statuses)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.DepartmentSalaryTotals), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetSalaryDistribution(ctx context.Context, filter repository.EmployeeFilter, rates map[valueobject.Currency]decimal.Decimal, buckets int) (*valueobject.SalaryDistribution, error) {
+	args := m.Called(ctx, filter, rates, buckets)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*valueobject.SalaryDistribution), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) AggregateSalaries(ctx context.Context, aggregation repository.SalaryAggregation) ([]repository.SalaryGroup, error) {
+	args := m.Called(ctx, aggregation)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.SalaryGroup), args.Error(1)
+}
+
+// Stream passes each employee given to Return to fn.
+func (m *MockEmployeeRepository) Stream(ctx context.Context, filter repository.EmployeeFilter, fn func(*entity.Employee) error) error {
+	args := m.Called(ctx, filter)
+	if employees, ok := args.Get(0).([]*entity.Employee); ok {
+		for _, employee := range employees {
+			if err := fn(employee); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockEmployeeRepository) CountByCountry(ctx context.Context) (map[string]int64, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+type MockEmployeeAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockEmployeeAuditRepository) Create(ctx context.Context, entry *entity.EmployeeAuditEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeAuditRepository) CreateBatch(ctx context.Context, entries []*entity.EmployeeAuditEntry) error {
+	args := m.Called(ctx, entries)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeAuditRepository) ListByEmployee(ctx context.Context, employeeID uuid.UUID, page, pageSize int) (*repository.EmployeeAuditPage, error) {
+	args := m.Called(ctx, employeeID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.EmployeeAuditPage), args.Error(1)
+}
+
+type MockEmployeeEventRepository struct {
+	mock.Mock
+}
+
+func (m *MockEmployeeEventRepository) Append(ctx context.Context, events []*entity.EmployeeEvent) error {
+	args := m.Called(ctx, events)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeEventRepository) ListAfter(ctx context.Context, after int64, filter repository.EmployeeEventFilter, limit int) ([]*entity.EmployeeEvent, error) {
+	args := m.Called(ctx, after, filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.EmployeeEvent), args.Error(1)
+}
+
+func (m *MockEmployeeEventRepository) Exists(ctx context.Context, sequence int64) (bool, error) {
+	args := m.Called(ctx, sequence)
+	return args.Bool(0), args.Error(1)
+}
+
+type MockCompensationRepository struct {
+	mock.Mock
+}
+
+func (m *MockCompensationRepository) Insert(ctx context.Context, record *entity.CompensationRecord) error {
+	args := m.Called(ctx, record)
+	return args.Error(0)
+}
+
+func (m *MockCompensationRepository) CreateBatch(ctx context.Context, records []*entity.CompensationRecord) error {
+	args := m.Called(ctx, records)
+	return args.Error(0)
+}
+
+func (m *MockCompensationRepository) FindEffective(ctx context.Context, employeeID uuid.UUID, asOf time.Time) (*entity.CompensationRecord, error) {
+	args := m.Called(ctx, employeeID, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CompensationRecord), args.Error(1)
+}
+
+func (m *MockCompensationRepository) ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]*entity.CompensationRecord, error) {
+	args := m.Called(ctx, employeeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.CompensationRecord), args.Error(1)
+}
+
+func (m *MockCompensationRepository) ListDue(ctx context.Context, asOf time.Time, limit int) ([]*entity.CompensationRecord, error) {
+	args := m.Called(ctx, asOf, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.CompensationRecord), args.Error(1)
+}
+
+func (m *MockCompensationRepository) CancelScheduled(ctx context.Context, employeeID uuid.UUID, asOf time.Time) error {
+	args := m.Called(ctx, employeeID, asOf)
+	return args.Error(0)
+}
+
+type MockSalaryService struct {
+	mock.Mock
+}
+
+func (m *MockSalaryService) CalculateNetSalary(ctx context.Context, employeeID uuid.UUID, asOf time.Time) (*valueobject.Salary, error) {
+	args := m.Called(ctx, employeeID, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*valueobject.Salary), args.Error(1)
+}
+
+func (m *MockSalaryService) NewNetSalaryCalculator(asOf time.Time) salary.NetSalaryCalculator {
+	args := m.Called(asOf)
+	return args.Get(0).(salary.NetSalaryCalculator)
+}
+
+func (m *MockSalaryService) GetSalaryStatsByCountry(ctx context.Context, country string, reportsTo *uuid.UUID, reporting salary.Reporting) (*valueobject.SalaryStats, error) {
+	args := m.Called(ctx, country, reportsTo, reporting)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*valueobject.SalaryStats), args.Error(1)
 }
 
-func (m *MockEmployeeRepository) GetAvgSalaryByJobTitle(ctx context.Context, jobTitle string) (*valueobject.JobTitleSalaryStats, error) {
-	args := m.Called(ctx, jobTitle)
+func (m *MockSalaryService) GetAvgSalaryByJobTitle(ctx context.Context, jobTitle string, reportsTo *uuid.UUID, reporting salary.Reporting) (*valueobject.JobTitleSalaryStats, error) {
+	args := m.Called(ctx, jobTitle, reportsTo, reporting)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*valueobject.JobTitleSalaryStats), args.Error(1)
 }
 
+func (m *MockSalaryService) GetSalaryDistribution(ctx context.Context, params salary.DistributionParams) (*valueobject.SalaryDistribution, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*valueobject.SalaryDistribution), args.Error(1)
+}
+
+func (m *MockSalaryService) AggregateSalaries(ctx context.Context, params salary.AggregateParams) (*salary.Aggregate, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*salary.Aggregate), args.Error(1)
+}
+
+func (m *MockSalaryService) GetDepartmentPayroll(ctx context.Context, params salary.PayrollParams) (*salary.Payroll, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*salary.Payroll), args.Error(1)
+}
+
+type MockNetSalaryCalculator struct {
+	mock.Mock
+}
+
+func (m *MockNetSalaryCalculator) NetSalary(ctx context.Context, country string, grossSalary decimal.Decimal, currency valueobject.Currency) (*valueobject.Salary, error) {
+	args := m.Called(ctx, country, grossSalary, currency)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*valueobject.Salary), args.Error(1)
+}
+
+// passthroughTransactor runs the function without a transaction.
+type passthroughTransactor struct{}
+
+func (passthroughTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (passthroughTransactor) WithinSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+var now = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+// newTestService returns a service whose compensation history and event
+// stream accept every write; tests of them replace them.
+func newTestService(repo *MockEmployeeRepository, auditRepo *MockEmployeeAuditRepository) *service {
+	compensationRepo := new(MockCompensationRepository)
+	compensationRepo.On("Insert", mock.Anything, mock.Anything).Return(nil).Maybe()
+	compensationRepo.On("CreateBatch", mock.Anything, mock.Anything).Return(nil).Maybe()
+	compensationRepo.On("CancelScheduled", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventRepo := new(MockEmployeeEventRepository)
+	eventRepo.On("Append", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return &service{
+		repo:             repo,
+		auditRepo:        auditRepo,
+		compensationRepo: compensationRepo,
+		eventRepo:        eventRepo,
+		salaryService:    new(MockSalaryService),
+		transactor:       passthroughTransactor{},
+		now:              func() time.Time { return now },
+		pollInterval:     time.Millisecond,
+	}
+}
+
 func TestEmployeeService_Create(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("successful creation", func(t *testing.T) {
 		mockRepo := new(MockEmployeeRepository)
-		svc := NewService(mockRepo)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
 
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Employee")).Return(nil)
+		mockAudit.On("Create", ctx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return e.Action == entity.AuditActionCreated && len(e.Changes) == 13 && e.Changes[0].Before == nil
+		})).Return(nil)
 
-		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000))
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "usd", Placement{}, Employment{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, emp)
 		assert.Equal(t, "John Doe", emp.FullName)
 		assert.Equal(t, "Engineer", emp.JobTitle)
 		assert.Equal(t, "India", emp.Country)
+		assert.Equal(t, "USD", emp.Currency)
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("with a manager", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		managerID := uuid.New()
+
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(e *entity.Employee) bool {
+			return e.ManagerID != nil && *e.ManagerID == managerID
+		})).Return(nil)
+		mockAudit.On("Create", ctx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return e.Changes[5].Field == "manager_id" && *e.Changes[5].After == managerID.String()
+		})).Return(nil)
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "", Placement{ManagerID: &managerID}, Employment{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &managerID, emp.ManagerID)
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("in a department and cost center", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		departmentID, costCenterID := uuid.New(), uuid.New()
+
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(e *entity.Employee) bool {
+			return *e.DepartmentID == departmentID && *e.CostCenterID == costCenterID && e.ManagerID == nil
+		})).Return(nil)
+		mockAudit.On("Create", ctx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return e.Changes[6].Field == "department_id" && *e.Changes[6].After == departmentID.String() &&
+				e.Changes[7].Field == "cost_center_id" && *e.Changes[7].After == costCenterID.String()
+		})).Return(nil)
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "",
+			Placement{DepartmentID: &departmentID, CostCenterID: &costCenterID}, Employment{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &departmentID, emp.DepartmentID)
 		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("currency defaults to the country's", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockCompensation := new(MockCompensationRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.compensationRepo = mockCompensation
+
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Employee")).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+		mockCompensation.On("Insert", ctx, mock.MatchedBy(func(r *entity.CompensationRecord) bool {
+			return r.Currency == "INR"
+		})).Return(nil)
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "", Placement{}, Employment{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "INR", emp.Currency)
+		mockCompensation.AssertExpectations(t)
+	})
+
+	t.Run("hired for a later date is pending", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		hireDate := time.Date(2025, 4, 1, 15, 0, 0, 0, time.UTC)
+
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Employee")).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "", Placement{},
+			Employment{HireDate: hireDate, Type: entity.EmploymentTypeContractor})
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), emp.HireDate)
+		assert.Equal(t, entity.EmploymentTypeContractor, emp.EmploymentType)
+		assert.Equal(t, entity.EmploymentStatusPending, emp.Status)
+	})
+
+	t.Run("hire date defaults to today", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Employee")).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "", Placement{}, Employment{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), emp.HireDate)
+		assert.Equal(t, entity.EmploymentTypeFullTime, emp.EmploymentType)
+		assert.Equal(t, entity.EmploymentStatusActive, emp.Status)
+	})
+
+	t.Run("validation error - unknown employment type", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "", Placement{}, Employment{Type: "intern"})
+
+		assert.Nil(t, emp)
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("validation error - unknown currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "XYZ", Placement{}, Employment{})
+
+		assert.Nil(t, emp)
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("validation error - no currency for the country", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "Germany", decimal.NewFromInt(100000), "", Placement{}, Employment{})
+
+		assert.Nil(t, emp)
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("audit failure rolls back", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Employee")).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(errors.NewInternalError(assert.AnError))
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "INR", Placement{}, Employment{})
+
+		assert.Error(t, err)
+		assert.Nil(t, emp)
 	})
 
 	t.Run("validation error - empty name", func(t *testing.T) {
 		mockRepo := new(MockEmployeeRepository)
-		svc := NewService(mockRepo)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
 
-		emp, err := svc.Create(ctx, "", "Engineer", "India", decimal.NewFromInt(100000))
+		emp, err := svc.Create(ctx, "", "Engineer", "India", decimal.NewFromInt(100000), "INR", Placement{}, Employment{})
 
 		assert.Error(t, err)
 		assert.Nil(t, emp)
@@ -88,9 +550,10 @@ func TestEmployeeService_Create(t *testing.T) {
 
 	t.Run("validation error - negative salary", func(t *testing.T) {
 		mockRepo := new(MockEmployeeRepository)
-		svc := NewService(mockRepo)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
 
-		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(-100))
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(-100), "INR", Placement{}, Employment{})
 
 		assert.Error(t, err)
 		assert.Nil(t, emp)
@@ -103,7 +566,8 @@ func TestEmployeeService_GetByID(t *testing.T) {
 
 	t.Run("found", func(t *testing.T) {
 		mockRepo := new(MockEmployeeRepository)
-		svc := NewService(mockRepo)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
 
 		id := uuid.New()
 		expected := &entity.Employee{
@@ -122,7 +586,8 @@ func TestEmployeeService_GetByID(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		mockRepo := new(MockEmployeeRepository)
-		svc := NewService(mockRepo)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
 
 		id := uuid.New()
 		mockRepo.On("FindByID", ctx, id).Return(nil, errors.NewNotFoundError("employee"))
@@ -136,41 +601,529 @@ func TestEmployeeService_GetByID(t *testing.T) {
 	})
 }
 
-func TestEmployeeService_Update(t *testing.T) {
+func TestEmployeeService_List(t *testing.T) {
 	ctx := context.Background()
 
-	t.Run("successful update", func(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
 		mockRepo := new(MockEmployeeRepository)
-		svc := NewService(mockRepo)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
 
-		id := uuid.New()
-		existing := &entity.Employee{
-			ID:          id,
-			FullName:    "John Doe",
-			JobTitle:    "Engineer",
-			Country:     "India",
+		expected := repository.EmployeeListParams{
+			SortBy:   repository.SortByCreatedAt,
+			Page:     1,
+			PageSize: 20,
+		}
+		page := &repository.EmployeePage{TotalCount: 0, Page: 1, PageSize: 20}
+		mockRepo.On("List", ctx, expected).Return(page, nil)
+
+		result, err := svc.List(ctx, repository.EmployeeListParams{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, page, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("caps page size", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		mockRepo.On("List", ctx, mock.MatchedBy(func(p repository.EmployeeListParams) bool {
+			return p.PageSize == 100
+		})).Return(&repository.EmployeePage{}, nil)
+
+		_, err := svc.List(ctx, repository.EmployeeListParams{PageSize: 5000})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("validation error - unsupported sort field", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		result, err := svc.List(ctx, repository.EmployeeListParams{SortBy: "password_hash"})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("validation error - inverted salary range", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		minSalary := decimal.NewFromInt(200000)
+		maxSalary := decimal.NewFromInt(100000)
+		result, err := svc.List(ctx, repository.EmployeeListParams{
+			Filter: repository.EmployeeFilter{MinSalary: &minSalary, MaxSalary: &maxSalary},
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.True(t, errors.IsValidationError(err))
+	})
+}
+
+func TestEmployeeService_Search(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("trims the query and applies the default limit", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		match := repository.EmployeeMatch{Employee: &entity.Employee{FullName: "Srinivas Rao"}, Rank: 0.8, FullNameHighlight: "<mark>Srini</mark>vas Rao"}
+		mockRepo.On("Search", ctx, "srini", defaultPageSize).Return([]repository.EmployeeMatch{match}, nil)
+
+		matches, err := svc.Search(ctx, "  srini ", 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []repository.EmployeeMatch{match}, matches)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("caps the limit", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		mockRepo.On("Search", ctx, "engineer", maxPageSize).Return([]repository.EmployeeMatch{}, nil)
+
+		_, err := svc.Search(ctx, "engineer", 500)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid searches", func(t *testing.T) {
+		cases := map[string]struct {
+			query string
+			limit int
+		}{
+			"empty query":          {query: "   "},
+			"no letters or digits": {query: "&|!"},
+			"too long":             {query: strings.Repeat("a", maxSearchQueryLength+1)},
+			"negative limit":       {query: "john", limit: -1},
+		}
+		for name, tc := range cases {
+			t.Run(name, func(t *testing.T) {
+				mockRepo := new(MockEmployeeRepository)
+				svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+				_, err := svc.Search(ctx, tc.query, tc.limit)
+
+				assert.True(t, errors.IsValidationError(err))
+				mockRepo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
+
+func TestEmployeeService_Update(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful update", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		existing := &entity.Employee{
+			ID:          id,
+			FullName:    "John Doe",
+			JobTitle:    "Engineer",
+			Country:     "India",
 			GrossSalary: decimal.NewFromInt(100000),
+			Currency:    "INR",
 		}
 
 		mockRepo.On("FindByID", ctx, id).Return(existing, nil)
-		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee")).Return(nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), repository.UpdatableEmployeeFields).Return(nil)
+		mockAudit.On("Create", ctx, mock.AnythingOfType("*entity.EmployeeAuditEntry")).Return(nil)
 
-		emp, err := svc.Update(ctx, id, "John Updated", "Senior Engineer", "United States", decimal.NewFromInt(150000))
+		emp, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, FullName: "John Updated", JobTitle: "Senior Engineer", Country: "United States", GrossSalary: decimal.NewFromInt(150000)})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, emp)
 		assert.Equal(t, "John Updated", emp.FullName)
 		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("terminated employee's compensation cannot change", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		terminated := func() *entity.Employee {
+			return &entity.Employee{ID: id, FullName: "John Doe", JobTitle: "Engineer", Country: "India",
+				GrossSalary: decimal.NewFromInt(100000), Currency: "INR", Status: entity.EmploymentStatusTerminated}
+		}
+		mockRepo.On("FindByID", ctx, id).Return(terminated(), nil).Once()
+
+		_, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, GrossSalary: decimal.NewFromInt(120000), UpdateMask: []string{"gross_salary"}})
+
+		assert.True(t, errors.IsConflictError(err))
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+		svc.compensationRepo.(*MockCompensationRepository).AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+
+		mockRepo.On("FindByID", ctx, id).Return(terminated(), nil).Once()
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), []repository.EmployeeField{repository.EmployeeFieldJobTitle}).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+
+		emp, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, JobTitle: "Senior Engineer", UpdateMask: []string{"job_title"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Senior Engineer", emp.JobTitle)
+	})
+
+	t.Run("omitted currency defaults to the stored country's", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		existing := &entity.Employee{ID: id, FullName: "John Doe", Country: "India", GrossSalary: decimal.NewFromInt(100000), Currency: "EUR"}
+		currencyField := []repository.EmployeeField{repository.EmployeeFieldCurrency}
+
+		mockRepo.On("FindByID", ctx, id).Return(existing, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), currencyField).Return(nil)
+		mockAudit.On("Create", ctx, mock.AnythingOfType("*entity.EmployeeAuditEntry")).Return(nil)
+
+		emp, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, UpdateMask: []string{"currency"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "INR", emp.Currency)
+		assert.Equal(t, "India", emp.Country)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("validation error - stored country without a default currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		existing := &entity.Employee{ID: id, FullName: "John Doe", Country: "Germany", GrossSalary: decimal.NewFromInt(100000), Currency: "EUR"}
+		mockRepo.On("FindByID", ctx, id).Return(existing, nil)
+
+		emp, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, Country: "India", UpdateMask: []string{"currency"}})
+
+		assert.Nil(t, emp)
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("records actor and changed fields only", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		actorID := uuid.New()
+		actorCtx := context.WithValue(ctx, authctx.UserIDKey, actorID.String())
+		existing := &entity.Employee{
+			ID:          id,
+			FullName:    "John Doe",
+			JobTitle:    "Engineer",
+			Country:     "India",
+			GrossSalary: decimal.NewFromInt(100000),
+			Currency:    "INR",
+		}
+
+		mockRepo.On("FindByID", actorCtx, id).Return(existing, nil)
+		mockRepo.On("Update", actorCtx, mock.AnythingOfType("*entity.Employee"), repository.UpdatableEmployeeFields).Return(nil)
+
+		var recorded *entity.EmployeeAuditEntry
+		mockAudit.On("Create", actorCtx, mock.AnythingOfType("*entity.EmployeeAuditEntry")).
+			Run(func(args mock.Arguments) { recorded = args.Get(1).(*entity.EmployeeAuditEntry) }).
+			Return(nil)
+
+		_, err := svc.Update(actorCtx, id, EmployeeUpdate{Version: 1, FullName: "John Doe", JobTitle: "Engineer", Country: "India", GrossSalary: decimal.NewFromInt(120000)})
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, recorded) {
+			assert.Equal(t, entity.AuditActionUpdated, recorded.Action)
+			assert.Equal(t, id, recorded.EmployeeID)
+			assert.Equal(t, &actorID, recorded.ActorID)
+			assert.Equal(t, now, recorded.OccurredAt)
+			assert.Len(t, recorded.Changes, 1)
+			assert.Equal(t, "gross_salary", recorded.Changes[0].Field)
+			assert.Equal(t, "100000.00", *recorded.Changes[0].Before)
+			assert.Equal(t, "120000.00", *recorded.Changes[0].After)
+		}
+	})
+
+	t.Run("unchanged update is not audited", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		existing := &entity.Employee{
+			ID:          id,
+			FullName:    "John Doe",
+			JobTitle:    "Engineer",
+			Country:     "India",
+			GrossSalary: decimal.NewFromInt(100000),
+			Currency:    "INR",
+		}
+
+		mockRepo.On("FindByID", ctx, id).Return(existing, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), repository.UpdatableEmployeeFields).Return(nil)
+
+		_, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, FullName: "John Doe", JobTitle: "Engineer", Country: "India", GrossSalary: decimal.RequireFromString("100000.00")})
+
+		assert.NoError(t, err)
+		mockAudit.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("update mask writes named fields only", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		existing := &entity.Employee{
+			ID:          id,
+			FullName:    "John Doe",
+			JobTitle:    "Engineer",
+			Country:     "India",
+			GrossSalary: decimal.NewFromInt(100000),
+			Currency:    "INR",
+			Version:     1,
+		}
+
+		mockRepo.On("FindByID", ctx, id).Return(existing, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), []repository.EmployeeField{repository.EmployeeFieldGrossSalary}).Return(nil)
+		mockAudit.On("Create", ctx, mock.AnythingOfType("*entity.EmployeeAuditEntry")).Return(nil)
+
+		emp, err := svc.Update(ctx, id, EmployeeUpdate{
+			Version:     1,
+			GrossSalary: decimal.NewFromInt(130000),
+			UpdateMask:  []string{"gross_salary", "gross_salary"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "John Doe", emp.FullName)
+		assert.Equal(t, "Engineer", emp.JobTitle)
+		assert.Equal(t, "India", emp.Country)
+		assert.True(t, decimal.NewFromInt(130000).Equal(emp.GrossSalary))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("manager is only changed when named", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id, oldManager, newManager := uuid.New(), uuid.New(), uuid.New()
+		existing := func() *entity.Employee {
+			return &entity.Employee{
+				ID:          id,
+				FullName:    "John Doe",
+				JobTitle:    "Engineer",
+				Country:     "India",
+				GrossSalary: decimal.NewFromInt(100000),
+				Currency:    "INR",
+				ManagerID:   &oldManager,
+				Version:     1,
+			}
+		}
+
+		mockRepo.On("FindByID", ctx, id).Return(existing(), nil).Once()
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), repository.UpdatableEmployeeFields).Return(nil).Once()
+		mockAudit.On("Create", ctx, mock.AnythingOfType("*entity.EmployeeAuditEntry")).Return(nil)
+
+		emp, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, FullName: "John Doe", JobTitle: "Lead", Country: "India", GrossSalary: decimal.NewFromInt(100000)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &oldManager, emp.ManagerID)
+
+		mockRepo.On("FindByID", ctx, id).Return(existing(), nil).Once()
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), []repository.EmployeeField{repository.EmployeeFieldManagerID}).Return(nil).Once()
+
+		emp, err = svc.Update(ctx, id, EmployeeUpdate{Version: 1, ManagerID: &newManager, UpdateMask: []string{"manager_id"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &newManager, emp.ManagerID)
+		assert.Equal(t, "Engineer", emp.JobTitle)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("moves the employee to another department", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id, oldDepartment, newDepartment, costCenter := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+		mockRepo.On("FindByID", ctx, id).Return(&entity.Employee{
+			ID:           id,
+			FullName:     "John Doe",
+			JobTitle:     "Engineer",
+			Country:      "India",
+			GrossSalary:  decimal.NewFromInt(100000),
+			Currency:     "INR",
+			DepartmentID: &oldDepartment,
+			CostCenterID: &costCenter,
+			Version:      1,
+		}, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), []repository.EmployeeField{repository.EmployeeFieldDepartmentID}).Return(nil)
+		mockAudit.On("Create", ctx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return len(e.Changes) == 1 && e.Changes[0].Field == "department_id" &&
+				*e.Changes[0].Before == oldDepartment.String() && *e.Changes[0].After == newDepartment.String()
+		})).Return(nil)
+
+		emp, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, DepartmentID: &newDepartment, UpdateMask: []string{"department_id"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &newDepartment, emp.DepartmentID)
+		assert.Equal(t, &costCenter, emp.CostCenterID)
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("employee cannot be its own manager", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		id := uuid.New()
+
+		_, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, ManagerID: &id, UpdateMask: []string{"manager_id"}})
+
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("update mask fields are validated", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+		_, err := svc.Update(ctx, uuid.New(), EmployeeUpdate{Version: 1, JobTitle: "Engineer", UpdateMask: []string{"full_name"}})
+
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown update mask path", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+		_, err := svc.Update(ctx, uuid.New(), EmployeeUpdate{Version: 1, UpdateMask: []string{"gross_salary", "id"}})
+
+		assert.True(t, errors.IsValidationError(err))
+		assert.Contains(t, errors.GetMessage(err), "id")
+		mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		existing := &entity.Employee{
+			ID:          id,
+			FullName:    "John Doe",
+			JobTitle:    "Engineer",
+			Country:     "India",
+			GrossSalary: decimal.NewFromInt(100000),
+			Currency:    "INR",
+			Version:     3,
+		}
+
+		mockRepo.On("FindByID", ctx, id).Return(existing, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(e *entity.Employee) bool { return e.Version == 2 }), repository.UpdatableEmployeeFields).
+			Return(errors.NewPreconditionFailedError("employee was modified by another request; reload it and retry"))
+
+		emp, err := svc.Update(ctx, id, EmployeeUpdate{Version: 2, FullName: "John Doe", JobTitle: "Engineer", Country: "India", GrossSalary: decimal.NewFromInt(120000)})
+
+		assert.Nil(t, emp)
+		assert.True(t, errors.IsPreconditionFailedError(err))
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("version is required", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+		emp, err := svc.Update(ctx, uuid.New(), EmployeeUpdate{Version: 0, FullName: "John Doe", JobTitle: "Engineer", Country: "India", GrossSalary: decimal.NewFromInt(120000)})
+
+		assert.Nil(t, emp)
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("status moves through allowed transitions", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		existing := &entity.Employee{ID: id, FullName: "John Doe", Status: entity.EmploymentStatusActive}
+		statusField := []repository.EmployeeField{repository.EmployeeFieldStatus}
+		mockRepo.On("FindByID", ctx, id).Return(existing, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), statusField).Return(nil)
+		mockAudit.On("Create", ctx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return len(e.Changes) == 1 && e.Changes[0].Field == "status" && *e.Changes[0].After == "on_leave"
+		})).Return(nil)
+
+		emp, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, Status: entity.EmploymentStatusOnLeave, UpdateMask: []string{"status"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EmploymentStatusOnLeave, emp.Status)
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("status transitions are checked", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			from  entity.EmploymentStatus
+			to    entity.EmploymentStatus
+			check func(error) bool
+		}{
+			{"pending cannot go on leave", entity.EmploymentStatusPending, entity.EmploymentStatusOnLeave, errors.IsConflictError},
+			{"on leave cannot go back to pending", entity.EmploymentStatusOnLeave, entity.EmploymentStatusPending, errors.IsConflictError},
+			{"terminating needs a reason", entity.EmploymentStatusActive, entity.EmploymentStatusTerminated, errors.IsValidationError},
+			{"terminated employees are rehired", entity.EmploymentStatusTerminated, entity.EmploymentStatusActive, errors.IsConflictError},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockRepo := new(MockEmployeeRepository)
+				svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+				id := uuid.New()
+				mockRepo.On("FindByID", ctx, id).Return(&entity.Employee{ID: id, Status: tt.from}, nil)
+
+				emp, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, Status: tt.to, UpdateMask: []string{"status"}})
+
+				assert.Nil(t, emp)
+				assert.True(t, tt.check(err))
+				mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("unknown status is rejected", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+		_, err := svc.Update(ctx, uuid.New(), EmployeeUpdate{Version: 1, Status: "retired", UpdateMask: []string{"status"}})
+
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
 	})
 
 	t.Run("not found", func(t *testing.T) {
 		mockRepo := new(MockEmployeeRepository)
-		svc := NewService(mockRepo)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
 
 		id := uuid.New()
 		mockRepo.On("FindByID", ctx, id).Return(nil, errors.NewNotFoundError("employee"))
 
-		emp, err := svc.Update(ctx, id, "John", "Engineer", "India", decimal.NewFromInt(100000))
+		emp, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, FullName: "John", JobTitle: "Engineer", Country: "India", GrossSalary: decimal.NewFromInt(100000)})
 
 		assert.Error(t, err)
 		assert.Nil(t, emp)
@@ -183,28 +1136,1127 @@ func TestEmployeeService_Delete(t *testing.T) {
 
 	t.Run("successful delete", func(t *testing.T) {
 		mockRepo := new(MockEmployeeRepository)
-		svc := NewService(mockRepo)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
 
 		id := uuid.New()
+		existing := &entity.Employee{
+			ID:          id,
+			FullName:    "John Doe",
+			JobTitle:    "Engineer",
+			Country:     "India",
+			GrossSalary: decimal.NewFromInt(100000),
+			Currency:    "INR",
+		}
+		mockRepo.On("FindByID", ctx, id).Return(existing, nil)
 		mockRepo.On("Delete", ctx, id).Return(nil)
+		mockAudit.On("Create", ctx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return e.Action == entity.AuditActionDeleted && e.ActorID == nil &&
+				len(e.Changes) == 13 && *e.Changes[0].Before == "John Doe" && e.Changes[0].After == nil
+		})).Return(nil)
 
 		err := svc.Delete(ctx, id)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
 	})
 
 	t.Run("not found", func(t *testing.T) {
 		mockRepo := new(MockEmployeeRepository)
-		svc := NewService(mockRepo)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
 
 		id := uuid.New()
-		mockRepo.On("Delete", ctx, id).Return(errors.NewNotFoundError("employee"))
+		mockRepo.On("FindByID", ctx, id).Return(nil, errors.NewNotFoundError("employee"))
 
 		err := svc.Delete(ctx, id)
 
 		assert.Error(t, err)
 		assert.True(t, errors.IsNotFoundError(err))
 		mockRepo.AssertExpectations(t)
+		mockAudit.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEmployeeService_RestoreAndPurge(t *testing.T) {
+	ctx := context.Background()
+	actorID := uuid.New()
+	actorCtx := context.WithValue(ctx, authctx.UserIDKey, actorID.String())
+
+	t.Run("list deleted applies paging defaults", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		mockRepo.On("ListDeleted", ctx, 1, defaultPageSize).Return(&repository.EmployeePage{Page: 1, PageSize: defaultPageSize}, nil)
+
+		page, err := svc.ListDeleted(ctx, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, defaultPageSize, page.PageSize)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("restore is audited with the actor", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		restored := &entity.Employee{ID: id, FullName: "John Doe", JobTitle: "Engineer", Country: "India", GrossSalary: decimal.NewFromInt(100000), Currency: "INR", Version: 4}
+		mockRepo.On("Restore", actorCtx, id).Return(restored, nil)
+		mockAudit.On("Create", actorCtx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return e.Action == entity.AuditActionRestored && *e.ActorID == actorID &&
+				len(e.Changes) == 13 && e.Changes[0].Before == nil && *e.Changes[0].After == "John Doe"
+		})).Return(nil)
+
+		emp, err := svc.Restore(actorCtx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, restored, emp)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("restore of an employee that is not deleted", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		mockRepo.On("Restore", ctx, id).Return(nil, errors.NewNotFoundError("deleted employee"))
+
+		_, err := svc.Restore(ctx, id)
+
+		assert.True(t, errors.IsNotFoundError(err))
+		mockAudit.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("purge is audited with the actor", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		mockRepo.On("Purge", actorCtx, id).Return(nil)
+		mockAudit.On("Create", actorCtx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return e.Action == entity.AuditActionPurged && e.EmployeeID == id && *e.ActorID == actorID
+		})).Return(nil)
+
+		err := svc.Purge(actorCtx, id)
+
+		assert.NoError(t, err)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("purge deleted works in batches", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		cutoff := now.AddDate(0, 0, -90)
+		full := make([]uuid.UUID, purgeBatchSize)
+		for i := range full {
+			full[i] = uuid.New()
+		}
+		mockRepo.On("PurgeDeletedBefore", ctx, cutoff, purgeBatchSize).Return(full, nil).Once()
+		mockRepo.On("PurgeDeletedBefore", ctx, cutoff, purgeBatchSize).Return([]uuid.UUID{uuid.New()}, nil).Once()
+		mockAudit.On("CreateBatch", ctx, mock.MatchedBy(func(entries []*entity.EmployeeAuditEntry) bool {
+			return entries[0].Action == entity.AuditActionPurged && entries[0].ActorID == nil
+		})).Return(nil).Twice()
+
+		purged, err := svc.PurgeDeleted(ctx, cutoff)
+
+		assert.NoError(t, err)
+		assert.Equal(t, purgeBatchSize+1, purged)
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+}
+
+func TestEmployeeService_Events(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create publishes a created event", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockEvents := new(MockEmployeeEventRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.eventRepo = mockEvents
+
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Employee")).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+		mockEvents.On("Append", ctx, mock.MatchedBy(func(events []*entity.EmployeeEvent) bool {
+			return len(events) == 1 && events[0].Type == entity.EmployeeEventCreated && events[0].Country == "India" &&
+				events[0].Employee.FullName == "John Doe" && len(events[0].Changes) == 13 && events[0].OccurredAt.Equal(now)
+		})).Return(nil)
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "", Placement{}, Employment{})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, emp)
+		mockEvents.AssertExpectations(t)
+	})
+
+	t.Run("delete publishes the deleted employee", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockEvents := new(MockEmployeeEventRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.eventRepo = mockEvents
+
+		id := uuid.New()
+		mockRepo.On("FindByID", ctx, id).Return(&entity.Employee{ID: id, FullName: "John Doe", Country: "United States"}, nil)
+		mockRepo.On("Delete", ctx, id).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+		mockEvents.On("Append", ctx, mock.MatchedBy(func(events []*entity.EmployeeEvent) bool {
+			return len(events) == 1 && events[0].Type == entity.EmployeeEventDeleted &&
+				events[0].EmployeeID == id && events[0].Country == "United States"
+		})).Return(nil)
+
+		err := svc.Delete(ctx, id)
+
+		assert.NoError(t, err)
+		mockEvents.AssertExpectations(t)
+	})
+
+	t.Run("update publishes the country the employee moved from", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockEvents := new(MockEmployeeEventRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.eventRepo = mockEvents
+
+		id := uuid.New()
+		existing := &entity.Employee{ID: id, FullName: "John Doe", JobTitle: "Engineer", Country: "India", GrossSalary: decimal.NewFromInt(100000), Currency: "INR"}
+		mockRepo.On("FindByID", ctx, id).Return(existing, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), repository.UpdatableEmployeeFields).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+		mockEvents.On("Append", ctx, mock.MatchedBy(func(events []*entity.EmployeeEvent) bool {
+			return len(events) == 1 && events[0].Type == entity.EmployeeEventUpdated &&
+				events[0].Country == "United States" && events[0].PreviousCountry == "India"
+		})).Return(nil)
+
+		_, err := svc.Update(ctx, id, EmployeeUpdate{Version: 1, FullName: "John Doe", JobTitle: "Engineer", Country: "United States", GrossSalary: decimal.NewFromInt(100000)})
+
+		assert.NoError(t, err)
+		mockEvents.AssertExpectations(t)
+	})
+
+	t.Run("event failure rolls back", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockEvents := new(MockEmployeeEventRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.eventRepo = mockEvents
+
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Employee")).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+		mockEvents.On("Append", ctx, mock.Anything).Return(errors.NewInternalError(assert.AnError))
+
+		emp, err := svc.Create(ctx, "John Doe", "Engineer", "India", decimal.NewFromInt(100000), "", Placement{}, Employment{})
+
+		assert.Nil(t, emp)
+		assert.Error(t, err)
+	})
+
+	t.Run("purge is not published", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockEvents := new(MockEmployeeEventRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.eventRepo = mockEvents
+
+		id := uuid.New()
+		mockRepo.On("Purge", ctx, id).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+
+		err := svc.Purge(ctx, id)
+
+		assert.NoError(t, err)
+		mockEvents.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEmployeeService_Watch(t *testing.T) {
+	event := func(sequence int64) *entity.EmployeeEvent {
+		return &entity.EmployeeEvent{Sequence: sequence, Type: entity.EmployeeEventUpdated, Country: "India"}
+	}
+
+	t.Run("delivers events in order and resumes after the last one", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockEvents := new(MockEmployeeEventRepository)
+		svc := newTestService(new(MockEmployeeRepository), new(MockEmployeeAuditRepository))
+		svc.eventRepo = mockEvents
+
+		filter := repository.EmployeeEventFilter{Country: "India", Types: []entity.EmployeeEventType{entity.EmployeeEventUpdated}}
+		mockEvents.On("Exists", ctx, int64(41)).Return(true, nil)
+		mockEvents.On("ListAfter", ctx, int64(41), filter, watchBatchSize).Return([]*entity.EmployeeEvent{event(42), event(45)}, nil).Once()
+		mockEvents.On("ListAfter", ctx, int64(45), filter, watchBatchSize).Return([]*entity.EmployeeEvent{}, nil).Once()
+		mockEvents.On("ListAfter", ctx, int64(45), filter, watchBatchSize).Return([]*entity.EmployeeEvent{event(46)}, nil).Once()
+		mockEvents.On("ListAfter", ctx, int64(46), filter, watchBatchSize).Return([]*entity.EmployeeEvent{}, nil).
+			Run(func(mock.Arguments) { cancel() })
+
+		var delivered []int64
+		err := svc.Watch(ctx, WatchParams{AfterSequence: 41, Country: "India", Types: filter.Types}, func(e *entity.EmployeeEvent) error {
+			delivered = append(delivered, e.Sequence)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{42, 45, 46}, delivered)
+		mockEvents.AssertExpectations(t)
+	})
+
+	t.Run("delivers moves out of and into the country as deletions and creations", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockEvents := new(MockEmployeeEventRepository)
+		svc := newTestService(new(MockEmployeeRepository), new(MockEmployeeAuditRepository))
+		svc.eventRepo = mockEvents
+
+		movedOut := &entity.EmployeeEvent{Sequence: 1, Type: entity.EmployeeEventUpdated, Country: "France", PreviousCountry: "Germany"}
+		movedIn := &entity.EmployeeEvent{Sequence: 2, Type: entity.EmployeeEventUpdated, Country: "Germany", PreviousCountry: "France"}
+		updated := &entity.EmployeeEvent{Sequence: 3, Type: entity.EmployeeEventUpdated, Country: "Germany"}
+		filter := repository.EmployeeEventFilter{
+			Country: "Germany",
+			Types:   []entity.EmployeeEventType{entity.EmployeeEventCreated, entity.EmployeeEventDeleted, entity.EmployeeEventUpdated},
+		}
+		mockEvents.On("ListAfter", ctx, int64(0), filter, watchBatchSize).Return([]*entity.EmployeeEvent{movedOut, movedIn, updated}, nil).Once()
+		mockEvents.On("ListAfter", ctx, int64(3), filter, watchBatchSize).Return([]*entity.EmployeeEvent{}, nil).
+			Run(func(mock.Arguments) { cancel() })
+
+		var delivered []entity.EmployeeEventType
+		err := svc.Watch(ctx, WatchParams{Country: "Germany", Types: filter.Types[:2]}, func(e *entity.EmployeeEvent) error {
+			delivered = append(delivered, e.Type)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.EmployeeEventType{entity.EmployeeEventDeleted, entity.EmployeeEventCreated}, delivered)
+		assert.Equal(t, entity.EmployeeEventUpdated, movedOut.Type)
+		mockEvents.AssertExpectations(t)
+	})
+
+	t.Run("stops when the watcher fails", func(t *testing.T) {
+		ctx := context.Background()
+		mockEvents := new(MockEmployeeEventRepository)
+		svc := newTestService(new(MockEmployeeRepository), new(MockEmployeeAuditRepository))
+		svc.eventRepo = mockEvents
+		mockEvents.On("ListAfter", ctx, int64(0), repository.EmployeeEventFilter{}, watchBatchSize).
+			Return([]*entity.EmployeeEvent{event(1), event(2)}, nil)
+
+		calls := 0
+		err := svc.Watch(ctx, WatchParams{}, func(*entity.EmployeeEvent) error {
+			calls++
+			return assert.AnError
+		})
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("rejects a sequence that was never committed", func(t *testing.T) {
+		ctx := context.Background()
+		mockEvents := new(MockEmployeeEventRepository)
+		svc := newTestService(new(MockEmployeeRepository), new(MockEmployeeAuditRepository))
+		svc.eventRepo = mockEvents
+		mockEvents.On("Exists", ctx, int64(1042)).Return(false, nil)
+
+		err := svc.Watch(ctx, WatchParams{AfterSequence: 1042}, func(*entity.EmployeeEvent) error { return nil })
+
+		assert.True(t, errors.IsNotFoundError(err))
+		assert.Contains(t, err.Error(), "employee event 1042")
+		mockEvents.AssertNotCalled(t, "ListAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects invalid parameters", func(t *testing.T) {
+		cases := map[string]WatchParams{
+			"negative sequence":  {AfterSequence: -1},
+			"unknown event type": {Types: []entity.EmployeeEventType{"purged"}},
+		}
+		for name, params := range cases {
+			t.Run(name, func(t *testing.T) {
+				mockEvents := new(MockEmployeeEventRepository)
+				svc := newTestService(new(MockEmployeeRepository), new(MockEmployeeAuditRepository))
+				svc.eventRepo = mockEvents
+
+				err := svc.Watch(context.Background(), params, func(*entity.EmployeeEvent) error { return nil })
+
+				assert.True(t, errors.IsValidationError(err))
+				mockEvents.AssertNotCalled(t, "ListAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
+
+func TestEmployeeService_Terminate(t *testing.T) {
+	ctx := context.Background()
+	hireDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	statusField := []repository.EmployeeField{repository.EmployeeFieldStatus}
+
+	t.Run("records the reason and last working day", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		existing := &entity.Employee{ID: id, HireDate: hireDate, Status: entity.EmploymentStatusOnLeave, Version: 2}
+		mockRepo.On("FindByID", ctx, id).Return(existing, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(e *entity.Employee) bool {
+			return e.Status == entity.EmploymentStatusTerminated && e.Version == 2
+		}), statusField).Return(nil)
+		mockAudit.On("Create", ctx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return len(e.Changes) == 3 && e.Changes[0].Field == "status" &&
+				*e.Changes[1].After == "resignation" && *e.Changes[2].After == "2025-03-14"
+		})).Return(nil)
+
+		emp, err := svc.Terminate(ctx, id, Termination{
+			Reason:         entity.TerminationReasonResignation,
+			LastWorkingDay: time.Date(2025, 3, 14, 18, 0, 0, 0, time.UTC),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EmploymentStatusTerminated, emp.Status)
+		assert.Equal(t, time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), *emp.LastWorkingDay)
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("last working day defaults to today", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		mockRepo.On("FindByID", ctx, id).Return(&entity.Employee{ID: id, HireDate: hireDate, Status: entity.EmploymentStatusActive}, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), statusField).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+
+		emp, err := svc.Terminate(ctx, id, Termination{Reason: entity.TerminationReasonRedundancy})
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), *emp.LastWorkingDay)
+	})
+
+	t.Run("cancels scheduled compensation changes", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockCompensation := new(MockCompensationRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.compensationRepo = mockCompensation
+
+		id := uuid.New()
+		mockRepo.On("FindByID", ctx, id).Return(&entity.Employee{ID: id, HireDate: hireDate, Status: entity.EmploymentStatusActive}, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), statusField).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+		mockCompensation.On("CancelScheduled", ctx, id, now).Return(nil)
+
+		_, err := svc.Terminate(ctx, id, Termination{Reason: entity.TerminationReasonResignation})
+
+		assert.NoError(t, err)
+		mockCompensation.AssertExpectations(t)
+	})
+
+	t.Run("rejects employees that cannot be terminated", func(t *testing.T) {
+		for _, status := range []entity.EmploymentStatus{entity.EmploymentStatusPending, entity.EmploymentStatusTerminated} {
+			mockRepo := new(MockEmployeeRepository)
+			svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+			id := uuid.New()
+			mockRepo.On("FindByID", ctx, id).Return(&entity.Employee{ID: id, HireDate: hireDate, Status: status}, nil)
+
+			emp, err := svc.Terminate(ctx, id, Termination{Reason: entity.TerminationReasonOther})
+
+			assert.Nil(t, emp)
+			assert.True(t, errors.IsConflictError(err), status)
+			mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("last working day cannot be before the hire date", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		id := uuid.New()
+		mockRepo.On("FindByID", ctx, id).Return(&entity.Employee{ID: id, HireDate: hireDate, Status: entity.EmploymentStatusActive}, nil)
+
+		_, err := svc.Terminate(ctx, id, Termination{Reason: entity.TerminationReasonDismissal, LastWorkingDay: hireDate.AddDate(0, 0, -1)})
+
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("reason is required", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+		_, err := svc.Terminate(ctx, uuid.New(), Termination{})
+
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEmployeeService_Rehire(t *testing.T) {
+	ctx := context.Background()
+	lastWorkingDay := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	terminated := func(id uuid.UUID) *entity.Employee {
+		return &entity.Employee{
+			ID:                id,
+			HireDate:          time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC),
+			EmploymentType:    entity.EmploymentTypePartTime,
+			Status:            entity.EmploymentStatusTerminated,
+			TerminationReason: entity.TerminationReasonContractEnd,
+			LastWorkingDay:    &lastWorkingDay,
+		}
+	}
+
+	t.Run("starts a new employment", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		mockRepo.On("FindByID", ctx, id).Return(terminated(id), nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Employee"), []repository.EmployeeField{
+			repository.EmployeeFieldHireDate, repository.EmployeeFieldEmploymentType, repository.EmployeeFieldStatus,
+		}).Return(nil)
+		mockAudit.On("Create", ctx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return len(e.Changes) == 4 && e.Changes[0].Field == "hire_date" && *e.Changes[0].After == "2025-03-01"
+		})).Return(nil)
+
+		emp, err := svc.Rehire(ctx, id, Rehire{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EmploymentStatusActive, emp.Status)
+		assert.Equal(t, entity.EmploymentTypePartTime, emp.EmploymentType)
+		assert.Empty(t, emp.TerminationReason)
+		assert.Nil(t, emp.LastWorkingDay)
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("rehired for a later date is pending", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		mockRepo.On("FindByID", ctx, id).Return(terminated(id), nil)
+		mockRepo.On("Update", ctx, mock.Anything, mock.Anything).Return(nil)
+		mockAudit.On("Create", ctx, mock.Anything).Return(nil)
+
+		emp, err := svc.Rehire(ctx, id, Rehire{HireDate: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), Type: entity.EmploymentTypeFullTime})
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EmploymentStatusPending, emp.Status)
+		assert.Equal(t, entity.EmploymentTypeFullTime, emp.EmploymentType)
+	})
+
+	t.Run("only terminated employees", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		id := uuid.New()
+		mockRepo.On("FindByID", ctx, id).Return(&entity.Employee{ID: id, Status: entity.EmploymentStatusActive}, nil)
+
+		emp, err := svc.Rehire(ctx, id, Rehire{})
+
+		assert.Nil(t, emp)
+		assert.True(t, errors.IsConflictError(err))
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("hire date must be after the last working day", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		id := uuid.New()
+		mockRepo.On("FindByID", ctx, id).Return(terminated(id), nil)
+
+		_, err := svc.Rehire(ctx, id, Rehire{HireDate: lastWorkingDay})
+
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestEmployeeService_Compensation(t *testing.T) {
+	ctx := context.Background()
+	approverID := uuid.New()
+	actorCtx := context.WithValue(ctx, authctx.UserIDKey, approverID.String())
+
+	newEmployee := func() *entity.Employee {
+		return &entity.Employee{ID: uuid.New(), FullName: "John Doe", JobTitle: "Engineer", Country: "India", GrossSalary: decimal.NewFromInt(100000), Currency: "INR", Status: entity.EmploymentStatusActive, Version: 3}
+	}
+
+	t.Run("future-dated raise leaves the current salary", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockCompensation := new(MockCompensationRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.compensationRepo = mockCompensation
+
+		employee := newEmployee()
+		effectiveFrom := now.AddDate(0, 1, 0)
+		mockRepo.On("FindByID", actorCtx, employee.ID).Return(employee, nil)
+		mockCompensation.On("Insert", actorCtx, mock.MatchedBy(func(r *entity.CompensationRecord) bool {
+			return r.EmployeeID == employee.ID && r.Reason == entity.CompensationReasonPromotion &&
+				r.EffectiveFrom.Equal(effectiveFrom) && r.ApproverID != nil && *r.ApproverID == approverID
+		})).Return(nil)
+
+		record, err := svc.ScheduleCompensation(actorCtx, employee.ID, CompensationChange{
+			GrossSalary:   decimal.NewFromInt(120000),
+			Reason:        entity.CompensationReasonPromotion,
+			EffectiveFrom: effectiveFrom,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "120000", record.GrossSalary.String())
+		assert.Equal(t, "100000", employee.GrossSalary.String())
+		mockCompensation.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+		mockAudit.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("change effective now updates the salary", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockCompensation := new(MockCompensationRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.compensationRepo = mockCompensation
+
+		employee := newEmployee()
+		mockRepo.On("FindByID", actorCtx, employee.ID).Return(employee, nil)
+		mockCompensation.On("Insert", actorCtx, mock.MatchedBy(func(r *entity.CompensationRecord) bool {
+			return r.EffectiveFrom.Equal(now)
+		})).Return(nil)
+		mockRepo.On("Update", actorCtx, mock.MatchedBy(func(e *entity.Employee) bool {
+			return e.GrossSalary.Equal(decimal.NewFromInt(110000)) && e.Version == 3
+		}), []repository.EmployeeField{repository.EmployeeFieldGrossSalary, repository.EmployeeFieldCurrency}).Return(nil)
+		mockAudit.On("Create", actorCtx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return e.Action == entity.AuditActionUpdated && *e.ActorID == approverID &&
+				len(e.Changes) == 1 && e.Changes[0].Field == "gross_salary"
+		})).Return(nil)
+
+		_, err := svc.ScheduleCompensation(actorCtx, employee.ID, CompensationChange{
+			GrossSalary: decimal.NewFromInt(110000),
+			Reason:      entity.CompensationReasonMerit,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid changes", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+		for name, change := range map[string]CompensationChange{
+			"past effective_from": {GrossSalary: decimal.NewFromInt(1), Reason: entity.CompensationReasonMerit, EffectiveFrom: now.Add(-time.Hour)},
+			"hire reason":         {GrossSalary: decimal.NewFromInt(1), Reason: entity.CompensationReasonHire},
+			"missing reason":      {GrossSalary: decimal.NewFromInt(1)},
+			"negative salary":     {GrossSalary: decimal.NewFromInt(-1), Reason: entity.CompensationReasonMerit},
+		} {
+			_, err := svc.ScheduleCompensation(ctx, uuid.New(), change)
+			assert.True(t, errors.IsValidationError(err), name)
+		}
+		mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("salary update records an adjustment", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockCompensation := new(MockCompensationRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.compensationRepo = mockCompensation
+
+		employee := newEmployee()
+		mockRepo.On("FindByID", actorCtx, employee.ID).Return(employee, nil)
+		mockRepo.On("Update", actorCtx, mock.Anything, mock.Anything).Return(nil)
+		mockAudit.On("Create", actorCtx, mock.Anything).Return(nil)
+		mockCompensation.On("Insert", actorCtx, mock.MatchedBy(func(r *entity.CompensationRecord) bool {
+			return r.Reason == entity.CompensationReasonAdjustment && r.GrossSalary.Equal(decimal.NewFromInt(105000))
+		})).Return(nil)
+
+		_, err := svc.Update(actorCtx, employee.ID, EmployeeUpdate{Version: 3, GrossSalary: decimal.NewFromInt(105000), UpdateMask: []string{"gross_salary"}})
+
+		assert.NoError(t, err)
+		mockCompensation.AssertExpectations(t)
+	})
+
+	t.Run("applies due changes and skips stale employees", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockCompensation := new(MockCompensationRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.compensationRepo = mockCompensation
+
+		current, stale := newEmployee(), newEmployee()
+		due := []*entity.CompensationRecord{
+			entity.NewCompensationRecord(current.ID, decimal.NewFromInt(130000), "INR", entity.CompensationReasonPromotion, &approverID, now.Add(-time.Minute)),
+			entity.NewCompensationRecord(stale.ID, decimal.NewFromInt(140000), "INR", entity.CompensationReasonMerit, &approverID, now.Add(-time.Minute)),
+		}
+		mockCompensation.On("ListDue", ctx, now, compensationBatchSize).Return(due, nil)
+		mockRepo.On("FindByID", ctx, current.ID).Return(current, nil)
+		mockRepo.On("FindByID", ctx, stale.ID).Return(stale, nil)
+		mockRepo.On("Update", ctx, current, mock.Anything).Return(nil)
+		mockRepo.On("Update", ctx, stale, mock.Anything).Return(errors.NewPreconditionFailedError("stale"))
+		mockAudit.On("Create", ctx, mock.MatchedBy(func(e *entity.EmployeeAuditEntry) bool {
+			return e.EmployeeID == current.ID && *e.ActorID == approverID
+		})).Return(nil).Once()
+
+		applied, err := svc.ApplyDueCompensation(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, applied)
+		assert.Equal(t, "130000", current.GrossSalary.String())
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("skips employees terminated since the change was listed", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockCompensation := new(MockCompensationRepository)
+		svc := newTestService(mockRepo, mockAudit)
+		svc.compensationRepo = mockCompensation
+
+		terminated := newEmployee()
+		terminated.Status = entity.EmploymentStatusTerminated
+		due := []*entity.CompensationRecord{
+			entity.NewCompensationRecord(terminated.ID, decimal.NewFromInt(130000), "INR", entity.CompensationReasonMerit, &approverID, now.Add(-time.Minute)),
+		}
+		mockCompensation.On("ListDue", ctx, now, compensationBatchSize).Return(due, nil)
+		mockRepo.On("FindByID", ctx, terminated.ID).Return(terminated, nil)
+
+		applied, err := svc.ApplyDueCompensation(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, applied)
+		assert.Equal(t, "100000", terminated.GrossSalary.String())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+		mockAudit.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("conflict - terminated employee", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockCompensation := new(MockCompensationRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		svc.compensationRepo = mockCompensation
+
+		employee := newEmployee()
+		employee.Status = entity.EmploymentStatusTerminated
+		mockRepo.On("FindByID", actorCtx, employee.ID).Return(employee, nil)
+
+		_, err := svc.ScheduleCompensation(actorCtx, employee.ID, CompensationChange{
+			GrossSalary: decimal.NewFromInt(120000),
+			Reason:      entity.CompensationReasonMerit,
+		})
+
+		assert.Equal(t, 409, errors.GetStatusCode(err))
+		mockCompensation.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEmployeeService_History(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("applies paging defaults", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		page := &repository.EmployeeAuditPage{
+			Entries:    []*entity.EmployeeAuditEntry{entity.NewEmployeeAuditEntry(id, entity.AuditActionCreated, nil, nil, now)},
+			TotalCount: 1,
+			Page:       1,
+			PageSize:   defaultPageSize,
+		}
+		mockAudit.On("ListByEmployee", ctx, id, 1, defaultPageSize).Return(page, nil)
+
+		history, err := svc.History(ctx, id, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, page, history)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("employee without audited changes", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		empty := &repository.EmployeeAuditPage{Page: 1, PageSize: maxPageSize}
+		mockAudit.On("ListByEmployee", ctx, id, 1, maxPageSize).Return(empty, nil)
+		mockRepo.On("FindByIDWithDeleted", ctx, id).Return(&entity.Employee{ID: id}, nil)
+
+		history, err := svc.History(ctx, id, 1, 500)
+
+		assert.NoError(t, err)
+		assert.Equal(t, empty, history)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown employee", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		id := uuid.New()
+		mockAudit.On("ListByEmployee", ctx, id, 1, maxPageSize).Return(&repository.EmployeeAuditPage{Page: 1, PageSize: maxPageSize}, nil)
+		mockRepo.On("FindByIDWithDeleted", ctx, id).Return(nil, errors.NewNotFoundError("employee"))
+
+		history, err := svc.History(ctx, id, 1, 500)
+
+		assert.Nil(t, history)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func TestEmployeeService_Import(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("commits every row", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		rows, err := NewCSVRowReader(strings.NewReader(
+			"full_name,job_title,country,gross_salary\n" +
+				"John Doe,Engineer,India,100000\n" +
+				"Jane Roe,Manager,United States,\"150000.50\"\n"))
+		assert.NoError(t, err)
+
+		mockRepo.On("CreateBatch", ctx, mock.MatchedBy(func(batch []*entity.Employee) bool {
+			return len(batch) == 2 && batch[1].FullName == "Jane Roe" && batch[1].GrossSalary.Equal(decimal.RequireFromString("150000.50"))
+		})).Return(nil)
+		mockAudit.On("CreateBatch", ctx, mock.MatchedBy(func(entries []*entity.EmployeeAuditEntry) bool {
+			return len(entries) == 2 && entries[0].Action == entity.AuditActionCreated
+		})).Return(nil)
+
+		result, err := svc.Import(ctx, rows, false)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Committed)
+		assert.Equal(t, 2, result.TotalRows)
+		assert.Equal(t, 2, result.ImportedRows)
+		assert.Empty(t, result.Errors)
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("reports row errors with line numbers and writes nothing", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		rows, err := NewCSVRowReader(strings.NewReader(
+			"country,full_name,job_title,gross_salary\n" +
+				"India,John Doe,Engineer,100000\n" +
+				"India,,Engineer,100000\n" +
+				"India,Jane Roe,Engineer,lots\n" +
+				"India,Jim Poe,Engineer\n" +
+				"India,Joe Bloggs,Engineer,-1\n"))
+		assert.NoError(t, err)
+
+		result, err := svc.Import(ctx, rows, false)
+
+		assert.NoError(t, err)
+		assert.False(t, result.Committed)
+		assert.Equal(t, 5, result.TotalRows)
+		assert.Equal(t, 0, result.ImportedRows)
+		assert.Equal(t, 4, result.ErrorCount)
+		if assert.Len(t, result.Errors, 4) {
+			assert.Equal(t, 3, result.Errors[0].Line)
+			assert.Contains(t, result.Errors[0].Message, "full_name")
+			assert.Equal(t, 4, result.Errors[1].Line)
+			assert.Equal(t, 5, result.Errors[2].Line)
+			assert.Equal(t, 6, result.Errors[3].Line)
+		}
+		mockRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("dry run validates only", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		rows, err := NewCSVRowReader(strings.NewReader(
+			"full_name,job_title,country,gross_salary\nJohn Doe,Engineer,India,100000\n"))
+		assert.NoError(t, err)
+
+		result, err := svc.Import(ctx, rows, true)
+
+		assert.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.False(t, result.Committed)
+		assert.Equal(t, 1, result.TotalRows)
+		assert.Empty(t, result.Errors)
+		mockRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("optional currency column", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		rows, err := NewCSVRowReader(strings.NewReader(
+			"full_name,job_title,country,gross_salary,currency\n" +
+				"John Doe,Engineer,India,100000,usd\n" +
+				"Jane Roe,Manager,India,150000,\n"))
+		assert.NoError(t, err)
+
+		mockRepo.On("CreateBatch", ctx, mock.MatchedBy(func(batch []*entity.Employee) bool {
+			return len(batch) == 2 && batch[0].Currency == "USD" && batch[1].Currency == "INR"
+		})).Return(nil)
+		mockAudit.On("CreateBatch", ctx, mock.Anything).Return(nil)
+
+		result, err := svc.Import(ctx, rows, false)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Committed)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("optional hire date and employment type columns", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockRepo, mockAudit)
+
+		rows, err := NewCSVRowReader(strings.NewReader(
+			"full_name,job_title,country,gross_salary,hire_date,employment_type\n" +
+				"John Doe,Engineer,India,100000,2020-05-04,contractor\n" +
+				"Jane Roe,Manager,India,150000,2025-06-01,\n"))
+		assert.NoError(t, err)
+
+		mockRepo.On("CreateBatch", ctx, mock.MatchedBy(func(batch []*entity.Employee) bool {
+			return len(batch) == 2 &&
+				batch[0].HireDate.Equal(time.Date(2020, 5, 4, 0, 0, 0, 0, time.UTC)) &&
+				batch[0].EmploymentType == entity.EmploymentTypeContractor && batch[0].Status == entity.EmploymentStatusActive &&
+				batch[1].EmploymentType == entity.EmploymentTypeFullTime && batch[1].Status == entity.EmploymentStatusPending
+		})).Return(nil)
+		mockAudit.On("CreateBatch", ctx, mock.Anything).Return(nil)
+
+		result, err := svc.Import(ctx, rows, false)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Committed)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects a header with missing columns", func(t *testing.T) {
+		_, err := NewCSVRowReader(strings.NewReader("full_name,job_title,country\n"))
+
+		assert.True(t, errors.IsValidationError(err))
+	})
+}
+
+func TestEmployeeService_Export(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	employees := []*entity.Employee{
+		{ID: uuid.New(), FullName: "John Doe", JobTitle: "Engineer", Country: "India", GrossSalary: decimal.NewFromInt(1800000), Currency: "INR", CreatedAt: created, UpdatedAt: created},
+		{ID: uuid.New(), FullName: "Doe, Jane", JobTitle: "Manager", Country: "Germany", GrossSalary: decimal.RequireFromString("150000.5"), Currency: "EUR", CreatedAt: created, UpdatedAt: created},
+	}
+	filter := repository.EmployeeFilter{Country: "India"}
+	indiaNet := &valueobject.Salary{
+		Currency:    valueobject.CurrencyINR,
+		GrossSalary: decimal.NewFromInt(1800000),
+		TaxRate:     decimal.RequireFromString("0.1153"),
+		TaxAmount:   decimal.NewFromInt(207500),
+		NetSalary:   decimal.NewFromInt(1592500),
+	}
+	germanyNet := &valueobject.Salary{Currency: "EUR", GrossSalary: employees[1].GrossSalary, NetSalary: employees[1].GrossSalary}
+	// netSalaries is a salary service taxing the employees above with the
+	// catalog rules in force now.
+	netSalaries := func() (*MockSalaryService, *MockNetSalaryCalculator) {
+		calculator := new(MockNetSalaryCalculator)
+		calculator.On("NetSalary", ctx, "India", employees[0].GrossSalary, valueobject.CurrencyINR).Return(indiaNet, nil)
+		calculator.On("NetSalary", ctx, "Germany", employees[1].GrossSalary, valueobject.Currency("EUR")).Return(germanyNet, nil)
+		salaryService := new(MockSalaryService)
+		salaryService.On("NewNetSalaryCalculator", now).Return(calculator)
+		return salaryService, calculator
+	}
+
+	t.Run("csv with net salary", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		salaryService, calculator := netSalaries()
+		svc.salaryService = salaryService
+		mockRepo.On("Stream", ctx, filter).Return(employees, nil)
+
+		var out strings.Builder
+		err := svc.Export(ctx, ExportParams{Filter: filter, IncludeNetSalary: true}, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t,
+			"id,full_name,job_title,country,gross_salary,currency,created_at,updated_at,tax_amount,tax_rate,net_salary\n"+
+				employees[0].ID.String()+",John Doe,Engineer,India,1800000.00,INR,2024-03-01T09:30:00Z,2024-03-01T09:30:00Z,207500.00,0.1153,1592500.00\n"+
+				employees[1].ID.String()+",\"Doe, Jane\",Manager,Germany,150000.50,EUR,2024-03-01T09:30:00Z,2024-03-01T09:30:00Z,0.00,0,150000.50\n",
+			out.String())
+		calculator.AssertExpectations(t)
+	})
+
+	t.Run("fails without a tax rule for an employee's country", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		calculator := new(MockNetSalaryCalculator)
+		calculator.On("NetSalary", ctx, "India", mock.Anything, mock.Anything).Return(nil, errors.NewNotFoundError("tax rule for India"))
+		salaryService := new(MockSalaryService)
+		salaryService.On("NewNetSalaryCalculator", now).Return(calculator)
+		svc.salaryService = salaryService
+		mockRepo.On("Stream", ctx, filter).Return(employees[:1], nil)
+
+		err := svc.Export(ctx, ExportParams{Filter: filter, IncludeNetSalary: true}, io.Discard)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("json lines", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		mockRepo.On("Stream", ctx, filter).Return(employees[:1], nil)
+
+		var out strings.Builder
+		err := svc.Export(ctx, ExportParams{Filter: filter, Format: ExportFormatJSONL}, &out)
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":"`+employees[0].ID.String()+`","full_name":"John Doe","job_title":"Engineer","country":"India",`+
+			`"gross_salary":"1800000.00","currency":"INR","created_at":"2024-03-01T09:30:00Z","updated_at":"2024-03-01T09:30:00Z"}`, out.String())
+		assert.True(t, strings.HasSuffix(out.String(), "}\n"))
+	})
+
+	t.Run("parquet", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		mockRepo.On("Stream", ctx, filter).Return(employees, nil)
+
+		svc.salaryService, _ = netSalaries()
+
+		var out bytes.Buffer
+		err := svc.Export(ctx, ExportParams{Filter: filter, Format: ExportFormatParquet, IncludeNetSalary: true}, &out)
+		assert.NoError(t, err)
+
+		rows, err := parquet.Read[parquetEmployeeWithNetSalary](bytes.NewReader(out.Bytes()), int64(out.Len()))
+		assert.NoError(t, err)
+		if assert.Len(t, rows, 2) {
+			assert.Equal(t, employees[0].ID.String(), rows[0].ID)
+			assert.Equal(t, int64(180000000), rows[0].GrossSalary)
+			assert.Equal(t, "INR", rows[0].Currency)
+			assert.Equal(t, int64(159250000), rows[0].NetSalary)
+			assert.True(t, created.Equal(rows[0].CreatedAt))
+			assert.Equal(t, int64(15000050), rows[1].GrossSalary)
+		}
+	})
+
+	t.Run("rejects an unknown format", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+		err := svc.Export(ctx, ExportParams{Format: "xlsx"}, io.Discard)
+
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "Stream", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects an inverted salary range", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		minSalary, maxSalary := decimal.NewFromInt(200), decimal.NewFromInt(100)
+
+		err := svc.Export(ctx, ExportParams{Filter: repository.EmployeeFilter{MinSalary: &minSalary, MaxSalary: &maxSalary}}, io.Discard)
+
+		assert.True(t, errors.IsValidationError(err))
+	})
+}
+
+func TestEmployeeService_OrgChart(t *testing.T) {
+	ctx := context.Background()
+	ceo := &entity.Employee{ID: uuid.New(), FullName: "Ada \"The Boss\" King", JobTitle: "CEO", Country: "India"}
+	cto := &entity.Employee{ID: uuid.New(), FullName: "Grace Hopper", JobTitle: "CTO", Country: "United States", ManagerID: &ceo.ID}
+	engineer := &entity.Employee{ID: uuid.New(), FullName: "John Doe", JobTitle: "Engineer", Country: "India", ManagerID: &cto.ID}
+	tree := []repository.OrgNode{{Employee: ceo, Depth: 0}, {Employee: cto, Depth: 1}, {Employee: engineer, Depth: 2}}
+
+	t.Run("direct reports leave out the manager", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		mockRepo.On("ListOrgTree", ctx, &ceo.ID, 1, MaxOrgTreeSize+1).Return(tree[:2], nil)
+
+		reports, err := svc.DirectReports(ctx, ceo.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entity.Employee{cto}, reports)
+	})
+
+	t.Run("reports keep their depth", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		mockRepo.On("ListOrgTree", ctx, &ceo.ID, 0, MaxOrgTreeSize+1).Return(tree, nil)
+
+		reports, err := svc.Reports(ctx, ceo.ID, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tree[1:], reports)
+	})
+
+	t.Run("rejects a negative depth", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+		_, err := svc.Reports(ctx, ceo.ID, -1)
+
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "ListOrgTree", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("dot", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		mockRepo.On("ListOrgTree", ctx, (*uuid.UUID)(nil), 0, MaxOrgTreeSize+1).Return(tree, nil)
+
+		var out strings.Builder
+		err := svc.ExportOrgChart(ctx, OrgChartParams{Format: OrgChartFormatDOT}, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "digraph org_chart {\n"+
+			"  node [shape=box];\n"+
+			"  \""+ceo.ID.String()+"\" [label=\"Ada \\\"The Boss\\\" King\\nCEO\"];\n"+
+			"  \""+cto.ID.String()+"\" [label=\"Grace Hopper\\nCTO\"];\n"+
+			"  \""+engineer.ID.String()+"\" [label=\"John Doe\\nEngineer\"];\n"+
+			"  \""+ceo.ID.String()+"\" -> \""+cto.ID.String()+"\";\n"+
+			"  \""+cto.ID.String()+"\" -> \""+engineer.ID.String()+"\";\n"+
+			"}\n", out.String())
+	})
+
+	t.Run("json nests reports under their manager", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		mockRepo.On("ListOrgTree", ctx, &cto.ID, 0, MaxOrgTreeSize+1).Return(tree[1:], nil)
+
+		var out strings.Builder
+		err := svc.ExportOrgChart(ctx, OrgChartParams{Root: &cto.ID, Format: OrgChartFormatJSON}, &out)
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"employees":[{"id":"`+cto.ID.String()+`","full_name":"Grace Hopper","job_title":"CTO","country":"United States","reports":[`+
+			`{"id":"`+engineer.ID.String()+`","full_name":"John Doe","job_title":"Engineer","country":"India","reports":[]}]}]}`, out.String())
+	})
+
+	t.Run("rejects charts that are too large", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+		mockRepo.On("ListOrgTree", ctx, (*uuid.UUID)(nil), 0, MaxOrgTreeSize+1).Return(make([]repository.OrgNode, MaxOrgTreeSize+1), nil)
+
+		err := svc.ExportOrgChart(ctx, OrgChartParams{Format: OrgChartFormatJSON}, io.Discard)
+
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("rejects an unknown format", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := newTestService(mockRepo, new(MockEmployeeAuditRepository))
+
+		err := svc.ExportOrgChart(ctx, OrgChartParams{Format: "svg"}, io.Discard)
+
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "ListOrgTree", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	})
 }
@@ -0,0 +1,91 @@
+package employee
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+)
+
+const (
+	// watchBatchSize is the number of events Watch reads at a time.
+	watchBatchSize = 100
+	// defaultWatchPollInterval is how often Watch looks for new events once
+	// it has caught up.
+	defaultWatchPollInterval = time.Second
+)
+
+// WatchParams selects the events Watch delivers: those after AfterSequence,
+// zero for the whole stream, for employees in Country and of one of Types.
+// Empty filters match every event. An employee moving out of Country is
+// delivered as deleted, and one moving in as created.
+type WatchParams struct {
+	AfterSequence int64
+	Country       string
+	Types         []entity.EmployeeEventType
+}
+
+// Watch calls fn with every committed employee event matching params, in
+// commit order, then with new events as they are committed, until ctx is
+// done or fn fails. A watcher that reconnects passes the sequence of the
+// last event it handled to resume without gaps or repeats; a sequence that
+// was never committed is rejected rather than replaying the whole stream.
+func (s *service) Watch(ctx context.Context, params WatchParams, fn func(*entity.EmployeeEvent) error) error {
+	if params.AfterSequence < 0 {
+		return errors.NewValidationError("after_sequence cannot be negative")
+	}
+	for _, eventType := range params.Types {
+		if !eventType.IsValid() {
+			return errors.NewValidationError("unsupported event type: " + string(eventType))
+		}
+	}
+	if params.AfterSequence > 0 {
+		exists, err := s.eventRepo.Exists(ctx, params.AfterSequence)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.NewNotFoundError("employee event " + strconv.FormatInt(params.AfterSequence, 10))
+		}
+	}
+	filter := repository.EmployeeEventFilter{Country: params.Country, Types: params.Types}
+	if filter.Country != "" && len(filter.Types) > 0 && !slices.Contains(filter.Types, entity.EmployeeEventUpdated) {
+		// Moves in and out of the country are stored as updates.
+		filter.Types = append(slices.Clone(filter.Types), entity.EmployeeEventUpdated)
+	}
+
+	after := params.AfterSequence
+	for {
+		events, err := s.eventRepo.ListAfter(ctx, after, filter, watchBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		for _, event := range events {
+			if params.Country != "" {
+				event = event.ForCountry(params.Country)
+			}
+			if len(params.Types) == 0 || slices.Contains(params.Types, event.Type) {
+				if err := fn(event); err != nil {
+					return err
+				}
+			}
+			after = event.Sequence
+		}
+		if len(events) == watchBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
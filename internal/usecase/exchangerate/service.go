@@ -0,0 +1,86 @@
+package exchangerate
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// maxRatesPerCall bounds the rates one SetRates call may store.
+const maxRatesPerCall = 10000
+
+type Service interface {
+	SetRates(ctx context.Context, rates []*entity.ExchangeRate) ([]*entity.ExchangeRate, error)
+	List(ctx context.Context, base string) ([]*entity.ExchangeRate, error)
+}
+
+type service struct {
+	repo repository.ExchangeRateRepository
+}
+
+func NewService(repo repository.ExchangeRateRepository) Service {
+	return &service{repo: repo}
+}
+
+// SetRates validates and stores rates, all or none. Currency codes are
+// normalized to upper case and ValidFrom is truncated to its UTC date. A rate
+// for a pair and date that is already stored replaces it.
+func (s *service) SetRates(ctx context.Context, rates []*entity.ExchangeRate) ([]*entity.ExchangeRate, error) {
+	if len(rates) == 0 {
+		return nil, errors.NewValidationError("at least one rate is required")
+	}
+	if len(rates) > maxRatesPerCall {
+		return nil, errors.NewValidationError("too many rates in one call")
+	}
+
+	for _, rate := range rates {
+		if err := normalizeRate(rate); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Upsert(ctx, rates); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func normalizeRate(rate *entity.ExchangeRate) error {
+	base, err := valueobject.ParseCurrency(rate.BaseCurrency)
+	if err != nil {
+		return errors.NewValidationError("base_currency must be an ISO 4217 code")
+	}
+	quote, err := valueobject.ParseCurrency(rate.QuoteCurrency)
+	if err != nil {
+		return errors.NewValidationError("quote_currency must be an ISO 4217 code")
+	}
+	if base == quote {
+		return errors.NewValidationError("base_currency and quote_currency must differ")
+	}
+	if !rate.Rate.GreaterThan(decimal.Zero) {
+		return errors.NewValidationError("rate must be positive")
+	}
+	if rate.ValidFrom.IsZero() {
+		return errors.NewValidationError("valid_from is required")
+	}
+
+	rate.BaseCurrency, rate.QuoteCurrency = base.String(), quote.String()
+	rate.ValidFrom = rate.ValidFrom.UTC().Truncate(24 * time.Hour)
+	return nil
+}
+
+func (s *service) List(ctx context.Context, base string) ([]*entity.ExchangeRate, error) {
+	if base != "" {
+		currency, err := valueobject.ParseCurrency(base)
+		if err != nil {
+			return nil, errors.NewValidationError("base_currency must be an ISO 4217 code")
+		}
+		base = currency.String()
+	}
+	return s.repo.List(ctx, base)
+}
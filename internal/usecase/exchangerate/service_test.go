@@ -0,0 +1,112 @@
+package exchangerate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockExchangeRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRateRepository) Upsert(ctx context.Context, rates []*entity.ExchangeRate) error {
+	args := m.Called(ctx, rates)
+	return args.Error(0)
+}
+
+func (m *MockExchangeRateRepository) FindEffective(ctx context.Context, base, quote string, asOf time.Time) (*entity.ExchangeRate, error) {
+	args := m.Called(ctx, base, quote, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) List(ctx context.Context, base string) ([]*entity.ExchangeRate, error) {
+	args := m.Called(ctx, base)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ExchangeRate), args.Error(1)
+}
+
+func TestExchangeRateService_SetRates(t *testing.T) {
+	ctx := context.Background()
+	validFrom := time.Date(2025, 3, 1, 15, 30, 0, 0, time.FixedZone("IST", 5*3600+1800))
+
+	t.Run("normalizes and stores", func(t *testing.T) {
+		mockRepo := new(MockExchangeRateRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("Upsert", ctx, mock.AnythingOfType("[]*entity.ExchangeRate")).Return(nil)
+
+		rates, err := svc.SetRates(ctx, []*entity.ExchangeRate{
+			entity.NewExchangeRate("usd", "inr", decimal.RequireFromString("83.25"), validFrom),
+		})
+
+		assert.NoError(t, err)
+		if assert.Len(t, rates, 1) {
+			assert.Equal(t, "USD", rates[0].BaseCurrency)
+			assert.Equal(t, "INR", rates[0].QuoteCurrency)
+			assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), rates[0].ValidFrom)
+		}
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid rates", func(t *testing.T) {
+		cases := map[string]*entity.ExchangeRate{
+			"unknown currency": entity.NewExchangeRate("USD", "XYZ", decimal.NewFromInt(1), validFrom),
+			"same currency":    entity.NewExchangeRate("USD", "usd", decimal.NewFromInt(1), validFrom),
+			"zero rate":        entity.NewExchangeRate("USD", "INR", decimal.Zero, validFrom),
+			"no valid_from":    entity.NewExchangeRate("USD", "INR", decimal.NewFromInt(83), time.Time{}),
+		}
+		for name, rate := range cases {
+			t.Run(name, func(t *testing.T) {
+				mockRepo := new(MockExchangeRateRepository)
+				svc := NewService(mockRepo)
+
+				_, err := svc.SetRates(ctx, []*entity.ExchangeRate{rate})
+
+				assert.True(t, errors.IsValidationError(err))
+				mockRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("requires at least one rate", func(t *testing.T) {
+		svc := NewService(new(MockExchangeRateRepository))
+
+		_, err := svc.SetRates(ctx, nil)
+
+		assert.True(t, errors.IsValidationError(err))
+	})
+}
+
+func TestExchangeRateService_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("normalizes the base currency", func(t *testing.T) {
+		mockRepo := new(MockExchangeRateRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("List", ctx, "USD").Return([]*entity.ExchangeRate{}, nil)
+
+		_, err := svc.List(ctx, "usd")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects an unknown base currency", func(t *testing.T) {
+		svc := NewService(new(MockExchangeRateRepository))
+
+		_, err := svc.List(ctx, "dollars")
+
+		assert.True(t, errors.IsValidationError(err))
+	})
+}
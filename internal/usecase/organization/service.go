@@ -0,0 +1,221 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/authctx"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/validator"
+	"github.com/google/uuid"
+)
+
+const (
+	maxNameLength = 100
+	maxCodeLength = 20
+)
+
+type Service interface {
+	CreateDepartment(ctx context.Context, name string, parentID *uuid.UUID) (*entity.Department, error)
+	GetDepartment(ctx context.Context, id uuid.UUID) (*entity.Department, error)
+	ListDepartments(ctx context.Context) ([]*entity.Department, error)
+	UpdateDepartment(ctx context.Context, id uuid.UUID, name string, parentID *uuid.UUID) (*entity.Department, error)
+	DeleteDepartment(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error
+	CreateCostCenter(ctx context.Context, code, name string) (*entity.CostCenter, error)
+	GetCostCenter(ctx context.Context, id uuid.UUID) (*entity.CostCenter, error)
+	ListCostCenters(ctx context.Context) ([]*entity.CostCenter, error)
+	UpdateCostCenter(ctx context.Context, id uuid.UUID, code, name string) (*entity.CostCenter, error)
+	DeleteCostCenter(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error
+}
+
+type service struct {
+	departmentRepo repository.DepartmentRepository
+	costCenterRepo repository.CostCenterRepository
+	auditRepo      repository.EmployeeAuditRepository
+	eventRepo      repository.EmployeeEventRepository
+	transactor     repository.Transactor
+	now            func() time.Time
+}
+
+func NewService(departmentRepo repository.DepartmentRepository, costCenterRepo repository.CostCenterRepository, auditRepo repository.EmployeeAuditRepository, eventRepo repository.EmployeeEventRepository, transactor repository.Transactor) Service {
+	return &service{
+		departmentRepo: departmentRepo,
+		costCenterRepo: costCenterRepo,
+		auditRepo:      auditRepo,
+		eventRepo:      eventRepo,
+		transactor:     transactor,
+		now:            time.Now,
+	}
+}
+
+// CreateDepartment adds a department under parentID, or a division when it
+// is nil.
+func (s *service) CreateDepartment(ctx context.Context, name string, parentID *uuid.UUID) (*entity.Department, error) {
+	name = strings.TrimSpace(name)
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	department := entity.NewDepartment(name, parentID)
+	if err := s.departmentRepo.Create(ctx, department); err != nil {
+		return nil, err
+	}
+	return department, nil
+}
+
+func (s *service) GetDepartment(ctx context.Context, id uuid.UUID) (*entity.Department, error) {
+	return s.departmentRepo.FindByID(ctx, id)
+}
+
+func (s *service) ListDepartments(ctx context.Context) ([]*entity.Department, error) {
+	return s.departmentRepo.List(ctx)
+}
+
+// UpdateDepartment renames a department and moves it under parentID, or to
+// the top level when it is nil.
+func (s *service) UpdateDepartment(ctx context.Context, id uuid.UUID, name string, parentID *uuid.UUID) (*entity.Department, error) {
+	name = strings.TrimSpace(name)
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	if parentID != nil && *parentID == id {
+		return nil, errors.NewValidationError("a department cannot be its own parent")
+	}
+
+	department, err := s.departmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	department.Name = name
+	department.ParentID = parentID
+	if err := s.departmentRepo.Update(ctx, department); err != nil {
+		return nil, err
+	}
+	return department, nil
+}
+
+// DeleteDepartment removes a department. A department with employees or
+// sub-departments can only be deleted by naming reassignTo, the department
+// they move to; each moved employee's history records the change.
+func (s *service) DeleteDepartment(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		moved, err := s.departmentRepo.Delete(ctx, id, reassignTo)
+		if err != nil {
+			return err
+		}
+		return s.auditMoves(ctx, moved, func(e *entity.Employee) { e.DepartmentID = reassignTo })
+	})
+}
+
+// CreateCostCenter adds a cost center. Codes are stored in upper case.
+func (s *service) CreateCostCenter(ctx context.Context, code, name string) (*entity.CostCenter, error) {
+	code, name = normalizeCode(code), strings.TrimSpace(name)
+	if err := validateCostCenter(code, name); err != nil {
+		return nil, err
+	}
+
+	costCenter := entity.NewCostCenter(code, name)
+	if err := s.costCenterRepo.Create(ctx, costCenter); err != nil {
+		return nil, err
+	}
+	return costCenter, nil
+}
+
+func (s *service) GetCostCenter(ctx context.Context, id uuid.UUID) (*entity.CostCenter, error) {
+	return s.costCenterRepo.FindByID(ctx, id)
+}
+
+func (s *service) ListCostCenters(ctx context.Context) ([]*entity.CostCenter, error) {
+	return s.costCenterRepo.List(ctx)
+}
+
+func (s *service) UpdateCostCenter(ctx context.Context, id uuid.UUID, code, name string) (*entity.CostCenter, error) {
+	code, name = normalizeCode(code), strings.TrimSpace(name)
+	if err := validateCostCenter(code, name); err != nil {
+		return nil, err
+	}
+
+	costCenter, err := s.costCenterRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	costCenter.Code = code
+	costCenter.Name = name
+	if err := s.costCenterRepo.Update(ctx, costCenter); err != nil {
+		return nil, err
+	}
+	return costCenter, nil
+}
+
+// DeleteCostCenter removes a cost center, moving its employees to
+// reassignTo like DeleteDepartment.
+func (s *service) DeleteCostCenter(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		moved, err := s.costCenterRepo.Delete(ctx, id, reassignTo)
+		if err != nil {
+			return err
+		}
+		return s.auditMoves(ctx, moved, func(e *entity.Employee) { e.CostCenterID = reassignTo })
+	})
+}
+
+// auditMoves records and publishes an update for each employee, given as it
+// was before move was applied to it.
+func (s *service) auditMoves(ctx context.Context, employees []*entity.Employee, move func(*entity.Employee)) error {
+	if len(employees) == 0 {
+		return nil
+	}
+
+	occurredAt := s.now().UTC()
+	entries := make([]*entity.EmployeeAuditEntry, 0, len(employees))
+	events := make([]*entity.EmployeeEvent, 0, len(employees))
+	for _, before := range employees {
+		after := *before
+		move(&after)
+		entry := entity.NewEmployeeAuditEntry(before.ID, entity.AuditActionUpdated, actorID(ctx), entity.DiffEmployee(before, &after), occurredAt)
+		entries = append(entries, entry)
+		events = append(events, entity.NewEmployeeEvent(entry, &after))
+	}
+	if err := s.auditRepo.CreateBatch(ctx, entries); err != nil {
+		return err
+	}
+	return s.eventRepo.Append(ctx, events)
+}
+
+func validateName(name string) error {
+	if err := validator.ValidateRequired(name, "name"); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return errors.NewValidationError(fmt.Sprintf("name cannot be longer than %d characters", maxNameLength))
+	}
+	return nil
+}
+
+func validateCostCenter(code, name string) error {
+	if err := validator.ValidateRequired(code, "code"); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(code) > maxCodeLength {
+		return errors.NewValidationError(fmt.Sprintf("code cannot be longer than %d characters", maxCodeLength))
+	}
+	return validateName(name)
+}
+
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// actorID returns the authenticated user in ctx, or nil when there is none.
+func actorID(ctx context.Context) *uuid.UUID {
+	id, err := uuid.Parse(authctx.UserID(ctx))
+	if err != nil {
+		return nil
+	}
+	return &id
+}
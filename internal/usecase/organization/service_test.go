@@ -0,0 +1,380 @@
+package organization
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDepartmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockDepartmentRepository) Create(ctx context.Context, department *entity.Department) error {
+	args := m.Called(ctx, department)
+	return args.Error(0)
+}
+
+func (m *MockDepartmentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Department, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Department), args.Error(1)
+}
+
+func (m *MockDepartmentRepository) List(ctx context.Context) ([]*entity.Department, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Department), args.Error(1)
+}
+
+func (m *MockDepartmentRepository) Update(ctx context.Context, department *entity.Department) error {
+	args := m.Called(ctx, department)
+	return args.Error(0)
+}
+
+func (m *MockDepartmentRepository) Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) ([]*entity.Employee, error) {
+	args := m.Called(ctx, id, reassignTo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Employee), args.Error(1)
+}
+
+type MockCostCenterRepository struct {
+	mock.Mock
+}
+
+func (m *MockCostCenterRepository) Create(ctx context.Context, costCenter *entity.CostCenter) error {
+	args := m.Called(ctx, costCenter)
+	return args.Error(0)
+}
+
+func (m *MockCostCenterRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.CostCenter, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CostCenter), args.Error(1)
+}
+
+func (m *MockCostCenterRepository) List(ctx context.Context) ([]*entity.CostCenter, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.CostCenter), args.Error(1)
+}
+
+func (m *MockCostCenterRepository) Update(ctx context.Context, costCenter *entity.CostCenter) error {
+	args := m.Called(ctx, costCenter)
+	return args.Error(0)
+}
+
+func (m *MockCostCenterRepository) Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) ([]*entity.Employee, error) {
+	args := m.Called(ctx, id, reassignTo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Employee), args.Error(1)
+}
+
+type MockEmployeeAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockEmployeeAuditRepository) Create(ctx context.Context, entry *entity.EmployeeAuditEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeAuditRepository) CreateBatch(ctx context.Context, entries []*entity.EmployeeAuditEntry) error {
+	args := m.Called(ctx, entries)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeAuditRepository) ListByEmployee(ctx context.Context, employeeID uuid.UUID, page, pageSize int) (*repository.EmployeeAuditPage, error) {
+	args := m.Called(ctx, employeeID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.EmployeeAuditPage), args.Error(1)
+}
+
+type MockEmployeeEventRepository struct {
+	mock.Mock
+}
+
+func (m *MockEmployeeEventRepository) Append(ctx context.Context, events []*entity.EmployeeEvent) error {
+	args := m.Called(ctx, events)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeEventRepository) ListAfter(ctx context.Context, after int64, filter repository.EmployeeEventFilter, limit int) ([]*entity.EmployeeEvent, error) {
+	args := m.Called(ctx, after, filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.EmployeeEvent), args.Error(1)
+}
+
+func (m *MockEmployeeEventRepository) Exists(ctx context.Context, sequence int64) (bool, error) {
+	args := m.Called(ctx, sequence)
+	return args.Bool(0), args.Error(1)
+}
+
+// passthroughTransactor runs the function without a transaction.
+type passthroughTransactor struct{}
+
+func (passthroughTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (passthroughTransactor) WithinSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+var now = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func newTestService(departmentRepo *MockDepartmentRepository, costCenterRepo *MockCostCenterRepository, auditRepo *MockEmployeeAuditRepository) *service {
+	eventRepo := new(MockEmployeeEventRepository)
+	eventRepo.On("Append", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return &service{
+		departmentRepo: departmentRepo,
+		costCenterRepo: costCenterRepo,
+		auditRepo:      auditRepo,
+		eventRepo:      eventRepo,
+		transactor:     passthroughTransactor{},
+		now:            func() time.Time { return now },
+	}
+}
+
+func TestOrganizationService_CreateDepartment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("trims the name", func(t *testing.T) {
+		mockDepartments := new(MockDepartmentRepository)
+		svc := newTestService(mockDepartments, new(MockCostCenterRepository), new(MockEmployeeAuditRepository))
+		parentID := uuid.New()
+		mockDepartments.On("Create", ctx, mock.MatchedBy(func(d *entity.Department) bool {
+			return d.Name == "Platform" && *d.ParentID == parentID
+		})).Return(nil)
+
+		department, err := svc.CreateDepartment(ctx, "  Platform ", &parentID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Platform", department.Name)
+		mockDepartments.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid names", func(t *testing.T) {
+		for _, name := range []string{"", "   ", strings.Repeat("x", maxNameLength+1)} {
+			mockDepartments := new(MockDepartmentRepository)
+			svc := newTestService(mockDepartments, new(MockCostCenterRepository), new(MockEmployeeAuditRepository))
+
+			_, err := svc.CreateDepartment(ctx, name, nil)
+
+			assert.True(t, errors.IsValidationError(err))
+			mockDepartments.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("name taken", func(t *testing.T) {
+		mockDepartments := new(MockDepartmentRepository)
+		svc := newTestService(mockDepartments, new(MockCostCenterRepository), new(MockEmployeeAuditRepository))
+		mockDepartments.On("Create", ctx, mock.Anything).Return(errors.NewConflictError("a department named Sales already exists"))
+
+		_, err := svc.CreateDepartment(ctx, "Sales", nil)
+
+		assert.True(t, errors.IsConflictError(err))
+	})
+}
+
+func TestOrganizationService_UpdateDepartment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("moves a department", func(t *testing.T) {
+		mockDepartments := new(MockDepartmentRepository)
+		svc := newTestService(mockDepartments, new(MockCostCenterRepository), new(MockEmployeeAuditRepository))
+		department := entity.NewDepartment("Platform", nil)
+		parentID := uuid.New()
+		mockDepartments.On("FindByID", ctx, department.ID).Return(department, nil)
+		mockDepartments.On("Update", ctx, department).Return(nil)
+
+		updated, err := svc.UpdateDepartment(ctx, department.ID, "Platform", &parentID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &parentID, updated.ParentID)
+		mockDepartments.AssertExpectations(t)
+	})
+
+	t.Run("department cannot be its own parent", func(t *testing.T) {
+		mockDepartments := new(MockDepartmentRepository)
+		svc := newTestService(mockDepartments, new(MockCostCenterRepository), new(MockEmployeeAuditRepository))
+		id := uuid.New()
+
+		_, err := svc.UpdateDepartment(ctx, id, "Platform", &id)
+
+		assert.True(t, errors.IsValidationError(err))
+		mockDepartments.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("moving under a sub-department fails", func(t *testing.T) {
+		mockDepartments := new(MockDepartmentRepository)
+		svc := newTestService(mockDepartments, new(MockCostCenterRepository), new(MockEmployeeAuditRepository))
+		department := entity.NewDepartment("Engineering", nil)
+		childID := uuid.New()
+		mockDepartments.On("FindByID", ctx, department.ID).Return(department, nil)
+		mockDepartments.On("Update", ctx, department).
+			Return(errors.NewValidationError("parent_id cannot be the department or one of its sub-departments"))
+
+		_, err := svc.UpdateDepartment(ctx, department.ID, "Engineering", &childID)
+
+		assert.True(t, errors.IsValidationError(err))
+	})
+}
+
+func TestOrganizationService_DeleteDepartment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("department with members is kept", func(t *testing.T) {
+		mockDepartments := new(MockDepartmentRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockDepartments, new(MockCostCenterRepository), mockAudit)
+		id := uuid.New()
+		mockDepartments.On("Delete", ctx, id, (*uuid.UUID)(nil)).
+			Return(nil, errors.NewConflictError("department has employees or sub-departments; reassign them to another department first"))
+
+		err := svc.DeleteDepartment(ctx, id, nil)
+
+		assert.True(t, errors.IsConflictError(err))
+		mockAudit.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reassigned members are audited", func(t *testing.T) {
+		mockDepartments := new(MockDepartmentRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockDepartments, new(MockCostCenterRepository), mockAudit)
+		id, reassignTo := uuid.New(), uuid.New()
+		moved := []*entity.Employee{
+			{ID: uuid.New(), FullName: "John Doe", DepartmentID: &id},
+			{ID: uuid.New(), FullName: "Jane Doe", DepartmentID: &id},
+		}
+		mockDepartments.On("Delete", ctx, id, &reassignTo).Return(moved, nil)
+		mockAudit.On("CreateBatch", ctx, mock.MatchedBy(func(entries []*entity.EmployeeAuditEntry) bool {
+			if len(entries) != 2 {
+				return false
+			}
+			for i, entry := range entries {
+				if entry.EmployeeID != moved[i].ID || entry.Action != entity.AuditActionUpdated || len(entry.Changes) != 1 ||
+					*entry.Changes[0].Before != id.String() || *entry.Changes[0].After != reassignTo.String() {
+					return false
+				}
+			}
+			return true
+		})).Return(nil)
+
+		err := svc.DeleteDepartment(ctx, id, &reassignTo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &id, moved[0].DepartmentID)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("reassigned members are published", func(t *testing.T) {
+		mockDepartments := new(MockDepartmentRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		mockEvents := new(MockEmployeeEventRepository)
+		svc := newTestService(mockDepartments, new(MockCostCenterRepository), mockAudit)
+		svc.eventRepo = mockEvents
+		id, reassignTo := uuid.New(), uuid.New()
+		moved := []*entity.Employee{{ID: uuid.New(), FullName: "John Doe", Country: "India", DepartmentID: &id}}
+		mockDepartments.On("Delete", ctx, id, &reassignTo).Return(moved, nil)
+		mockAudit.On("CreateBatch", ctx, mock.Anything).Return(nil)
+		mockEvents.On("Append", ctx, mock.MatchedBy(func(events []*entity.EmployeeEvent) bool {
+			return len(events) == 1 && events[0].Type == entity.EmployeeEventUpdated && events[0].Country == "India" &&
+				*events[0].Employee.DepartmentID == reassignTo
+		})).Return(nil)
+
+		err := svc.DeleteDepartment(ctx, id, &reassignTo)
+
+		assert.NoError(t, err)
+		mockEvents.AssertExpectations(t)
+	})
+
+	t.Run("empty department", func(t *testing.T) {
+		mockDepartments := new(MockDepartmentRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(mockDepartments, new(MockCostCenterRepository), mockAudit)
+		id := uuid.New()
+		mockDepartments.On("Delete", ctx, id, (*uuid.UUID)(nil)).Return([]*entity.Employee{}, nil)
+
+		err := svc.DeleteDepartment(ctx, id, nil)
+
+		assert.NoError(t, err)
+		mockAudit.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+}
+
+func TestOrganizationService_CreateCostCenter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("upper-cases the code", func(t *testing.T) {
+		mockCostCenters := new(MockCostCenterRepository)
+		svc := newTestService(new(MockDepartmentRepository), mockCostCenters, new(MockEmployeeAuditRepository))
+		mockCostCenters.On("Create", ctx, mock.MatchedBy(func(c *entity.CostCenter) bool {
+			return c.Code == "CC-100" && c.Name == "Research"
+		})).Return(nil)
+
+		costCenter, err := svc.CreateCostCenter(ctx, " cc-100", "Research")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CC-100", costCenter.Code)
+		mockCostCenters.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid codes", func(t *testing.T) {
+		for _, code := range []string{"", strings.Repeat("X", maxCodeLength+1)} {
+			mockCostCenters := new(MockCostCenterRepository)
+			svc := newTestService(new(MockDepartmentRepository), mockCostCenters, new(MockEmployeeAuditRepository))
+
+			_, err := svc.CreateCostCenter(ctx, code, "Research")
+
+			assert.True(t, errors.IsValidationError(err))
+			mockCostCenters.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		}
+	})
+}
+
+func TestOrganizationService_DeleteCostCenter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reassigned members are audited", func(t *testing.T) {
+		mockCostCenters := new(MockCostCenterRepository)
+		mockAudit := new(MockEmployeeAuditRepository)
+		svc := newTestService(new(MockDepartmentRepository), mockCostCenters, mockAudit)
+		id, reassignTo := uuid.New(), uuid.New()
+		moved := []*entity.Employee{{ID: uuid.New(), CostCenterID: &id}}
+		mockCostCenters.On("Delete", ctx, id, &reassignTo).Return(moved, nil)
+		mockAudit.On("CreateBatch", ctx, mock.MatchedBy(func(entries []*entity.EmployeeAuditEntry) bool {
+			return len(entries) == 1 && entries[0].Changes[0].Field == "cost_center_id" &&
+				*entries[0].Changes[0].After == reassignTo.String() && entries[0].OccurredAt.Equal(now)
+		})).Return(nil)
+
+		err := svc.DeleteCostCenter(ctx, id, &reassignTo)
+
+		assert.NoError(t, err)
+		mockAudit.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,179 @@
+package payslip
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/shopspring/decimal"
+)
+
+//go:embed templates/*.txt.tmpl
+var builtinTemplates embed.FS
+
+// defaultTemplate lays out the payslips of countries without a template of
+// their own.
+const defaultTemplate = "default"
+
+const templateSuffix = ".txt.tmpl"
+
+// PDF page layout, in millimetres and points.
+const (
+	pdfMargin     = 15.0
+	pdfLineHeight = 4.5
+	pdfFontSize   = 9.0
+)
+
+// Renderer executes the payslip templates. A country's payslips use the
+// template named after it in lower case with spaces replaced by
+// underscores (for example united_states.txt.tmpl), or default.txt.tmpl.
+type Renderer struct {
+	templates map[string]*template.Template
+}
+
+// NewRenderer parses the built-in templates and then those in dir, if
+// given, which replace built-in templates of the same name.
+func NewRenderer(dir string) (*Renderer, error) {
+	r := &Renderer{templates: make(map[string]*template.Template)}
+	if err := r.load(builtinTemplates, "templates"); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := r.load(os.DirFS(dir), "."); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := r.templates[defaultTemplate]; !ok {
+		return nil, fmt.Errorf("payslip template %s%s is missing", defaultTemplate, templateSuffix)
+	}
+	return r, nil
+}
+
+func (r *Renderer) load(fsys fs.FS, dir string) error {
+	names, err := fs.Glob(fsys, path.Join(dir, "*"+templateSuffix))
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		text, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		key := strings.TrimSuffix(path.Base(name), templateSuffix)
+		tmpl, err := template.New(key).Funcs(templateFuncs).Parse(string(text))
+		if err != nil {
+			return fmt.Errorf("parse payslip template: %w", err)
+		}
+		r.templates[key] = tmpl
+	}
+	return nil
+}
+
+func templateName(country string) string {
+	return strings.ReplaceAll(strings.ToLower(country), " ", "_")
+}
+
+// Text renders a payslip as plain text.
+func (r *Renderer) Text(slip *Payslip) ([]byte, error) {
+	tmpl, ok := r.templates[templateName(slip.Country)]
+	if !ok {
+		tmpl = r.templates[defaultTemplate]
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, slip); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// PDF renders the text of a payslip onto A4 pages in a fixed-width font, so
+// templates line up the same way in both formats. Characters outside
+// Windows-1252 are replaced. The document dates are the end of the pay
+// period rather than the time of rendering, so the same payslip always
+// produces the same bytes.
+func (r *Renderer) PDF(slip *Payslip) ([]byte, error) {
+	text, err := r.Text(slip)
+	if err != nil {
+		return nil, err
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetCreationDate(slip.PeriodEnd)
+	pdf.SetModificationDate(slip.PeriodEnd)
+	pdf.SetCatalogSort(true)
+	pdf.SetTitle("Payslip "+slip.PeriodStart.Format("January 2006"), true)
+	pdf.SetAuthor(slip.Employer.Name, true)
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	pdf.AddPage()
+	pdf.SetFont("Courier", "", pdfFontSize)
+
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+	scanner := bufio.NewScanner(bytes.NewReader(text))
+	for scanner.Scan() {
+		pdf.CellFormat(0, pdfLineHeight, translate(scanner.Text()), "", 1, "L", false, 0, "")
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var templateFuncs = template.FuncMap{
+	"money":   formatMoney,
+	"percent": formatPercent,
+	"band":    formatBand,
+}
+
+// formatMoney formats an amount with two decimals and thousands
+// separators, such as 1,234,567.89.
+func formatMoney(d decimal.Decimal) string {
+	s := d.StringFixed(2)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	whole, fraction, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+	b.WriteByte('.')
+	b.WriteString(fraction)
+	return b.String()
+}
+
+// formatPercent formats a rate such as 0.125 as 12.5%.
+func formatPercent(rate decimal.Decimal) string {
+	return rate.Shift(2).String() + "%"
+}
+
+// formatBand formats an income band such as 300,000 - 700,000, or
+// above 1,500,000 when it is unbounded.
+func formatBand(lower decimal.Decimal, upper *decimal.Decimal) string {
+	if upper == nil {
+		return "above " + formatBound(lower)
+	}
+	return formatBound(lower) + " - " + formatBound(*upper)
+}
+
+func formatBound(d decimal.Decimal) string {
+	return strings.TrimSuffix(formatMoney(d), ".00")
+}
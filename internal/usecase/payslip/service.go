@@ -0,0 +1,189 @@
+package payslip
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/salary"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatPDF  Format = "pdf"
+)
+
+type Service interface {
+	Generate(ctx context.Context, params Params) (*Document, error)
+}
+
+// Params selects the employee, the pay period (a calendar month) and the
+// format of a payslip.
+type Params struct {
+	EmployeeID uuid.UUID
+	Year       int
+	Month      time.Month
+	Format     Format
+}
+
+// Document is a rendered payslip.
+type Document struct {
+	Content     []byte
+	ContentType string
+	Filename    string
+}
+
+// Employer is printed at the top of every payslip.
+type Employer struct {
+	Name    string
+	Address []string
+}
+
+// Payslip is the data payslip templates are executed with. Amounts are for
+// one month, in Currency.
+type Payslip struct {
+	Employer     Employer
+	EmployeeID   string
+	EmployeeName string
+	JobTitle     string
+	Country      string
+	// PeriodStart and PeriodEnd are the first and last days of the month.
+	PeriodStart       time.Time
+	PeriodEnd         time.Time
+	Currency          string
+	AnnualGrossSalary decimal.Decimal
+	GrossPay          decimal.Decimal
+	// Taxes lists the income tax due in each marginal band, lowest first.
+	// Bands with nothing due are left out.
+	Taxes []TaxLine
+	// CapAdjustment is zero or negative; it is non-zero when the country
+	// caps the total tax.
+	CapAdjustment   decimal.Decimal
+	TotalDeductions decimal.Decimal
+	NetPay          decimal.Decimal
+}
+
+// TaxLine is the tax due for one month on an annual income band.
+type TaxLine struct {
+	LowerBound decimal.Decimal
+	// UpperBound is nil for the unbounded top band.
+	UpperBound *decimal.Decimal
+	Rate       decimal.Decimal
+	Amount     decimal.Decimal
+}
+
+// monthsPerYear converts annual salaries and taxes to monthly amounts.
+var monthsPerYear = decimal.NewFromInt(12)
+
+type service struct {
+	employeeRepo  repository.EmployeeRepository
+	salaryService salary.Service
+	renderer      *Renderer
+	employer      Employer
+}
+
+func NewService(employeeRepo repository.EmployeeRepository, salaryService salary.Service, renderer *Renderer, employer Employer) Service {
+	return &service{
+		employeeRepo:  employeeRepo,
+		salaryService: salaryService,
+		renderer:      renderer,
+		employer:      employer,
+	}
+}
+
+// Generate renders an employee's payslip for a month. Pay is a twelfth of
+// the annual salary and tax in force on the first day of the month, or on
+// the hire date for employees hired during it; it is not prorated. There is
+// no payslip for a month before the hire date or, for a terminated employee,
+// after the last working day. Each amount is rounded to cents and the totals
+// are their sums, so the payslip always adds up.
+func (s *service) Generate(ctx context.Context, params Params) (*Document, error) {
+	if params.Format != FormatText && params.Format != FormatPDF {
+		return nil, errors.NewValidationError("format must be text or pdf")
+	}
+	if params.Year < 1 || params.Year > 9999 {
+		return nil, errors.NewValidationError("year must be between 1 and 9999")
+	}
+	if params.Month < time.January || params.Month > time.December {
+		return nil, errors.NewValidationError("month must be between 1 and 12")
+	}
+
+	employee, err := s.employeeRepo.FindByID(ctx, params.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Date(params.Year, params.Month, 1, 0, 0, 0, 0, time.UTC)
+	next := start.AddDate(0, 1, 0)
+	if !employee.HireDate.Before(next) {
+		return nil, errors.NewValidationError("employee was hired after the pay period")
+	}
+	if employee.Status == entity.EmploymentStatusTerminated && employee.LastWorkingDay != nil && employee.LastWorkingDay.Before(start) {
+		return nil, errors.NewValidationError("employee left before the pay period")
+	}
+	asOf := start
+	if employee.HireDate.After(asOf) {
+		asOf = employee.HireDate
+	}
+
+	annual, err := s.salaryService.CalculateNetSalary(ctx, employee.ID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	slip := &Payslip{
+		Employer:          s.employer,
+		EmployeeID:        employee.ID.String(),
+		EmployeeName:      employee.FullName,
+		JobTitle:          employee.JobTitle,
+		Country:           employee.Country,
+		PeriodStart:       start,
+		PeriodEnd:         next.AddDate(0, 0, -1),
+		Currency:          annual.Currency.String(),
+		AnnualGrossSalary: annual.GrossSalary,
+		GrossPay:          monthly(annual.GrossSalary),
+		CapAdjustment:     monthly(annual.CapAdjustment),
+	}
+	slip.TotalDeductions = slip.CapAdjustment
+	for _, b := range annual.Brackets {
+		amount := monthly(b.TaxAmount)
+		if amount.IsZero() {
+			continue
+		}
+		slip.Taxes = append(slip.Taxes, TaxLine{
+			LowerBound: b.LowerBound,
+			UpperBound: b.UpperBound,
+			Rate:       b.Rate,
+			Amount:     amount,
+		})
+		slip.TotalDeductions = slip.TotalDeductions.Add(amount)
+	}
+	slip.NetPay = slip.GrossPay.Sub(slip.TotalDeductions)
+
+	filename := fmt.Sprintf("payslip-%s-%04d-%02d", employee.ID, params.Year, int(params.Month))
+	switch params.Format {
+	case FormatPDF:
+		content, err := s.renderer.PDF(slip)
+		if err != nil {
+			return nil, errors.NewInternalError(err)
+		}
+		return &Document{Content: content, ContentType: "application/pdf", Filename: filename + ".pdf"}, nil
+	default:
+		content, err := s.renderer.Text(slip)
+		if err != nil {
+			return nil, errors.NewInternalError(err)
+		}
+		return &Document{Content: content, ContentType: "text/plain; charset=utf-8", Filename: filename + ".txt"}, nil
+	}
+}
+
+func monthly(annual decimal.Decimal) decimal.Decimal {
+	return annual.DivRound(monthsPerYear, 2)
+}
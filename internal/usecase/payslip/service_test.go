@@ -0,0 +1,510 @@
+package payslip
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/usecase/salary"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden payslips in testdata")
+
+type MockEmployeeRepository struct {
+	mock.Mock
+}
+
+func (m *MockEmployeeRepository) Create(ctx context.Context, employee *entity.Employee) error {
+	args := m.Called(ctx, employee)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) CreateBatch(ctx context.Context, employees []*entity.Employee) error {
+	args := m.Called(ctx, employees)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) FindByIDWithDeleted(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) List(ctx context.Context, params repository.EmployeeListParams) (*repository.EmployeePage, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.EmployeePage), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Search(ctx context.Context, query string, limit int) ([]repository.EmployeeMatch, error) {
+	args := m.Called(ctx, query, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.EmployeeMatch), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Update(ctx context.Context, employee *entity.Employee, fields []repository.EmployeeField) error {
+	args := m.Called(ctx, employee, fields)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) ListDeleted(ctx context.Context, page, pageSize int) (*repository.EmployeePage, error) {
+	args := m.Called(ctx, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.EmployeePage), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Restore(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, cutoff, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) ListOrgTree(ctx context.Context, root *uuid.UUID, maxDepth, limit int) ([]repository.OrgNode, error) {
+	args := m.Called(ctx, root, maxDepth, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.OrgNode), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetManagementChain(ctx context.Context, id uuid.UUID) ([]*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetSalaryTotals(ctx context.Context, filter repository.EmployeeFilter) ([]valueobject.SalaryTotals, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]valueobject.SalaryTotals), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetDepartmentSalaryTotals(ctx context.Context, statuses []entity.EmploymentStatus) ([]repository.DepartmentSalaryTotals, error) {
+	args := m.Called(ctx, statuses)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.DepartmentSalaryTotals), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetSalaryDistribution(ctx context.Context, filter repository.EmployeeFilter, rates map[valueobject.Currency]decimal.Decimal, buckets int) (*valueobject.SalaryDistribution, error) {
+	args := m.Called(ctx, filter, rates, buckets)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*valueobject.SalaryDistribution), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) AggregateSalaries(ctx context.Context, aggregation repository.SalaryAggregation) ([]repository.SalaryGroup, error) {
+	args := m.Called(ctx, aggregation)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.SalaryGroup), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Stream(ctx context.Context, filter repository.EmployeeFilter, fn func(*entity.Employee) error) error {
+	args := m.Called(ctx, filter, fn)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) CountByCountry(ctx context.Context) (map[string]int64, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+type MockSalaryService struct {
+	mock.Mock
+}
+
+func (m *MockSalaryService) CalculateNetSalary(ctx context.Context, employeeID uuid.UUID, asOf time.Time) (*valueobject.Salary, error) {
+	args := m.Called(ctx, employeeID, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*valueobject.Salary), args.Error(1)
+}
+
+func (m *MockSalaryService) NewNetSalaryCalculator(asOf time.Time) salary.NetSalaryCalculator {
+	args := m.Called(asOf)
+	return args.Get(0).(salary.NetSalaryCalculator)
+}
+
+func (m *MockSalaryService) GetSalaryStatsByCountry(ctx context.Context, country string, reportsTo *uuid.UUID, reporting salary.Reporting) (*valueobject.SalaryStats, error) {
+	args := m.Called(ctx, country, reportsTo, reporting)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*valueobject.SalaryStats), args.Error(1)
+}
+
+func (m *MockSalaryService) GetAvgSalaryByJobTitle(ctx context.Context, jobTitle string, reportsTo *uuid.UUID, reporting salary.Reporting) (*valueobject.JobTitleSalaryStats, error) {
+	args := m.Called(ctx, jobTitle, reportsTo, reporting)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*valueobject.JobTitleSalaryStats), args.Error(1)
+}
+
+func (m *MockSalaryService) GetSalaryDistribution(ctx context.Context, params salary.DistributionParams) (*valueobject.SalaryDistribution, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*valueobject.SalaryDistribution), args.Error(1)
+}
+
+func (m *MockSalaryService) AggregateSalaries(ctx context.Context, params salary.AggregateParams) (*salary.Aggregate, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*salary.Aggregate), args.Error(1)
+}
+
+func (m *MockSalaryService) GetDepartmentPayroll(ctx context.Context, params salary.PayrollParams) (*salary.Payroll, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*salary.Payroll), args.Error(1)
+}
+
+var testEmployer = Employer{
+	Name:    "Acme Corp",
+	Address: []string{"42 Residency Road", "Bengaluru 560025"},
+}
+
+func newEmployee(name, country string, hired time.Time) *entity.Employee {
+	employee := entity.NewEmployee(name, "Software Engineer", country, decimal.Zero, "")
+	employee.ID = uuid.MustParse("3f2504e0-4f89-41d3-9a0c-0305e82c3301")
+	employee.HireDate = hired
+	employee.Status = entity.EmploymentStatusActive
+	employee.CreatedAt = hired
+	return employee
+}
+
+// indiaTaxSchedule and unitedStatesTaxSchedule are the schedules of the tax
+// rules seeded by migration 0013.
+var (
+	indiaTaxSchedule = valueobject.TaxSchedule{
+		StandardDeduction: decimal.NewFromInt(75000),
+		Brackets: []valueobject.TaxBracket{
+			{UpTo: bound(300000), Rate: decimal.Zero},
+			{UpTo: bound(700000), Rate: decimal.RequireFromString("0.05")},
+			{UpTo: bound(1000000), Rate: decimal.RequireFromString("0.10")},
+			{UpTo: bound(1200000), Rate: decimal.RequireFromString("0.15")},
+			{UpTo: bound(1500000), Rate: decimal.RequireFromString("0.20")},
+			{Rate: decimal.RequireFromString("0.30")},
+		},
+	}
+	unitedStatesTaxSchedule = valueobject.TaxSchedule{
+		StandardDeduction: decimal.NewFromInt(14600),
+		Brackets: []valueobject.TaxBracket{
+			{UpTo: bound(11600), Rate: decimal.RequireFromString("0.10")},
+			{UpTo: bound(47150), Rate: decimal.RequireFromString("0.12")},
+			{UpTo: bound(100525), Rate: decimal.RequireFromString("0.22")},
+			{UpTo: bound(191950), Rate: decimal.RequireFromString("0.24")},
+			{UpTo: bound(243725), Rate: decimal.RequireFromString("0.32")},
+			{UpTo: bound(609350), Rate: decimal.RequireFromString("0.35")},
+			{Rate: decimal.RequireFromString("0.37")},
+		},
+	}
+)
+
+func bound(value int64) *decimal.Decimal {
+	d := decimal.NewFromInt(value)
+	return &d
+}
+
+func annualSalary(schedule valueobject.TaxSchedule, gross string, currency valueobject.Currency) *valueobject.Salary {
+	s := schedule.Apply(decimal.RequireFromString(gross))
+	s.Currency = currency
+	return &s
+}
+
+// assertGolden compares got with testdata/name, rewriting the file instead
+// when the tests run with -update.
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		require.NoError(t, os.WriteFile(path, got, 0o644))
+	}
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got))
+}
+
+func TestPayslipService_Generate(t *testing.T) {
+	ctx := context.Background()
+	hired := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	periodStart := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	golden := []struct {
+		name     string
+		employee *entity.Employee
+		salary   *valueobject.Salary
+		format   Format
+	}{
+		{
+			name:     "india.txt",
+			employee: newEmployee("Priya Sharma", "India", hired),
+			salary:   annualSalary(indiaTaxSchedule, "1850000", valueobject.CurrencyINR),
+			format:   FormatText,
+		},
+		{
+			name:     "india.pdf",
+			employee: newEmployee("Priya Sharma", "India", hired),
+			salary:   annualSalary(indiaTaxSchedule, "1850000", valueobject.CurrencyINR),
+			format:   FormatPDF,
+		},
+		{
+			name:     "united_states.txt",
+			employee: newEmployee("John Doe", "United States", hired),
+			salary:   annualSalary(unitedStatesTaxSchedule, "125000", valueobject.CurrencyUSD),
+			format:   FormatText,
+		},
+		{
+			name:     "default.txt",
+			employee: newEmployee("Jürgen Müller", "Germany", hired),
+			salary: annualSalary(valueobject.TaxSchedule{
+				Brackets: []valueobject.TaxBracket{
+					{UpTo: decimalPtr("10000"), Rate: decimal.Zero},
+					{Rate: decimal.RequireFromString("0.4")},
+				},
+				MaxTax: decimalPtr("20000"),
+			}, "80000", "EUR"),
+			format: FormatText,
+		},
+	}
+	for _, tc := range golden {
+		t.Run(tc.name, func(t *testing.T) {
+			employeeRepo := new(MockEmployeeRepository)
+			salaryService := new(MockSalaryService)
+			svc := NewService(employeeRepo, salaryService, renderer, testEmployer)
+			employeeRepo.On("FindByID", ctx, tc.employee.ID).Return(tc.employee, nil)
+			salaryService.On("CalculateNetSalary", ctx, tc.employee.ID, periodStart).Return(tc.salary, nil)
+
+			doc, err := svc.Generate(ctx, Params{EmployeeID: tc.employee.ID, Year: 2025, Month: time.March, Format: tc.format})
+
+			require.NoError(t, err)
+			assert.Equal(t, "payslip-3f2504e0-4f89-41d3-9a0c-0305e82c3301-2025-03."+filepath.Ext(tc.name)[1:], doc.Filename)
+			assertGolden(t, tc.name, doc.Content)
+			employeeRepo.AssertExpectations(t)
+			salaryService.AssertExpectations(t)
+		})
+	}
+
+	t.Run("pdf is deterministic", func(t *testing.T) {
+		employee := newEmployee("Priya Sharma", "India", hired)
+		employeeRepo := new(MockEmployeeRepository)
+		salaryService := new(MockSalaryService)
+		svc := NewService(employeeRepo, salaryService, renderer, testEmployer)
+		employeeRepo.On("FindByID", ctx, employee.ID).Return(employee, nil)
+		salaryService.On("CalculateNetSalary", ctx, employee.ID, periodStart).
+			Return(annualSalary(indiaTaxSchedule, "1850000", valueobject.CurrencyINR), nil)
+
+		first, err := svc.Generate(ctx, Params{EmployeeID: employee.ID, Year: 2025, Month: time.March, Format: FormatPDF})
+		require.NoError(t, err)
+		second, err := svc.Generate(ctx, Params{EmployeeID: employee.ID, Year: 2025, Month: time.March, Format: FormatPDF})
+		require.NoError(t, err)
+
+		assert.Equal(t, "application/pdf", first.ContentType)
+		assert.Equal(t, first.Content, second.Content)
+	})
+
+	t.Run("uses the salary on the hire date for a mid-month hire", func(t *testing.T) {
+		employee := newEmployee("Priya Sharma", "India", hired)
+		employeeRepo := new(MockEmployeeRepository)
+		salaryService := new(MockSalaryService)
+		svc := NewService(employeeRepo, salaryService, renderer, testEmployer)
+		employeeRepo.On("FindByID", ctx, employee.ID).Return(employee, nil)
+		salaryService.On("CalculateNetSalary", ctx, employee.ID, hired).
+			Return(annualSalary(indiaTaxSchedule, "1200000", valueobject.CurrencyINR), nil)
+
+		doc, err := svc.Generate(ctx, Params{EmployeeID: employee.ID, Year: 2024, Month: time.June, Format: FormatText})
+
+		require.NoError(t, err)
+		assert.Contains(t, string(doc.Content), "01-06-2024 to 30-06-2024")
+		assert.Contains(t, string(doc.Content), "100,000.00")
+		salaryService.AssertExpectations(t)
+	})
+
+	t.Run("rejects a period before the hire date", func(t *testing.T) {
+		employee := newEmployee("Priya Sharma", "India", hired)
+		employeeRepo := new(MockEmployeeRepository)
+		salaryService := new(MockSalaryService)
+		svc := NewService(employeeRepo, salaryService, renderer, testEmployer)
+		employeeRepo.On("FindByID", ctx, employee.ID).Return(employee, nil)
+
+		_, err := svc.Generate(ctx, Params{EmployeeID: employee.ID, Year: 2024, Month: time.May, Format: FormatText})
+
+		assert.True(t, errors.IsValidationError(err))
+		salaryService.AssertNotCalled(t, "CalculateNetSalary", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("uses the hire date rather than when the employee was recorded", func(t *testing.T) {
+		employee := newEmployee("Priya Sharma", "India", hired)
+		employee.CreatedAt = time.Date(2025, 2, 14, 9, 0, 0, 0, time.UTC)
+		employeeRepo := new(MockEmployeeRepository)
+		salaryService := new(MockSalaryService)
+		svc := NewService(employeeRepo, salaryService, renderer, testEmployer)
+		employeeRepo.On("FindByID", ctx, employee.ID).Return(employee, nil)
+		salaryService.On("CalculateNetSalary", ctx, employee.ID, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)).
+			Return(annualSalary(indiaTaxSchedule, "1200000", valueobject.CurrencyINR), nil)
+
+		doc, err := svc.Generate(ctx, Params{EmployeeID: employee.ID, Year: 2024, Month: time.July, Format: FormatText})
+
+		require.NoError(t, err)
+		assert.Contains(t, string(doc.Content), "01-07-2024 to 31-07-2024")
+		salaryService.AssertExpectations(t)
+	})
+
+	t.Run("rejects a period after the last working day", func(t *testing.T) {
+		lastDay := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+		employee := newEmployee("Priya Sharma", "India", hired)
+		employee.Status = entity.EmploymentStatusTerminated
+		employee.TerminationReason = entity.TerminationReasonResignation
+		employee.LastWorkingDay = &lastDay
+		employeeRepo := new(MockEmployeeRepository)
+		salaryService := new(MockSalaryService)
+		svc := NewService(employeeRepo, salaryService, renderer, testEmployer)
+		employeeRepo.On("FindByID", ctx, employee.ID).Return(employee, nil)
+		salaryService.On("CalculateNetSalary", ctx, employee.ID, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)).
+			Return(annualSalary(indiaTaxSchedule, "1200000", valueobject.CurrencyINR), nil)
+
+		_, err := svc.Generate(ctx, Params{EmployeeID: employee.ID, Year: 2025, Month: time.February, Format: FormatText})
+		assert.True(t, errors.IsValidationError(err))
+
+		_, err = svc.Generate(ctx, Params{EmployeeID: employee.ID, Year: 2025, Month: time.January, Format: FormatText})
+		assert.NoError(t, err)
+		salaryService.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid parameters", func(t *testing.T) {
+		cases := map[string]Params{
+			"unknown format": {EmployeeID: uuid.New(), Year: 2025, Month: time.March, Format: "docx"},
+			"no month":       {EmployeeID: uuid.New(), Year: 2025, Format: FormatText},
+			"month 13":       {EmployeeID: uuid.New(), Year: 2025, Month: 13, Format: FormatText},
+			"no year":        {EmployeeID: uuid.New(), Month: time.March, Format: FormatText},
+		}
+		for name, params := range cases {
+			t.Run(name, func(t *testing.T) {
+				employeeRepo := new(MockEmployeeRepository)
+				svc := NewService(employeeRepo, new(MockSalaryService), renderer, testEmployer)
+
+				_, err := svc.Generate(ctx, params)
+
+				assert.True(t, errors.IsValidationError(err))
+				employeeRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		id := uuid.New()
+		employeeRepo := new(MockEmployeeRepository)
+		svc := NewService(employeeRepo, new(MockSalaryService), renderer, testEmployer)
+		employeeRepo.On("FindByID", ctx, id).Return(nil, errors.NewNotFoundError("employee"))
+
+		_, err := svc.Generate(ctx, Params{EmployeeID: id, Year: 2025, Month: time.March, Format: FormatPDF})
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func TestNewRenderer(t *testing.T) {
+	slip := &Payslip{
+		Employer:    testEmployer,
+		EmployeeID:  "3f2504e0-4f89-41d3-9a0c-0305e82c3301",
+		Country:     "India",
+		PeriodStart: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		NetPay:      decimal.RequireFromString("1234567.891"),
+	}
+
+	t.Run("templates in the directory replace built-in ones", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "india.txt.tmpl"), []byte("{{.Employer.Name}} pays {{money .NetPay}}\n"), 0o644))
+
+		renderer, err := NewRenderer(dir)
+		require.NoError(t, err)
+		text, err := renderer.Text(slip)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Acme Corp pays 1,234,567.89\n", string(text))
+	})
+
+	t.Run("rejects an invalid template", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "india.txt.tmpl"), []byte("{{.NetPay"), 0o644))
+
+		_, err := NewRenderer(dir)
+
+		assert.Error(t, err)
+	})
+}
+
+func decimalPtr(value string) *decimal.Decimal {
+	d := decimal.RequireFromString(value)
+	return &d
+}
@@ -0,0 +1,178 @@
+package salary
+
+import (
+	"context"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayrollParams selects the departments whose payroll cost is rolled up:
+// Root and its sub-departments, or every department when it is nil. Only
+// employees with one of Statuses are counted, the active ones when it is
+// empty.
+type PayrollParams struct {
+	Root      *uuid.UUID
+	Statuses  []entity.EmploymentStatus
+	Reporting Reporting
+}
+
+// DepartmentPayroll is the yearly gross payroll of a department. Headcount
+// and Cost count its own employees; TotalHeadcount and TotalCost add those
+// of all its sub-departments. Department is nil for the employees without a
+// department.
+type DepartmentPayroll struct {
+	Department     *entity.Department
+	Depth          int
+	Headcount      int64
+	Cost           decimal.Decimal
+	TotalHeadcount int64
+	TotalCost      decimal.Decimal
+}
+
+// Payroll lists departments in tree order: each department is followed by
+// its sub-departments, siblings ordered by name.
+type Payroll struct {
+	Currency    valueobject.Currency
+	Departments []DepartmentPayroll
+}
+
+// GetDepartmentPayroll rolls salaries up the department hierarchy. When no
+// root is given, the employees without a department are listed last.
+func (s *service) GetDepartmentPayroll(ctx context.Context, params PayrollParams) (*Payroll, error) {
+	filter := activeByDefault(repository.EmployeeFilter{Statuses: params.Statuses})
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	departments, err := s.departmentRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[uuid.UUID][]*entity.Department, len(departments))
+	var roots []*entity.Department
+	for _, department := range departments {
+		switch {
+		case params.Root != nil && department.ID == *params.Root:
+			roots = append(roots, department)
+		case params.Root == nil && department.ParentID == nil:
+			roots = append(roots, department)
+		case department.ParentID != nil:
+			children[*department.ParentID] = append(children[*department.ParentID], department)
+		}
+	}
+	if params.Root != nil && len(roots) == 0 {
+		return nil, errors.NewNotFoundError("department")
+	}
+
+	totals, err := s.employeeRepo.GetDepartmentSalaryTotals(ctx, filter.Statuses)
+	if err != nil {
+		return nil, err
+	}
+	if params.Root != nil {
+		// Only the currencies paid in the subtree need a rate.
+		totals = subtreeTotals(totals, roots[0], children)
+	}
+	currency, rates, err := s.payrollRates(ctx, totals, params.Reporting)
+	if err != nil {
+		return nil, err
+	}
+
+	direct := make(map[uuid.UUID]*DepartmentPayroll, len(departments))
+	unassigned := &DepartmentPayroll{}
+	for _, t := range totals {
+		row := unassigned
+		if t.DepartmentID != nil {
+			if row = direct[*t.DepartmentID]; row == nil {
+				row = &DepartmentPayroll{}
+				direct[*t.DepartmentID] = row
+			}
+		}
+		row.Headcount += t.Count
+		row.Cost = row.Cost.Add(t.SumSalary.Mul(rates[t.Currency]))
+	}
+
+	payroll := &Payroll{Currency: currency, Departments: []DepartmentPayroll{}}
+	var visit func(department *entity.Department, depth int) (int64, decimal.Decimal)
+	visit = func(department *entity.Department, depth int) (int64, decimal.Decimal) {
+		row := DepartmentPayroll{Department: department, Depth: depth}
+		if own := direct[department.ID]; own != nil {
+			row.Headcount, row.Cost = own.Headcount, own.Cost
+		}
+		row.TotalHeadcount, row.TotalCost = row.Headcount, row.Cost
+
+		index := len(payroll.Departments)
+		payroll.Departments = append(payroll.Departments, row)
+		for _, child := range children[department.ID] {
+			headcount, cost := visit(child, depth+1)
+			row.TotalHeadcount += headcount
+			row.TotalCost = row.TotalCost.Add(cost)
+		}
+
+		total := row.TotalCost
+		row.Cost, row.TotalCost = row.Cost.Round(2), row.TotalCost.Round(2)
+		payroll.Departments[index] = row
+		return row.TotalHeadcount, total
+	}
+	for _, root := range roots {
+		visit(root, 0)
+	}
+
+	if params.Root == nil && unassigned.Headcount > 0 {
+		unassigned.Cost = unassigned.Cost.Round(2)
+		unassigned.TotalHeadcount, unassigned.TotalCost = unassigned.Headcount, unassigned.Cost
+		payroll.Departments = append(payroll.Departments, *unassigned)
+	}
+	return payroll, nil
+}
+
+// subtreeTotals keeps the totals of root and its sub-departments.
+func subtreeTotals(totals []repository.DepartmentSalaryTotals, root *entity.Department, children map[uuid.UUID][]*entity.Department) []repository.DepartmentSalaryTotals {
+	inSubtree := make(map[uuid.UUID]bool)
+	pending := []*entity.Department{root}
+	for len(pending) > 0 {
+		department := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		inSubtree[department.ID] = true
+		pending = append(pending, children[department.ID]...)
+	}
+
+	var scoped []repository.DepartmentSalaryTotals
+	for _, t := range totals {
+		if t.DepartmentID != nil && inSubtree[*t.DepartmentID] {
+			scoped = append(scoped, t)
+		}
+	}
+	return scoped
+}
+
+// payrollRates returns the currency of a payroll and the rate converting
+// each currency salaries are paid in to it.
+func (s *service) payrollRates(ctx context.Context, totals []repository.DepartmentSalaryTotals, reporting Reporting) (valueobject.Currency, map[valueobject.Currency]decimal.Decimal, error) {
+	var currencies []valueobject.SalaryTotals
+	seen := make(map[valueobject.Currency]bool)
+	for _, t := range totals {
+		if !seen[t.Currency] {
+			seen[t.Currency] = true
+			currencies = append(currencies, valueobject.SalaryTotals{Currency: t.Currency})
+		}
+	}
+
+	if len(currencies) > 0 {
+		return s.conversionRates(ctx, currencies, reporting)
+	}
+	// Without employees there is nothing to convert; the payroll is zero in
+	// the reporting currency, if any.
+	if reporting.Currency == "" {
+		return "", nil, nil
+	}
+	currency, err := valueobject.ParseCurrency(reporting.Currency)
+	if err != nil {
+		return "", nil, errors.NewValidationError("reporting_currency must be an ISO 4217 code")
+	}
+	return currency, nil, nil
+}
@@ -2,44 +2,498 @@ package salary
 
 import (
 	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
 	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type Service interface {
-	CalculateNetSalary(ctx context.Context, employeeID uuid.UUID) (*valueobject.Salary, error)
-	GetSalaryStatsByCountry(ctx context.Context, country string) (*valueobject.SalaryStats, error)
-	GetAvgSalaryByJobTitle(ctx context.Context, jobTitle string) (*valueobject.JobTitleSalaryStats, error)
+	CalculateNetSalary(ctx context.Context, employeeID uuid.UUID, asOf time.Time) (*valueobject.Salary, error)
+	NewNetSalaryCalculator(asOf time.Time) NetSalaryCalculator
+	GetSalaryStatsByCountry(ctx context.Context, country string, reportsTo *uuid.UUID, reporting Reporting) (*valueobject.SalaryStats, error)
+	GetAvgSalaryByJobTitle(ctx context.Context, jobTitle string, reportsTo *uuid.UUID, reporting Reporting) (*valueobject.JobTitleSalaryStats, error)
+	GetSalaryDistribution(ctx context.Context, params DistributionParams) (*valueobject.SalaryDistribution, error)
+	AggregateSalaries(ctx context.Context, params AggregateParams) (*Aggregate, error)
+	GetDepartmentPayroll(ctx context.Context, params PayrollParams) (*Payroll, error)
 }
 
+// NetSalaryCalculator taxes gross salaries as CalculateNetSalary does, at
+// one date, resolving each country's tax rule and exchange rate only once.
+// It is not safe for concurrent use.
+type NetSalaryCalculator interface {
+	NetSalary(ctx context.Context, country string, grossSalary decimal.Decimal, currency valueobject.Currency) (*valueobject.Salary, error)
+}
+
+// Reporting selects the currency salary statistics are reported in and the
+// date whose exchange rates convert to it (today when zero). An empty
+// Currency reports in the employees' own currency, which they must then all
+// share.
+type Reporting struct {
+	Currency string
+	AsOf     time.Time
+}
+
+// DistributionParams selects the employees whose salary distribution is
+// described and the number of histogram buckets (DefaultHistogramBuckets
+// when zero). Unless the filter names statuses, only active employees are
+// described.
+type DistributionParams struct {
+	Filter    repository.EmployeeFilter
+	Reporting Reporting
+	Buckets   int
+}
+
+const (
+	DefaultHistogramBuckets = 10
+	MaxHistogramBuckets     = 100
+)
+
+// AggregateParams groups the salaries of the employees matching Filter by
+// Dimensions and computes Metrics (DefaultAggregateMetrics when empty) for
+// each group. Without a reporting currency, salaries in different
+// currencies can only be aggregated when grouped by currency. Unless the
+// filter names statuses, only active employees are counted.
+type AggregateParams struct {
+	Filter     repository.EmployeeFilter
+	Dimensions []repository.SalaryDimension
+	Metrics    []repository.SalaryMetric
+	Reporting  Reporting
+}
+
+// Aggregate is a table of salary groups with one column per dimension and
+// metric.
+type Aggregate struct {
+	Dimensions []repository.SalaryDimension
+	Metrics    []repository.SalaryMetric
+	Groups     []repository.SalaryGroup
+}
+
+// MaxAggregateGroups bounds the rows of an Aggregate.
+const MaxAggregateGroups = 1000
+
+var DefaultAggregateMetrics = []repository.SalaryMetric{
+	repository.MetricCount,
+	repository.MetricMin,
+	repository.MetricMax,
+	repository.MetricAvg,
+}
+
+// exchangeRateScale is the number of decimal places an inverted exchange
+// rate is kept to.
+const exchangeRateScale = 10
+
 type service struct {
-	employeeRepo repository.EmployeeRepository
+	employeeRepo     repository.EmployeeRepository
+	compensationRepo repository.CompensationRepository
+	taxRuleRepo      repository.TaxRuleRepository
+	exchangeRateRepo repository.ExchangeRateRepository
+	departmentRepo   repository.DepartmentRepository
+	transactor       repository.Transactor
+	now              func() time.Time
 }
 
-func NewService(employeeRepo repository.EmployeeRepository) Service {
-	return &service{employeeRepo: employeeRepo}
+func NewService(employeeRepo repository.EmployeeRepository, compensationRepo repository.CompensationRepository, taxRuleRepo repository.TaxRuleRepository, exchangeRateRepo repository.ExchangeRateRepository, departmentRepo repository.DepartmentRepository, transactor repository.Transactor) Service {
+	return &service{
+		employeeRepo:     employeeRepo,
+		compensationRepo: compensationRepo,
+		taxRuleRepo:      taxRuleRepo,
+		exchangeRateRepo: exchangeRateRepo,
+		departmentRepo:   departmentRepo,
+		transactor:       transactor,
+		now:              time.Now,
+	}
 }
 
-func (s *service) CalculateNetSalary(ctx context.Context, employeeID uuid.UUID) (*valueobject.Salary, error) {
+// CalculateNetSalary applies the tax rule in force at asOf (now when zero)
+// to the gross salary the employee was paid at asOf. Tax bands are in the
+// country's currency, so a salary paid in another currency is converted at
+// the rate of asOf and the calculation is reported in the country's
+// currency.
+func (s *service) CalculateNetSalary(ctx context.Context, employeeID uuid.UUID, asOf time.Time) (*valueobject.Salary, error) {
+	if asOf.IsZero() {
+		asOf = s.now()
+	}
+
 	employee, err := s.employeeRepo.FindByID(ctx, employeeID)
 	if err != nil {
 		return nil, err
 	}
 
-	salary := valueobject.CalculateNetSalary(
-		employee.GrossSalary,
-		valueobject.Country(employee.Country),
-	)
+	compensation, err := s.compensationRepo.FindEffective(ctx, employee.ID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.NewNetSalaryCalculator(asOf).NetSalary(ctx, employee.Country, compensation.GrossSalary, valueobject.Currency(compensation.Currency))
+}
 
+func (s *service) NewNetSalaryCalculator(asOf time.Time) NetSalaryCalculator {
+	if asOf.IsZero() {
+		asOf = s.now()
+	}
+	return &netSalaryCalculator{
+		service:   s,
+		asOf:      asOf,
+		schedules: make(map[string]valueobject.TaxSchedule),
+		rates:     make(map[[2]valueobject.Currency]decimal.Decimal),
+	}
+}
+
+type netSalaryCalculator struct {
+	service   *service
+	asOf      time.Time
+	schedules map[string]valueobject.TaxSchedule
+	// rates holds the rate converting the first currency to the second.
+	rates map[[2]valueobject.Currency]decimal.Decimal
+}
+
+// NetSalary applies the country's tax rule to a gross salary. Countries
+// without a known currency tax the salary in the currency it is paid in.
+func (c *netSalaryCalculator) NetSalary(ctx context.Context, country string, grossSalary decimal.Decimal, currency valueobject.Currency) (*valueobject.Salary, error) {
+	schedule, ok := c.schedules[country]
+	if !ok {
+		var err error
+		if schedule, err = c.service.taxSchedule(ctx, country, c.asOf); err != nil {
+			return nil, err
+		}
+		c.schedules[country] = schedule
+	}
+
+	if local := valueobject.Country(country).Currency(); local != "" && local != currency {
+		pair := [2]valueobject.Currency{currency, local}
+		rate, ok := c.rates[pair]
+		if !ok {
+			var err error
+			if rate, err = c.service.exchangeRate(ctx, currency, local, c.asOf); err != nil {
+				return nil, err
+			}
+			c.rates[pair] = rate
+		}
+		grossSalary = grossSalary.Mul(rate).Round(2)
+		currency = local
+	}
+
+	salary := schedule.Apply(grossSalary)
+	salary.Currency = currency
 	return &salary, nil
 }
 
-func (s *service) GetSalaryStatsByCountry(ctx context.Context, country string) (*valueobject.SalaryStats, error) {
-	return s.employeeRepo.GetSalaryStatsByCountry(ctx, country)
+// taxSchedule resolves the country's tax rule valid at asOf. The catalog is
+// the only source of schedules, so a date it does not cover is an error
+// rather than a guess that could change between releases.
+func (s *service) taxSchedule(ctx context.Context, country string, asOf time.Time) (valueobject.TaxSchedule, error) {
+	rule, err := s.taxRuleRepo.FindEffective(ctx, country, asOf)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return valueobject.TaxSchedule{}, errors.NewNotFoundError("tax rule for " + country + " at " + asOf.UTC().Format(time.RFC3339))
+		}
+		return valueobject.TaxSchedule{}, err
+	}
+	return rule.Schedule(), nil
+}
+
+// GetSalaryStatsByCountry describes the salaries paid to the active
+// employees in a country, only to those below a manager when reportsTo is
+// set.
+func (s *service) GetSalaryStatsByCountry(ctx context.Context, country string, reportsTo *uuid.UUID, reporting Reporting) (*valueobject.SalaryStats, error) {
+	totals, err := s.employeeRepo.GetSalaryTotals(ctx, activeByDefault(repository.EmployeeFilter{Country: country, ReportsTo: reportsTo}))
+	if err != nil {
+		return nil, err
+	}
+	if len(totals) == 0 {
+		return nil, errors.NewNotFoundError("employees in country")
+	}
+
+	combined, err := s.combineTotals(ctx, totals, reporting)
+	if err != nil {
+		return nil, err
+	}
+
+	return &valueobject.SalaryStats{
+		Currency:  combined.Currency,
+		MinSalary: combined.MinSalary,
+		MaxSalary: combined.MaxSalary,
+		AvgSalary: average(combined),
+		Count:     combined.Count,
+	}, nil
+}
+
+// GetAvgSalaryByJobTitle averages the salaries of the active employees with
+// a job title, only over those below a manager when reportsTo is set.
+func (s *service) GetAvgSalaryByJobTitle(ctx context.Context, jobTitle string, reportsTo *uuid.UUID, reporting Reporting) (*valueobject.JobTitleSalaryStats, error) {
+	totals, err := s.employeeRepo.GetSalaryTotals(ctx, activeByDefault(repository.EmployeeFilter{JobTitle: jobTitle, ReportsTo: reportsTo}))
+	if err != nil {
+		return nil, err
+	}
+	if len(totals) == 0 {
+		return nil, errors.NewNotFoundError("employees with job title")
+	}
+
+	combined, err := s.combineTotals(ctx, totals, reporting)
+	if err != nil {
+		return nil, err
+	}
+
+	return &valueobject.JobTitleSalaryStats{
+		JobTitle:  jobTitle,
+		Currency:  combined.Currency,
+		AvgSalary: average(combined),
+		Count:     combined.Count,
+	}, nil
+}
+
+// GetSalaryDistribution describes the salaries of the employees matching the
+// filter: percentiles, standard deviation and a histogram, all computed by
+// the database after converting to the reporting currency.
+func (s *service) GetSalaryDistribution(ctx context.Context, params DistributionParams) (*valueobject.SalaryDistribution, error) {
+	if err := params.Filter.Validate(); err != nil {
+		return nil, err
+	}
+	params.Filter = activeByDefault(params.Filter)
+	if params.Buckets == 0 {
+		params.Buckets = DefaultHistogramBuckets
+	}
+	if params.Buckets < 1 || params.Buckets > MaxHistogramBuckets {
+		return nil, errors.NewValidationError("histogram_buckets must be between 1 and " + strconv.Itoa(MaxHistogramBuckets))
+	}
+
+	// The currencies paid, their rates and the distribution are read from
+	// one snapshot, so every salary in the distribution has a rate.
+	var distribution *valueobject.SalaryDistribution
+	err := s.transactor.WithinSnapshot(ctx, func(ctx context.Context) error {
+		totals, err := s.employeeRepo.GetSalaryTotals(ctx, params.Filter)
+		if err != nil {
+			return err
+		}
+		if len(totals) == 0 {
+			return errors.NewNotFoundError("employees matching the filter")
+		}
+
+		currency, rates, err := s.conversionRates(ctx, totals, params.Reporting)
+		if err != nil {
+			return err
+		}
+
+		distribution, err = s.employeeRepo.GetSalaryDistribution(ctx, params.Filter, rates, params.Buckets)
+		if err != nil {
+			return err
+		}
+		distribution.Currency = currency
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return distribution, nil
+}
+
+// AggregateSalaries returns one row per combination of dimension values
+// found among the matching employees, ordered by those values.
+func (s *service) AggregateSalaries(ctx context.Context, params AggregateParams) (*Aggregate, error) {
+	if err := validateAggregate(&params); err != nil {
+		return nil, err
+	}
+	params.Filter = activeByDefault(params.Filter)
+
+	aggregate := &Aggregate{Dimensions: params.Dimensions, Metrics: params.Metrics, Groups: []repository.SalaryGroup{}}
+	currencyColumn := slices.Index(params.Dimensions, repository.DimensionCurrency)
+	var currency valueobject.Currency
+	var groups []repository.SalaryGroup
+	// As for distributions, the currencies paid, their rates and the groups
+	// are read from one snapshot.
+	err := s.transactor.WithinSnapshot(ctx, func(ctx context.Context) error {
+		totals, err := s.employeeRepo.GetSalaryTotals(ctx, params.Filter)
+		if err != nil {
+			return err
+		}
+		if len(totals) == 0 {
+			return nil
+		}
+
+		// Grouping by currency keeps every group in one currency, so
+		// salaries only need converting when a reporting currency is
+		// asked for.
+		var rates map[valueobject.Currency]decimal.Decimal
+		if params.Reporting.Currency == "" && currencyColumn >= 0 {
+			rates = make(map[valueobject.Currency]decimal.Decimal, len(totals))
+			for _, t := range totals {
+				rates[t.Currency] = decimal.NewFromInt(1)
+			}
+		} else if currency, rates, err = s.conversionRates(ctx, totals, params.Reporting); err != nil {
+			return err
+		}
+
+		groups, err = s.employeeRepo.AggregateSalaries(ctx, repository.SalaryAggregation{
+			Filter:     params.Filter,
+			Dimensions: params.Dimensions,
+			Metrics:    params.Metrics,
+			Rates:      rates,
+			Limit:      MaxAggregateGroups + 1,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return aggregate, nil
+	}
+	if len(groups) > MaxAggregateGroups {
+		return nil, errors.NewValidationError(
+			"more than " + strconv.Itoa(MaxAggregateGroups) + " groups; narrow the filter or group by fewer dimensions")
+	}
+
+	for i := range groups {
+		groups[i].Currency = currency
+		if currency == "" {
+			groups[i].Currency = valueobject.Currency(groups[i].Keys[currencyColumn])
+		}
+	}
+	aggregate.Groups = groups
+	return aggregate, nil
+}
+
+func validateAggregate(params *AggregateParams) error {
+	if err := params.Filter.Validate(); err != nil {
+		return err
+	}
+	if len(params.Dimensions) == 0 {
+		return errors.NewValidationError("at least one group_by dimension is required")
+	}
+	for i, dimension := range params.Dimensions {
+		if !dimension.IsValid() {
+			return errors.NewValidationError("unsupported group_by dimension")
+		}
+		if slices.Contains(params.Dimensions[:i], dimension) {
+			return errors.NewValidationError("group_by dimension " + string(dimension) + " is repeated")
+		}
+	}
+
+	if len(params.Metrics) == 0 {
+		params.Metrics = DefaultAggregateMetrics
+	}
+	for i, metric := range params.Metrics {
+		if !metric.IsValid() {
+			return errors.NewValidationError("unsupported metric")
+		}
+		if slices.Contains(params.Metrics[:i], metric) {
+			return errors.NewValidationError("metric " + string(metric) + " is repeated")
+		}
+	}
+	return nil
+}
+
+// combineTotals converts per-currency totals to the reporting currency and
+// adds them up. Converting preserves order within a currency, so the
+// converted minimum and maximum of each currency bound the combined ones.
+func (s *service) combineTotals(ctx context.Context, totals []valueobject.SalaryTotals, reporting Reporting) (valueobject.SalaryTotals, error) {
+	currency, rates, err := s.conversionRates(ctx, totals, reporting)
+	if err != nil {
+		return valueobject.SalaryTotals{}, err
+	}
+	if reporting.Currency == "" {
+		return totals[0], nil
+	}
+
+	combined := valueobject.SalaryTotals{Currency: currency}
+	for i, t := range totals {
+		rate := rates[t.Currency]
+		minSalary, maxSalary := t.MinSalary.Mul(rate), t.MaxSalary.Mul(rate)
+		if i == 0 || minSalary.LessThan(combined.MinSalary) {
+			combined.MinSalary = minSalary
+		}
+		if i == 0 || maxSalary.GreaterThan(combined.MaxSalary) {
+			combined.MaxSalary = maxSalary
+		}
+		combined.SumSalary = combined.SumSalary.Add(t.SumSalary.Mul(rate))
+		combined.Count += t.Count
+	}
+
+	combined.MinSalary = combined.MinSalary.Round(2)
+	combined.MaxSalary = combined.MaxSalary.Round(2)
+	return combined, nil
+}
+
+// conversionRates returns the currency to report totals in and the rate
+// converting each of their currencies to it. Without a reporting currency
+// the totals must all be in one currency, which is then reported as is.
+func (s *service) conversionRates(ctx context.Context, totals []valueobject.SalaryTotals, reporting Reporting) (valueobject.Currency, map[valueobject.Currency]decimal.Decimal, error) {
+	if reporting.Currency == "" {
+		if len(totals) > 1 {
+			currencies := make([]string, 0, len(totals))
+			for _, t := range totals {
+				currencies = append(currencies, t.Currency.String())
+			}
+			return "", nil, errors.NewValidationError(
+				"salaries are paid in " + strings.Join(currencies, ", ") + "; set reporting_currency to combine them")
+		}
+		return totals[0].Currency, map[valueobject.Currency]decimal.Decimal{totals[0].Currency: decimal.NewFromInt(1)}, nil
+	}
+
+	target, err := valueobject.ParseCurrency(reporting.Currency)
+	if err != nil {
+		return "", nil, errors.NewValidationError("reporting_currency must be an ISO 4217 code")
+	}
+	asOf := reporting.AsOf
+	if asOf.IsZero() {
+		asOf = s.now()
+	}
+
+	rates := make(map[valueobject.Currency]decimal.Decimal, len(totals))
+	for _, t := range totals {
+		rate, err := s.exchangeRate(ctx, t.Currency, target, asOf)
+		if err != nil {
+			return "", nil, err
+		}
+		rates[t.Currency] = rate
+	}
+	return target, rates, nil
+}
+
+// exchangeRate returns how many units of to one unit of from buys on the
+// date of asOf, using the inverse of the opposite rate when only that one is
+// stored.
+func (s *service) exchangeRate(ctx context.Context, from, to valueobject.Currency, asOf time.Time) (decimal.Decimal, error) {
+	if from == to {
+		return decimal.NewFromInt(1), nil
+	}
+
+	rate, err := s.exchangeRateRepo.FindEffective(ctx, from.String(), to.String(), asOf)
+	if err == nil {
+		return rate.Rate, nil
+	}
+	if !errors.IsNotFoundError(err) {
+		return decimal.Zero, err
+	}
+
+	inverse, err := s.exchangeRateRepo.FindEffective(ctx, to.String(), from.String(), asOf)
+	if err == nil {
+		return decimal.NewFromInt(1).DivRound(inverse.Rate, exchangeRateScale), nil
+	}
+	if errors.IsNotFoundError(err) {
+		return decimal.Zero, errors.NewValidationError(
+			"no exchange rate from " + from.String() + " to " + to.String() + " on " + asOf.UTC().Format(time.DateOnly))
+	}
+	return decimal.Zero, err
+}
+
+// activeByDefault limits filter to active employees unless it names the
+// statuses to include.
+func activeByDefault(filter repository.EmployeeFilter) repository.EmployeeFilter {
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []entity.EmploymentStatus{entity.EmploymentStatusActive}
+	}
+	return filter
 }
 
-func (s *service) GetAvgSalaryByJobTitle(ctx context.Context, jobTitle string) (*valueobject.JobTitleSalaryStats, error) {
-	return s.employeeRepo.GetAvgSalaryByJobTitle(ctx, jobTitle)
+func average(totals valueobject.SalaryTotals) decimal.Decimal {
+	return totals.SumSalary.DivRound(decimal.NewFromInt(totals.Count), 2)
 }
@@ -0,0 +1,1131 @@
+package salary
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/valueobject"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockEmployeeRepository struct {
+	mock.Mock
+}
+
+func (m *MockEmployeeRepository) Create(ctx context.Context, employee *entity.Employee) error {
+	args := m.Called(ctx, employee)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) CreateBatch(ctx context.Context, employees []*entity.Employee) error {
+	args := m.Called(ctx, employees)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) FindByIDWithDeleted(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) List(ctx context.Context, params repository.EmployeeListParams) (*repository.EmployeePage, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.EmployeePage), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Search(ctx context.Context, query string, limit int) ([]repository.EmployeeMatch, error) {
+	args := m.Called(ctx, query, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.EmployeeMatch), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Update(ctx context.Context, employee *entity.Employee, fields []repository.EmployeeField) error {
+	args := m.Called(ctx, employee, fields)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) ListDeleted(ctx context.Context, page, pageSize int) (*repository.EmployeePage, error) {
+	args := m.Called(ctx, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.EmployeePage), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Restore(ctx context.Context, id uuid.UUID) (*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, cutoff, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) ListOrgTree(ctx context.Context, root *uuid.UUID, maxDepth, limit int) ([]repository.OrgNode, error) {
+	args := m.Called(ctx, root, maxDepth, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.OrgNode), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetManagementChain(ctx context.Context, id uuid.UUID) ([]*entity.Employee, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetSalaryTotals(ctx context.Context, filter repository.EmployeeFilter) ([]valueobject.SalaryTotals, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]valueobject.SalaryTotals), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetDepartmentSalaryTotals(ctx context.Context, statuses []entity.EmploymentStatus) ([]repository.DepartmentSalaryTotals, error) {
+	args := m.Called(ctx, statuses)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.DepartmentSalaryTotals), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetSalaryDistribution(ctx context.Context, filter repository.EmployeeFilter, rates map[valueobject.Currency]decimal.Decimal, buckets int) (*valueobject.SalaryDistribution, error) {
+	args := m.Called(ctx, filter, rates, buckets)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*valueobject.SalaryDistribution), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) AggregateSalaries(ctx context.Context, aggregation repository.SalaryAggregation) ([]repository.SalaryGroup, error) {
+	args := m.Called(ctx, aggregation)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.SalaryGroup), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) Stream(ctx context.Context, filter repository.EmployeeFilter, fn func(*entity.Employee) error) error {
+	args := m.Called(ctx, filter, fn)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) CountByCountry(ctx context.Context) (map[string]int64, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+type MockTaxRuleRepository struct {
+	mock.Mock
+}
+
+func (m *MockTaxRuleRepository) Supersede(ctx context.Context, rule *entity.TaxRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockTaxRuleRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.TaxRule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TaxRule), args.Error(1)
+}
+
+func (m *MockTaxRuleRepository) FindEffective(ctx context.Context, country string, asOf time.Time) (*entity.TaxRule, error) {
+	args := m.Called(ctx, country, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TaxRule), args.Error(1)
+}
+
+func (m *MockTaxRuleRepository) ListByCountry(ctx context.Context, country string) ([]*entity.TaxRule, error) {
+	args := m.Called(ctx, country)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TaxRule), args.Error(1)
+}
+
+func (m *MockTaxRuleRepository) Update(ctx context.Context, rule *entity.TaxRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+type MockCompensationRepository struct {
+	mock.Mock
+}
+
+func (m *MockCompensationRepository) Insert(ctx context.Context, record *entity.CompensationRecord) error {
+	args := m.Called(ctx, record)
+	return args.Error(0)
+}
+
+func (m *MockCompensationRepository) CreateBatch(ctx context.Context, records []*entity.CompensationRecord) error {
+	args := m.Called(ctx, records)
+	return args.Error(0)
+}
+
+func (m *MockCompensationRepository) FindEffective(ctx context.Context, employeeID uuid.UUID, asOf time.Time) (*entity.CompensationRecord, error) {
+	args := m.Called(ctx, employeeID, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CompensationRecord), args.Error(1)
+}
+
+func (m *MockCompensationRepository) ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]*entity.CompensationRecord, error) {
+	args := m.Called(ctx, employeeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.CompensationRecord), args.Error(1)
+}
+
+func (m *MockCompensationRepository) ListDue(ctx context.Context, asOf time.Time, limit int) ([]*entity.CompensationRecord, error) {
+	args := m.Called(ctx, asOf, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.CompensationRecord), args.Error(1)
+}
+
+func (m *MockCompensationRepository) CancelScheduled(ctx context.Context, employeeID uuid.UUID, asOf time.Time) error {
+	args := m.Called(ctx, employeeID, asOf)
+	return args.Error(0)
+}
+
+type MockExchangeRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRateRepository) Upsert(ctx context.Context, rates []*entity.ExchangeRate) error {
+	args := m.Called(ctx, rates)
+	return args.Error(0)
+}
+
+func (m *MockExchangeRateRepository) FindEffective(ctx context.Context, base, quote string, asOf time.Time) (*entity.ExchangeRate, error) {
+	args := m.Called(ctx, base, quote, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) List(ctx context.Context, base string) ([]*entity.ExchangeRate, error) {
+	args := m.Called(ctx, base)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ExchangeRate), args.Error(1)
+}
+
+type MockDepartmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockDepartmentRepository) Create(ctx context.Context, department *entity.Department) error {
+	args := m.Called(ctx, department)
+	return args.Error(0)
+}
+
+func (m *MockDepartmentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Department, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Department), args.Error(1)
+}
+
+func (m *MockDepartmentRepository) List(ctx context.Context) ([]*entity.Department, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Department), args.Error(1)
+}
+
+func (m *MockDepartmentRepository) Update(ctx context.Context, department *entity.Department) error {
+	args := m.Called(ctx, department)
+	return args.Error(0)
+}
+
+func (m *MockDepartmentRepository) Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) ([]*entity.Employee, error) {
+	args := m.Called(ctx, id, reassignTo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Employee), args.Error(1)
+}
+
+// passthroughTransactor runs the function without a transaction.
+type passthroughTransactor struct{}
+
+func (passthroughTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (passthroughTransactor) WithinSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// currentSalary returns a compensation history in which the employee has
+// always been paid their current gross salary.
+func currentSalary(employee *entity.Employee) *MockCompensationRepository {
+	m := new(MockCompensationRepository)
+	record := entity.NewCompensationRecord(employee.ID, employee.GrossSalary, employee.Currency, entity.CompensationReasonHire, nil, time.Time{})
+	m.On("FindEffective", mock.Anything, employee.ID, mock.Anything).Return(record, nil)
+	return m
+}
+
+// seededTaxRules returns a catalog holding the rules seeded by migration
+// 0013 for India and the United States, and no rules for other countries.
+func seededTaxRules() *MockTaxRuleRepository {
+	since := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	band := func(upTo, rate string) entity.TaxBand {
+		b := entity.TaxBand{Rate: decimal.RequireFromString(rate)}
+		if upTo != "" {
+			limit := decimal.RequireFromString(upTo)
+			b.UpTo = &limit
+		}
+		return b
+	}
+	india := entity.NewTaxRule("India", entity.TaxRuleTypeProgressive, decimal.Zero, entity.TaxBands{
+		band("300000", "0"), band("700000", "0.05"), band("1000000", "0.1"),
+		band("1200000", "0.15"), band("1500000", "0.2"), band("", "0.3"),
+	}, decimal.NewFromInt(75000), decimal.NullDecimal{}, since)
+	unitedStates := entity.NewTaxRule("United States", entity.TaxRuleTypeProgressive, decimal.Zero, entity.TaxBands{
+		band("11600", "0.1"), band("47150", "0.12"), band("100525", "0.22"), band("191950", "0.24"),
+		band("243725", "0.32"), band("609350", "0.35"), band("", "0.37"),
+	}, decimal.NewFromInt(14600), decimal.NullDecimal{}, since)
+
+	m := new(MockTaxRuleRepository)
+	m.On("FindEffective", mock.Anything, "India", mock.Anything).Return(india, nil)
+	m.On("FindEffective", mock.Anything, "United States", mock.Anything).Return(unitedStates, nil)
+	m.On("FindEffective", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.NewNotFoundError("tax rule"))
+	return m
+}
+
+func newEmployee(country string, grossSalary int64) *entity.Employee {
+	return &entity.Employee{
+		ID:          uuid.New(),
+		FullName:    "Jane Doe",
+		JobTitle:    "Engineer",
+		Country:     country,
+		GrossSalary: decimal.NewFromInt(grossSalary),
+		Currency:    valueobject.Country(country).Currency().String(),
+	}
+}
+
+func TestSalaryService_CalculateNetSalary(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("progressive brackets - India", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		emp := newEmployee("India", 1000000)
+		svc := NewService(mockRepo, currentSalary(emp), seededTaxRules(), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+		mockRepo.On("FindByID", ctx, emp.ID).Return(emp, nil)
+
+		salary, err := svc.CalculateNetSalary(ctx, emp.ID, time.Time{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "925000", salary.TaxableIncome.String())
+		assert.Len(t, salary.Brackets, 3)
+		assert.Equal(t, "0", salary.Brackets[0].TaxAmount.String())
+		assert.Equal(t, "20000", salary.Brackets[1].TaxAmount.String())
+		assert.Equal(t, "22500", salary.Brackets[2].TaxAmount.String())
+		assert.Equal(t, "42500", salary.TaxAmount.String())
+		assert.Equal(t, "957500", salary.NetSalary.String())
+		assert.Equal(t, "0.0425", salary.TaxRate.String())
+		assert.Equal(t, valueobject.CurrencyINR, salary.Currency)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("breakdown reconciles with total - United States", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		emp := newEmployee("United States", 150000)
+		svc := NewService(mockRepo, currentSalary(emp), seededTaxRules(), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+		mockRepo.On("FindByID", ctx, emp.ID).Return(emp, nil)
+
+		salary, err := svc.CalculateNetSalary(ctx, emp.ID, time.Time{})
+
+		assert.NoError(t, err)
+		sum := decimal.Zero
+		for _, b := range salary.Brackets {
+			sum = sum.Add(b.TaxAmount)
+		}
+		assert.True(t, sum.Equal(salary.TaxAmount))
+		assert.True(t, salary.GrossSalary.Sub(salary.TaxAmount).Equal(salary.NetSalary))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("income below standard deduction", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		emp := newEmployee("United States", 10000)
+		svc := NewService(mockRepo, currentSalary(emp), seededTaxRules(), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+		mockRepo.On("FindByID", ctx, emp.ID).Return(emp, nil)
+
+		salary, err := svc.CalculateNetSalary(ctx, emp.ID, time.Time{})
+
+		assert.NoError(t, err)
+		assert.True(t, salary.TaxAmount.IsZero())
+		assert.Empty(t, salary.Brackets)
+		assert.Equal(t, "10000", salary.NetSalary.String())
+	})
+
+	t.Run("no tax rule for the country", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		emp := newEmployee("Germany", 80000)
+		svc := NewService(mockRepo, currentSalary(emp), seededTaxRules(), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+		mockRepo.On("FindByID", ctx, emp.ID).Return(emp, nil)
+
+		salary, err := svc.CalculateNetSalary(ctx, emp.ID, time.Time{})
+
+		assert.Nil(t, salary)
+		assert.True(t, errors.IsNotFoundError(err))
+		assert.Contains(t, err.Error(), "tax rule for Germany")
+	})
+
+	t.Run("uses the catalog rule in force at as_of", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockTaxRules := new(MockTaxRuleRepository)
+		emp := newEmployee("India", 100000)
+		svc := NewService(mockRepo, currentSalary(emp), mockTaxRules, new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+		asOf := time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC)
+		rule := entity.NewTaxRule("India", entity.TaxRuleTypeFlat, decimal.RequireFromString("0.10"), nil,
+			decimal.Zero, decimal.NullDecimal{}, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		mockRepo.On("FindByID", ctx, emp.ID).Return(emp, nil)
+		mockTaxRules.On("FindEffective", ctx, "India", asOf).Return(rule, nil)
+
+		salary, err := svc.CalculateNetSalary(ctx, emp.ID, asOf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "10000", salary.TaxAmount.String())
+		assert.Equal(t, "90000", salary.NetSalary.String())
+		assert.Equal(t, "0.1", salary.TaxRate.String())
+		mockTaxRules.AssertExpectations(t)
+	})
+
+	t.Run("uses the gross salary paid at as_of", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockCompensation := new(MockCompensationRepository)
+		svc := NewService(mockRepo, mockCompensation, seededTaxRules(), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+		emp := newEmployee("India", 90000)
+		asOf := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+		raisedAt := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+		record := entity.NewCompensationRecord(emp.ID, decimal.NewFromInt(75000), "INR", entity.CompensationReasonHire, nil,
+			time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC))
+		record.EffectiveTo = &raisedAt
+
+		mockRepo.On("FindByID", ctx, emp.ID).Return(emp, nil)
+		mockCompensation.On("FindEffective", ctx, emp.ID, asOf).Return(record, nil)
+
+		salary, err := svc.CalculateNetSalary(ctx, emp.ID, asOf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "75000", salary.GrossSalary.String())
+		assert.Equal(t, "75000", salary.NetSalary.String())
+		mockCompensation.AssertExpectations(t)
+	})
+
+	t.Run("converts a salary paid in another currency to the country's", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockRates := new(MockExchangeRateRepository)
+		emp := newEmployee("India", 12000)
+		emp.Currency = "USD"
+		svc := NewService(mockRepo, currentSalary(emp), seededTaxRules(), mockRates, new(MockDepartmentRepository), passthroughTransactor{})
+
+		asOf := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+		mockRepo.On("FindByID", ctx, emp.ID).Return(emp, nil)
+		mockRates.On("FindEffective", ctx, "USD", "INR", asOf).
+			Return(entity.NewExchangeRate("USD", "INR", decimal.RequireFromString("83.5"), asOf), nil)
+
+		salary, err := svc.CalculateNetSalary(ctx, emp.ID, asOf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.CurrencyINR, salary.Currency)
+		assert.Equal(t, "1002000", salary.GrossSalary.String())
+		assert.Equal(t, "927000", salary.TaxableIncome.String())
+		assert.Equal(t, "42700", salary.TaxAmount.String())
+		assert.Equal(t, "959300", salary.NetSalary.String())
+		mockRates.AssertExpectations(t)
+	})
+
+	t.Run("no rate to the country's currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockRates := new(MockExchangeRateRepository)
+		emp := newEmployee("India", 12000)
+		emp.Currency = "USD"
+		svc := NewService(mockRepo, currentSalary(emp), seededTaxRules(), mockRates, new(MockDepartmentRepository), passthroughTransactor{})
+
+		mockRepo.On("FindByID", ctx, emp.ID).Return(emp, nil)
+		mockRates.On("FindEffective", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.NewNotFoundError("exchange rate"))
+
+		salary, err := svc.CalculateNetSalary(ctx, emp.ID, time.Time{})
+
+		assert.Nil(t, salary)
+		assert.True(t, errors.IsValidationError(err))
+		assert.Contains(t, err.Error(), "no exchange rate from USD to INR")
+	})
+
+	t.Run("as_of before the employee was hired", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockCompensation := new(MockCompensationRepository)
+		svc := NewService(mockRepo, mockCompensation, seededTaxRules(), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+		emp := newEmployee("Germany", 90000)
+		asOf := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		mockRepo.On("FindByID", ctx, emp.ID).Return(emp, nil)
+		mockCompensation.On("FindEffective", ctx, emp.ID, asOf).Return(nil, errors.NewNotFoundError("compensation record"))
+
+		salary, err := svc.CalculateNetSalary(ctx, emp.ID, asOf)
+
+		assert.Nil(t, salary)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), seededTaxRules(), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+		id := uuid.New()
+		mockRepo.On("FindByID", ctx, id).Return(nil, errors.NewNotFoundError("employee"))
+
+		salary, err := svc.CalculateNetSalary(ctx, id, time.Time{})
+
+		assert.Error(t, err)
+		assert.Nil(t, salary)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func TestSalaryService_NewNetSalaryCalculator(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("resolves each country's rule and rate once", func(t *testing.T) {
+		mockTaxRules := seededTaxRules()
+		mockRates := new(MockExchangeRateRepository)
+		svc := NewService(new(MockEmployeeRepository), new(MockCompensationRepository), mockTaxRules, mockRates, new(MockDepartmentRepository), passthroughTransactor{})
+		mockRates.On("FindEffective", ctx, "USD", "INR", asOf).
+			Return(entity.NewExchangeRate("USD", "INR", decimal.RequireFromString("83.5"), asOf), nil).Once()
+
+		calculator := svc.NewNetSalaryCalculator(asOf)
+		for range 2 {
+			salary, err := calculator.NetSalary(ctx, "India", decimal.NewFromInt(12000), valueobject.CurrencyUSD)
+			assert.NoError(t, err)
+			assert.Equal(t, "959300", salary.NetSalary.String())
+			assert.Equal(t, valueobject.CurrencyINR, salary.Currency)
+		}
+		salary, err := calculator.NetSalary(ctx, "India", decimal.NewFromInt(1000000), valueobject.CurrencyINR)
+		assert.NoError(t, err)
+		assert.Equal(t, "957500", salary.NetSalary.String())
+
+		mockTaxRules.AssertNumberOfCalls(t, "FindEffective", 1)
+		mockRates.AssertExpectations(t)
+	})
+
+	t.Run("no tax rule for the country", func(t *testing.T) {
+		svc := NewService(new(MockEmployeeRepository), new(MockCompensationRepository), seededTaxRules(), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+		salary, err := svc.NewNetSalaryCalculator(asOf).NetSalary(ctx, "Germany", decimal.NewFromInt(80000), "EUR")
+
+		assert.Nil(t, salary)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+// activeOnly is the status filter statistics apply when none is given.
+var activeOnly = []entity.EmploymentStatus{entity.EmploymentStatusActive}
+
+func TestSalaryService_GetSalaryStatsByCountry(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	inr := valueobject.SalaryTotals{
+		Currency:  valueobject.CurrencyINR,
+		MinSalary: decimal.NewFromInt(800000),
+		MaxSalary: decimal.NewFromInt(1600000),
+		SumSalary: decimal.NewFromInt(2400000),
+		Count:     2,
+	}
+	usd := valueobject.SalaryTotals{
+		Currency:  valueobject.CurrencyUSD,
+		MinSalary: decimal.NewFromInt(10000),
+		MaxSalary: decimal.NewFromInt(30000),
+		SumSalary: decimal.NewFromInt(40000),
+		Count:     2,
+	}
+
+	t.Run("single currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, repository.EmployeeFilter{Country: "India", Statuses: activeOnly}).Return([]valueobject.SalaryTotals{inr}, nil)
+
+		stats, err := svc.GetSalaryStatsByCountry(ctx, "India", nil, Reporting{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.CurrencyINR, stats.Currency)
+		assert.Equal(t, "1200000", stats.AvgSalary.String())
+		assert.Equal(t, int64(2), stats.Count)
+	})
+
+	t.Run("mixed currencies need a reporting currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, repository.EmployeeFilter{Country: "India", Statuses: activeOnly}).Return([]valueobject.SalaryTotals{inr, usd}, nil)
+
+		stats, err := svc.GetSalaryStatsByCountry(ctx, "India", nil, Reporting{})
+
+		assert.Nil(t, stats)
+		assert.True(t, errors.IsValidationError(err))
+		assert.Contains(t, err.Error(), "INR, USD")
+	})
+
+	t.Run("converts with the rate in force at as_of", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockRates := new(MockExchangeRateRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), mockRates, new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, repository.EmployeeFilter{Country: "India", Statuses: activeOnly}).Return([]valueobject.SalaryTotals{inr, usd}, nil)
+		mockRates.On("FindEffective", ctx, "INR", "USD", asOf).
+			Return(entity.NewExchangeRate("INR", "USD", decimal.RequireFromString("0.0125"), asOf), nil)
+
+		stats, err := svc.GetSalaryStatsByCountry(ctx, "India", nil, Reporting{Currency: "usd", AsOf: asOf})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.CurrencyUSD, stats.Currency)
+		assert.Equal(t, "10000", stats.MinSalary.String())
+		assert.Equal(t, "30000", stats.MaxSalary.String())
+		assert.Equal(t, "17500", stats.AvgSalary.String())
+		assert.Equal(t, int64(4), stats.Count)
+		mockRates.AssertExpectations(t)
+	})
+
+	t.Run("falls back to the inverse rate", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockRates := new(MockExchangeRateRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), mockRates, new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, repository.EmployeeFilter{Country: "India", Statuses: activeOnly}).Return([]valueobject.SalaryTotals{inr, usd}, nil)
+		mockRates.On("FindEffective", ctx, "INR", "USD", asOf).Return(nil, errors.NewNotFoundError("exchange rate"))
+		mockRates.On("FindEffective", ctx, "USD", "INR", asOf).
+			Return(entity.NewExchangeRate("USD", "INR", decimal.NewFromInt(80), asOf), nil)
+
+		stats, err := svc.GetSalaryStatsByCountry(ctx, "India", nil, Reporting{Currency: "USD", AsOf: asOf})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "17500", stats.AvgSalary.String())
+	})
+
+	t.Run("missing rate", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockRates := new(MockExchangeRateRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), mockRates, new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, repository.EmployeeFilter{Country: "India", Statuses: activeOnly}).Return([]valueobject.SalaryTotals{inr, usd}, nil)
+		mockRates.On("FindEffective", ctx, mock.Anything, mock.Anything, asOf).Return(nil, errors.NewNotFoundError("exchange rate"))
+
+		stats, err := svc.GetSalaryStatsByCountry(ctx, "India", nil, Reporting{Currency: "USD", AsOf: asOf})
+
+		assert.Nil(t, stats)
+		assert.True(t, errors.IsValidationError(err))
+		assert.Contains(t, err.Error(), "2025-03-01")
+	})
+
+	t.Run("invalid reporting currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, repository.EmployeeFilter{Country: "India", Statuses: activeOnly}).Return([]valueobject.SalaryTotals{inr}, nil)
+
+		_, err := svc.GetSalaryStatsByCountry(ctx, "India", nil, Reporting{Currency: "rupees"})
+
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("scoped to a manager's reports", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		managerID := uuid.New()
+		mockRepo.On("GetSalaryTotals", ctx, repository.EmployeeFilter{Country: "India", ReportsTo: &managerID, Statuses: activeOnly}).Return([]valueobject.SalaryTotals{inr}, nil)
+
+		stats, err := svc.GetSalaryStatsByCountry(ctx, "India", &managerID, Reporting{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), stats.Count)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("no employees", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, repository.EmployeeFilter{Country: "India", Statuses: activeOnly}).Return([]valueobject.SalaryTotals{}, nil)
+
+		stats, err := svc.GetSalaryStatsByCountry(ctx, "India", nil, Reporting{})
+
+		assert.Nil(t, stats)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func TestSalaryService_GetSalaryDistribution(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	filter := repository.EmployeeFilter{JobTitle: "Engineer"}
+	active := repository.EmployeeFilter{JobTitle: "Engineer", Statuses: activeOnly}
+	inr := valueobject.SalaryTotals{Currency: valueobject.CurrencyINR, Count: 3}
+	usd := valueobject.SalaryTotals{Currency: valueobject.CurrencyUSD, Count: 2}
+
+	t.Run("single currency with default buckets", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		rates := map[valueobject.Currency]decimal.Decimal{valueobject.CurrencyINR: decimal.NewFromInt(1)}
+		mockRepo.On("GetSalaryTotals", ctx, active).Return([]valueobject.SalaryTotals{inr}, nil)
+		mockRepo.On("GetSalaryDistribution", ctx, active, rates, DefaultHistogramBuckets).
+			Return(&valueobject.SalaryDistribution{Count: 3, MedianSalary: decimal.NewFromInt(900000)}, nil)
+
+		distribution, err := svc.GetSalaryDistribution(ctx, DistributionParams{Filter: filter})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.CurrencyINR, distribution.Currency)
+		assert.Equal(t, "900000", distribution.MedianSalary.String())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("converts every currency to the reporting currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockRates := new(MockExchangeRateRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), mockRates, new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, active).Return([]valueobject.SalaryTotals{inr, usd}, nil)
+		mockRates.On("FindEffective", ctx, "INR", "USD", asOf).
+			Return(entity.NewExchangeRate("INR", "USD", decimal.RequireFromString("0.0125"), asOf), nil)
+		mockRepo.On("GetSalaryDistribution", ctx, active, mock.MatchedBy(func(rates map[valueobject.Currency]decimal.Decimal) bool {
+			return len(rates) == 2 &&
+				rates[valueobject.CurrencyINR].Equal(decimal.RequireFromString("0.0125")) &&
+				rates[valueobject.CurrencyUSD].Equal(decimal.NewFromInt(1))
+		}), 20).Return(&valueobject.SalaryDistribution{Count: 5}, nil)
+
+		distribution, err := svc.GetSalaryDistribution(ctx, DistributionParams{
+			Filter:    filter,
+			Reporting: Reporting{Currency: "USD", AsOf: asOf},
+			Buckets:   20,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.CurrencyUSD, distribution.Currency)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("counts the requested statuses", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		statuses := repository.EmployeeFilter{
+			JobTitle: "Engineer",
+			Statuses: []entity.EmploymentStatus{entity.EmploymentStatusActive, entity.EmploymentStatusOnLeave},
+		}
+		mockRepo.On("GetSalaryTotals", ctx, statuses).Return([]valueobject.SalaryTotals{inr}, nil)
+		mockRepo.On("GetSalaryDistribution", ctx, statuses, mock.Anything, DefaultHistogramBuckets).
+			Return(&valueobject.SalaryDistribution{Count: 3}, nil)
+
+		_, err := svc.GetSalaryDistribution(ctx, DistributionParams{Filter: statuses})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("mixed currencies need a reporting currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, active).Return([]valueobject.SalaryTotals{inr, usd}, nil)
+
+		_, err := svc.GetSalaryDistribution(ctx, DistributionParams{Filter: filter})
+
+		assert.True(t, errors.IsValidationError(err))
+		mockRepo.AssertNotCalled(t, "GetSalaryDistribution", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("no matching employees", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, active).Return([]valueobject.SalaryTotals{}, nil)
+
+		_, err := svc.GetSalaryDistribution(ctx, DistributionParams{Filter: filter})
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("rejects invalid parameters", func(t *testing.T) {
+		minSalary, maxSalary := decimal.NewFromInt(200), decimal.NewFromInt(100)
+		cases := map[string]DistributionParams{
+			"too many buckets":       {Buckets: MaxHistogramBuckets + 1},
+			"negative buckets":       {Buckets: -1},
+			"inverted salary range":  {Filter: repository.EmployeeFilter{MinSalary: &minSalary, MaxSalary: &maxSalary}},
+			"inverted created range": {Filter: repository.EmployeeFilter{CreatedAfter: &asOf, CreatedBefore: &asOf}},
+			"unknown status":         {Filter: repository.EmployeeFilter{Statuses: []entity.EmploymentStatus{"retired"}}},
+		}
+		for name, params := range cases {
+			t.Run(name, func(t *testing.T) {
+				mockRepo := new(MockEmployeeRepository)
+				svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+				_, err := svc.GetSalaryDistribution(ctx, params)
+
+				assert.True(t, errors.IsValidationError(err))
+				mockRepo.AssertNotCalled(t, "GetSalaryTotals", mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
+
+func TestSalaryService_AggregateSalaries(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	filter := repository.EmployeeFilter{Country: "India"}
+	active := repository.EmployeeFilter{Country: "India", Statuses: activeOnly}
+	inr := valueobject.SalaryTotals{Currency: valueobject.CurrencyINR, Count: 3}
+	usd := valueobject.SalaryTotals{Currency: valueobject.CurrencyUSD, Count: 2}
+	byTitle := []repository.SalaryDimension{repository.DimensionJobTitle, repository.DimensionHireYear}
+
+	t.Run("default metrics in the employees' currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, active).Return([]valueobject.SalaryTotals{inr}, nil)
+		mockRepo.On("AggregateSalaries", ctx, repository.SalaryAggregation{
+			Filter:     active,
+			Dimensions: byTitle,
+			Metrics:    DefaultAggregateMetrics,
+			Rates:      map[valueobject.Currency]decimal.Decimal{valueobject.CurrencyINR: decimal.NewFromInt(1)},
+			Limit:      MaxAggregateGroups + 1,
+		}).Return([]repository.SalaryGroup{
+			{Keys: []string{"Engineer", "2024"}, Values: []decimal.Decimal{decimal.NewFromInt(3)}},
+		}, nil)
+
+		aggregate, err := svc.AggregateSalaries(ctx, AggregateParams{Filter: filter, Dimensions: byTitle})
+
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultAggregateMetrics, aggregate.Metrics)
+		if assert.Len(t, aggregate.Groups, 1) {
+			assert.Equal(t, valueobject.CurrencyINR, aggregate.Groups[0].Currency)
+		}
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("grouping by currency needs no conversion", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockRates := new(MockExchangeRateRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), mockRates, new(MockDepartmentRepository), passthroughTransactor{})
+		dimensions := []repository.SalaryDimension{repository.DimensionCountry, repository.DimensionCurrency}
+		mockRepo.On("GetSalaryTotals", ctx, active).Return([]valueobject.SalaryTotals{inr, usd}, nil)
+		mockRepo.On("AggregateSalaries", ctx, mock.MatchedBy(func(a repository.SalaryAggregation) bool {
+			return a.Rates[valueobject.CurrencyINR].Equal(decimal.NewFromInt(1)) && a.Rates[valueobject.CurrencyUSD].Equal(decimal.NewFromInt(1))
+		})).Return([]repository.SalaryGroup{
+			{Keys: []string{"India", "INR"}},
+			{Keys: []string{"India", "USD"}},
+		}, nil)
+
+		aggregate, err := svc.AggregateSalaries(ctx, AggregateParams{Filter: filter, Dimensions: dimensions})
+
+		assert.NoError(t, err)
+		if assert.Len(t, aggregate.Groups, 2) {
+			assert.Equal(t, valueobject.CurrencyINR, aggregate.Groups[0].Currency)
+			assert.Equal(t, valueobject.CurrencyUSD, aggregate.Groups[1].Currency)
+		}
+		mockRates.AssertNotCalled(t, "FindEffective", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("converts to the reporting currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockRates := new(MockExchangeRateRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), mockRates, new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, active).Return([]valueobject.SalaryTotals{inr, usd}, nil)
+		mockRates.On("FindEffective", ctx, "INR", "USD", asOf).
+			Return(entity.NewExchangeRate("INR", "USD", decimal.RequireFromString("0.0125"), asOf), nil)
+		mockRepo.On("AggregateSalaries", ctx, mock.MatchedBy(func(a repository.SalaryAggregation) bool {
+			return a.Rates[valueobject.CurrencyINR].Equal(decimal.RequireFromString("0.0125"))
+		})).Return([]repository.SalaryGroup{{Keys: []string{"Engineer", "2024"}}}, nil)
+
+		aggregate, err := svc.AggregateSalaries(ctx, AggregateParams{
+			Filter:     filter,
+			Dimensions: byTitle,
+			Metrics:    []repository.SalaryMetric{repository.MetricMedian},
+			Reporting:  Reporting{Currency: "USD", AsOf: asOf},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.CurrencyUSD, aggregate.Groups[0].Currency)
+	})
+
+	t.Run("mixed currencies need a reporting currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, active).Return([]valueobject.SalaryTotals{inr, usd}, nil)
+
+		_, err := svc.AggregateSalaries(ctx, AggregateParams{Filter: filter, Dimensions: byTitle})
+
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("no matching employees", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, active).Return([]valueobject.SalaryTotals{}, nil)
+
+		aggregate, err := svc.AggregateSalaries(ctx, AggregateParams{Filter: filter, Dimensions: byTitle})
+
+		assert.NoError(t, err)
+		assert.Empty(t, aggregate.Groups)
+		mockRepo.AssertNotCalled(t, "AggregateSalaries", mock.Anything, mock.Anything)
+	})
+
+	t.Run("too many groups", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+		mockRepo.On("GetSalaryTotals", ctx, active).Return([]valueobject.SalaryTotals{inr}, nil)
+		mockRepo.On("AggregateSalaries", ctx, mock.Anything).Return(make([]repository.SalaryGroup, MaxAggregateGroups+1), nil)
+
+		_, err := svc.AggregateSalaries(ctx, AggregateParams{Filter: filter, Dimensions: byTitle})
+
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("rejects invalid parameters", func(t *testing.T) {
+		cases := map[string]AggregateParams{
+			"no dimensions":       {},
+			"unknown dimension":   {Dimensions: []repository.SalaryDimension{"team"}},
+			"repeated dimension":  {Dimensions: []repository.SalaryDimension{repository.DimensionCountry, repository.DimensionCountry}},
+			"unknown metric":      {Dimensions: byTitle, Metrics: []repository.SalaryMetric{"mode"}},
+			"repeated metric":     {Dimensions: byTitle, Metrics: []repository.SalaryMetric{repository.MetricAvg, repository.MetricAvg}},
+			"inverted time range": {Dimensions: byTitle, Filter: repository.EmployeeFilter{UpdatedAfter: &asOf, UpdatedBefore: &asOf}},
+		}
+		for name, params := range cases {
+			t.Run(name, func(t *testing.T) {
+				mockRepo := new(MockEmployeeRepository)
+				svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), new(MockDepartmentRepository), passthroughTransactor{})
+
+				_, err := svc.AggregateSalaries(ctx, params)
+
+				assert.True(t, errors.IsValidationError(err))
+				mockRepo.AssertNotCalled(t, "GetSalaryTotals", mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
+
+func TestSalaryService_GetDepartmentPayroll(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	engineering := entity.NewDepartment("Engineering", nil)
+	platform := entity.NewDepartment("Platform", &engineering.ID)
+	sales := entity.NewDepartment("Sales", nil)
+	departments := []*entity.Department{engineering, platform, sales}
+
+	inr := func(departmentID *uuid.UUID, count int64, sum int64) repository.DepartmentSalaryTotals {
+		return repository.DepartmentSalaryTotals{
+			DepartmentID: departmentID,
+			SalaryTotals: valueobject.SalaryTotals{Currency: valueobject.CurrencyINR, SumSalary: decimal.NewFromInt(sum), Count: count},
+		}
+	}
+	totals := []repository.DepartmentSalaryTotals{
+		inr(&engineering.ID, 1, 3000000),
+		inr(&platform.ID, 2, 4000000),
+		inr(nil, 1, 1000000),
+	}
+
+	t.Run("rolls costs up the hierarchy", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockDepartments := new(MockDepartmentRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), mockDepartments, passthroughTransactor{})
+		mockDepartments.On("List", ctx).Return(departments, nil)
+		mockRepo.On("GetDepartmentSalaryTotals", ctx, activeOnly).Return(totals, nil)
+
+		payroll, err := svc.GetDepartmentPayroll(ctx, PayrollParams{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.CurrencyINR, payroll.Currency)
+		if assert.Len(t, payroll.Departments, 4) {
+			root := payroll.Departments[0]
+			assert.Equal(t, engineering, root.Department)
+			assert.Equal(t, int64(1), root.Headcount)
+			assert.True(t, root.Cost.Equal(decimal.NewFromInt(3000000)))
+			assert.Equal(t, int64(3), root.TotalHeadcount)
+			assert.True(t, root.TotalCost.Equal(decimal.NewFromInt(7000000)))
+
+			assert.Equal(t, platform, payroll.Departments[1].Department)
+			assert.Equal(t, 1, payroll.Departments[1].Depth)
+			assert.True(t, payroll.Departments[1].TotalCost.Equal(decimal.NewFromInt(4000000)))
+
+			assert.Equal(t, sales, payroll.Departments[2].Department)
+			assert.Zero(t, payroll.Departments[2].TotalHeadcount)
+
+			assert.Nil(t, payroll.Departments[3].Department)
+			assert.Equal(t, int64(1), payroll.Departments[3].TotalHeadcount)
+		}
+	})
+
+	t.Run("limited to a subtree", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockDepartments := new(MockDepartmentRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), mockDepartments, passthroughTransactor{})
+		mockDepartments.On("List", ctx).Return(departments, nil)
+		mockRepo.On("GetDepartmentSalaryTotals", ctx, activeOnly).Return(totals, nil)
+
+		payroll, err := svc.GetDepartmentPayroll(ctx, PayrollParams{Root: &platform.ID})
+
+		assert.NoError(t, err)
+		if assert.Len(t, payroll.Departments, 1) {
+			assert.Equal(t, platform, payroll.Departments[0].Department)
+			assert.Equal(t, 0, payroll.Departments[0].Depth)
+			assert.Equal(t, int64(2), payroll.Departments[0].TotalHeadcount)
+		}
+	})
+
+	t.Run("subtree only needs rates for its own currencies", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockDepartments := new(MockDepartmentRepository)
+		mockRates := new(MockExchangeRateRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), mockRates, mockDepartments, passthroughTransactor{})
+		mockDepartments.On("List", ctx).Return(departments, nil)
+		mockRepo.On("GetDepartmentSalaryTotals", ctx, activeOnly).Return([]repository.DepartmentSalaryTotals{
+			inr(&platform.ID, 2, 4000000),
+			{DepartmentID: &sales.ID, SalaryTotals: valueobject.SalaryTotals{Currency: "EUR", SumSalary: decimal.NewFromInt(90000), Count: 1}},
+		}, nil)
+		mockRates.On("FindEffective", ctx, "INR", "USD", asOf).
+			Return(entity.NewExchangeRate("INR", "USD", decimal.RequireFromString("0.0125"), asOf), nil)
+
+		payroll, err := svc.GetDepartmentPayroll(ctx, PayrollParams{Root: &engineering.ID, Reporting: Reporting{Currency: "USD", AsOf: asOf}})
+
+		assert.NoError(t, err)
+		if assert.Len(t, payroll.Departments, 2) {
+			assert.True(t, payroll.Departments[0].TotalCost.Equal(decimal.NewFromInt(50000)))
+		}
+		mockRates.AssertNotCalled(t, "FindEffective", ctx, "EUR", "USD", asOf)
+	})
+
+	t.Run("converts to the reporting currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockDepartments := new(MockDepartmentRepository)
+		mockRates := new(MockExchangeRateRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), mockRates, mockDepartments, passthroughTransactor{})
+		mockDepartments.On("List", ctx).Return([]*entity.Department{engineering}, nil)
+		mockRepo.On("GetDepartmentSalaryTotals", ctx, activeOnly).Return([]repository.DepartmentSalaryTotals{
+			inr(&engineering.ID, 1, 3000000),
+			{DepartmentID: &engineering.ID, SalaryTotals: valueobject.SalaryTotals{Currency: valueobject.CurrencyUSD, SumSalary: decimal.NewFromInt(50000), Count: 1}},
+		}, nil)
+		mockRates.On("FindEffective", ctx, "INR", "USD", asOf).
+			Return(entity.NewExchangeRate("INR", "USD", decimal.RequireFromString("0.0125"), asOf), nil)
+
+		payroll, err := svc.GetDepartmentPayroll(ctx, PayrollParams{Reporting: Reporting{Currency: "USD", AsOf: asOf}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.CurrencyUSD, payroll.Currency)
+		if assert.Len(t, payroll.Departments, 1) {
+			assert.Equal(t, int64(2), payroll.Departments[0].Headcount)
+			assert.True(t, payroll.Departments[0].Cost.Equal(decimal.NewFromInt(87500)))
+		}
+	})
+
+	t.Run("mixed currencies need a reporting currency", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockDepartments := new(MockDepartmentRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), mockDepartments, passthroughTransactor{})
+		mockDepartments.On("List", ctx).Return(departments, nil)
+		mockRepo.On("GetDepartmentSalaryTotals", ctx, activeOnly).Return([]repository.DepartmentSalaryTotals{
+			inr(nil, 1, 1000000),
+			{SalaryTotals: valueobject.SalaryTotals{Currency: valueobject.CurrencyUSD, SumSalary: decimal.NewFromInt(50000), Count: 1}},
+		}, nil)
+
+		_, err := svc.GetDepartmentPayroll(ctx, PayrollParams{})
+
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("counts the requested statuses", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockDepartments := new(MockDepartmentRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), mockDepartments, passthroughTransactor{})
+		statuses := []entity.EmploymentStatus{entity.EmploymentStatusPending}
+		mockDepartments.On("List", ctx).Return(departments, nil)
+		mockRepo.On("GetDepartmentSalaryTotals", ctx, statuses).Return([]repository.DepartmentSalaryTotals{}, nil)
+
+		payroll, err := svc.GetDepartmentPayroll(ctx, PayrollParams{Statuses: statuses})
+
+		assert.NoError(t, err)
+		assert.Len(t, payroll.Departments, 3)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockDepartments := new(MockDepartmentRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), mockDepartments, passthroughTransactor{})
+
+		_, err := svc.GetDepartmentPayroll(ctx, PayrollParams{Statuses: []entity.EmploymentStatus{"retired"}})
+
+		assert.True(t, errors.IsValidationError(err))
+		mockDepartments.AssertNotCalled(t, "List", mock.Anything)
+	})
+
+	t.Run("unknown root", func(t *testing.T) {
+		mockRepo := new(MockEmployeeRepository)
+		mockDepartments := new(MockDepartmentRepository)
+		svc := NewService(mockRepo, new(MockCompensationRepository), new(MockTaxRuleRepository), new(MockExchangeRateRepository), mockDepartments, passthroughTransactor{})
+		mockDepartments.On("List", ctx).Return(departments, nil)
+		id := uuid.New()
+
+		_, err := svc.GetDepartmentPayroll(ctx, PayrollParams{Root: &id})
+
+		assert.True(t, errors.IsNotFoundError(err))
+		mockRepo.AssertNotCalled(t, "GetDepartmentSalaryTotals", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,113 @@
+package taxrule
+
+import (
+	"context"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/repository"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/validator"
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	Create(ctx context.Context, rule *entity.TaxRule) (*entity.TaxRule, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.TaxRule, error)
+	List(ctx context.Context, country string) ([]*entity.TaxRule, error)
+	Retire(ctx context.Context, id uuid.UUID, effectiveTo time.Time) (*entity.TaxRule, error)
+}
+
+type service struct {
+	repo repository.TaxRuleRepository
+	now  func() time.Time
+}
+
+func NewService(repo repository.TaxRuleRepository) Service {
+	return &service{repo: repo, now: time.Now}
+}
+
+// Create adds a rule that takes effect at rule.EffectiveFrom, closing the
+// country's current open-ended rule at that instant. Rules cannot start in
+// the past or overlap an existing rule, so historical calculations never
+// change.
+func (s *service) Create(ctx context.Context, rule *entity.TaxRule) (*entity.TaxRule, error) {
+	if err := s.validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Supersede(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (s *service) validateRule(rule *entity.TaxRule) error {
+	if err := validator.ValidateRequired(rule.Country, "country"); err != nil {
+		return err
+	}
+	if !rule.RuleType.IsValid() {
+		return errors.NewValidationError("rule_type must be flat or progressive")
+	}
+	if rule.RuleType == entity.TaxRuleTypeFlat && len(rule.Bands) > 0 {
+		return errors.NewValidationError("flat tax rules cannot have bands")
+	}
+	if rule.RuleType == entity.TaxRuleTypeProgressive && len(rule.Bands) == 0 {
+		return errors.NewValidationError("progressive tax rules require at least one band")
+	}
+	if rule.RuleType == entity.TaxRuleTypeProgressive && !rule.Rate.IsZero() {
+		return errors.NewValidationError("progressive tax rules use band rates, not rate")
+	}
+	if rule.EffectiveFrom.IsZero() {
+		return errors.NewValidationError("effective_from is required")
+	}
+	if rule.EffectiveFrom.Before(s.now()) {
+		return errors.NewValidationError("effective_from cannot be in the past")
+	}
+	return rule.Schedule().Validate()
+}
+
+func (s *service) GetByID(ctx context.Context, id uuid.UUID) (*entity.TaxRule, error) {
+	return s.repo.FindByID(ctx, id)
+}
+
+func (s *service) List(ctx context.Context, country string) ([]*entity.TaxRule, error) {
+	return s.repo.ListByCountry(ctx, country)
+}
+
+// Retire ends a rule at effectiveTo, or now when it is zero. A rule can only
+// be ended from now on, never retroactively.
+func (s *service) Retire(ctx context.Context, id uuid.UUID, effectiveTo time.Time) (*entity.TaxRule, error) {
+	now := s.now()
+	if effectiveTo.IsZero() {
+		effectiveTo = now
+	}
+	if effectiveTo.Before(now) {
+		return nil, errors.NewValidationError("effective_to cannot be in the past")
+	}
+
+	rule, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if effectiveTo.Before(rule.EffectiveFrom) {
+		return nil, errors.NewValidationError("effective_to cannot be before effective_from")
+	}
+	if rule.EffectiveTo != nil {
+		if !rule.EffectiveTo.After(now) {
+			return nil, errors.NewConflictError("tax rule is already retired")
+		}
+		if !effectiveTo.Before(*rule.EffectiveTo) {
+			return nil, errors.NewConflictError("tax rule already ends before effective_to")
+		}
+	}
+
+	rule.EffectiveTo = &effectiveTo
+	if err := s.repo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,172 @@
+package taxrule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/domain/entity"
+	"github.com/SRINIVAS-B-SINDAGI/employee-api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTaxRuleRepository struct {
+	mock.Mock
+}
+
+func (m *MockTaxRuleRepository) Supersede(ctx context.Context, rule *entity.TaxRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockTaxRuleRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.TaxRule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TaxRule), args.Error(1)
+}
+
+func (m *MockTaxRuleRepository) FindEffective(ctx context.Context, country string, asOf time.Time) (*entity.TaxRule, error) {
+	args := m.Called(ctx, country, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TaxRule), args.Error(1)
+}
+
+func (m *MockTaxRuleRepository) ListByCountry(ctx context.Context, country string) ([]*entity.TaxRule, error) {
+	args := m.Called(ctx, country)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TaxRule), args.Error(1)
+}
+
+func (m *MockTaxRuleRepository) Update(ctx context.Context, rule *entity.TaxRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+var now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestService(repo *MockTaxRuleRepository) *service {
+	return &service{repo: repo, now: func() time.Time { return now }}
+}
+
+func flatRule(country string, rate string, effectiveFrom time.Time) *entity.TaxRule {
+	return entity.NewTaxRule(country, entity.TaxRuleTypeFlat, decimal.RequireFromString(rate), nil,
+		decimal.Zero, decimal.NullDecimal{}, effectiveFrom)
+}
+
+func TestTaxRuleService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("supersedes the current rule", func(t *testing.T) {
+		mockRepo := new(MockTaxRuleRepository)
+		svc := newTestService(mockRepo)
+
+		rule := flatRule("India", "0.12", now.AddDate(0, 1, 0))
+
+		mockRepo.On("Supersede", ctx, rule).Return(nil)
+
+		created, err := svc.Create(ctx, rule)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rule, created)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("validation error - effective in the past", func(t *testing.T) {
+		mockRepo := new(MockTaxRuleRepository)
+		svc := newTestService(mockRepo)
+
+		rule := flatRule("India", "0.12", now.AddDate(0, 0, -1))
+
+		created, err := svc.Create(ctx, rule)
+
+		assert.Error(t, err)
+		assert.Nil(t, created)
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("validation error - progressive without bands", func(t *testing.T) {
+		mockRepo := new(MockTaxRuleRepository)
+		svc := newTestService(mockRepo)
+
+		rule := entity.NewTaxRule("India", entity.TaxRuleTypeProgressive, decimal.Zero, nil,
+			decimal.Zero, decimal.NullDecimal{}, now.AddDate(0, 1, 0))
+
+		created, err := svc.Create(ctx, rule)
+
+		assert.Error(t, err)
+		assert.Nil(t, created)
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("conflict - a later rule already exists", func(t *testing.T) {
+		mockRepo := new(MockTaxRuleRepository)
+		svc := newTestService(mockRepo)
+
+		rule := flatRule("India", "0.12", now.AddDate(0, 1, 0))
+
+		mockRepo.On("Supersede", ctx, rule).
+			Return(errors.NewConflictError("a tax rule for this country already takes effect on or after effective_from"))
+
+		created, err := svc.Create(ctx, rule)
+
+		assert.Error(t, err)
+		assert.Nil(t, created)
+		assert.Equal(t, 409, errors.GetStatusCode(err))
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestTaxRuleService_Retire(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retires now by default", func(t *testing.T) {
+		mockRepo := new(MockTaxRuleRepository)
+		svc := newTestService(mockRepo)
+
+		rule := flatRule("India", "0.10", now.AddDate(-1, 0, 0))
+		mockRepo.On("FindByID", ctx, rule.ID).Return(rule, nil)
+		mockRepo.On("Update", ctx, rule).Return(nil)
+
+		retired, err := svc.Retire(ctx, rule.ID, time.Time{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, now, *retired.EffectiveTo)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("validation error - retroactive", func(t *testing.T) {
+		mockRepo := new(MockTaxRuleRepository)
+		svc := newTestService(mockRepo)
+
+		retired, err := svc.Retire(ctx, uuid.New(), now.AddDate(0, 0, -1))
+
+		assert.Error(t, err)
+		assert.Nil(t, retired)
+		assert.True(t, errors.IsValidationError(err))
+	})
+
+	t.Run("conflict - already retired", func(t *testing.T) {
+		mockRepo := new(MockTaxRuleRepository)
+		svc := newTestService(mockRepo)
+
+		rule := flatRule("India", "0.10", now.AddDate(-2, 0, 0))
+		ended := now.AddDate(-1, 0, 0)
+		rule.EffectiveTo = &ended
+		mockRepo.On("FindByID", ctx, rule.ID).Return(rule, nil)
+
+		retired, err := svc.Retire(ctx, rule.ID, time.Time{})
+
+		assert.Error(t, err)
+		assert.Nil(t, retired)
+		assert.Equal(t, 409, errors.GetStatusCode(err))
+	})
+}
@@ -9,6 +9,7 @@ package employeev1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,14 +23,526 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EmploymentType int32
+
+const (
+	EmploymentType_EMPLOYMENT_TYPE_UNSPECIFIED EmploymentType = 0
+	EmploymentType_EMPLOYMENT_TYPE_FULL_TIME   EmploymentType = 1
+	EmploymentType_EMPLOYMENT_TYPE_PART_TIME   EmploymentType = 2
+	EmploymentType_EMPLOYMENT_TYPE_CONTRACTOR  EmploymentType = 3
+)
+
+// Enum value maps for EmploymentType.
+var (
+	EmploymentType_name = map[int32]string{
+		0: "EMPLOYMENT_TYPE_UNSPECIFIED",
+		1: "EMPLOYMENT_TYPE_FULL_TIME",
+		2: "EMPLOYMENT_TYPE_PART_TIME",
+		3: "EMPLOYMENT_TYPE_CONTRACTOR",
+	}
+	EmploymentType_value = map[string]int32{
+		"EMPLOYMENT_TYPE_UNSPECIFIED": 0,
+		"EMPLOYMENT_TYPE_FULL_TIME":   1,
+		"EMPLOYMENT_TYPE_PART_TIME":   2,
+		"EMPLOYMENT_TYPE_CONTRACTOR":  3,
+	}
+)
+
+func (x EmploymentType) Enum() *EmploymentType {
+	p := new(EmploymentType)
+	*p = x
+	return p
+}
+
+func (x EmploymentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmploymentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_employee_v1_employee_proto_enumTypes[0].Descriptor()
+}
+
+func (EmploymentType) Type() protoreflect.EnumType {
+	return &file_proto_employee_v1_employee_proto_enumTypes[0]
+}
+
+func (x EmploymentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmploymentType.Descriptor instead.
+func (EmploymentType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{0}
+}
+
+// EmploymentStatus is where an employee is in the employment lifecycle.
+// Pending employees become active, active ones may go on leave and back,
+// and active or on-leave ones are terminated. Terminated employees can be
+// rehired.
+type EmploymentStatus int32
+
+const (
+	EmploymentStatus_EMPLOYMENT_STATUS_UNSPECIFIED EmploymentStatus = 0
+	// EMPLOYMENT_STATUS_PENDING is hired but not yet started.
+	EmploymentStatus_EMPLOYMENT_STATUS_PENDING    EmploymentStatus = 1
+	EmploymentStatus_EMPLOYMENT_STATUS_ACTIVE     EmploymentStatus = 2
+	EmploymentStatus_EMPLOYMENT_STATUS_ON_LEAVE   EmploymentStatus = 3
+	EmploymentStatus_EMPLOYMENT_STATUS_TERMINATED EmploymentStatus = 4
+)
+
+// Enum value maps for EmploymentStatus.
+var (
+	EmploymentStatus_name = map[int32]string{
+		0: "EMPLOYMENT_STATUS_UNSPECIFIED",
+		1: "EMPLOYMENT_STATUS_PENDING",
+		2: "EMPLOYMENT_STATUS_ACTIVE",
+		3: "EMPLOYMENT_STATUS_ON_LEAVE",
+		4: "EMPLOYMENT_STATUS_TERMINATED",
+	}
+	EmploymentStatus_value = map[string]int32{
+		"EMPLOYMENT_STATUS_UNSPECIFIED": 0,
+		"EMPLOYMENT_STATUS_PENDING":     1,
+		"EMPLOYMENT_STATUS_ACTIVE":      2,
+		"EMPLOYMENT_STATUS_ON_LEAVE":    3,
+		"EMPLOYMENT_STATUS_TERMINATED":  4,
+	}
+)
+
+func (x EmploymentStatus) Enum() *EmploymentStatus {
+	p := new(EmploymentStatus)
+	*p = x
+	return p
+}
+
+func (x EmploymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmploymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_employee_v1_employee_proto_enumTypes[1].Descriptor()
+}
+
+func (EmploymentStatus) Type() protoreflect.EnumType {
+	return &file_proto_employee_v1_employee_proto_enumTypes[1]
+}
+
+func (x EmploymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmploymentStatus.Descriptor instead.
+func (EmploymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{1}
+}
+
+type TerminationReason int32
+
+const (
+	TerminationReason_TERMINATION_REASON_UNSPECIFIED  TerminationReason = 0
+	TerminationReason_TERMINATION_REASON_RESIGNATION  TerminationReason = 1
+	TerminationReason_TERMINATION_REASON_DISMISSAL    TerminationReason = 2
+	TerminationReason_TERMINATION_REASON_REDUNDANCY   TerminationReason = 3
+	TerminationReason_TERMINATION_REASON_RETIREMENT   TerminationReason = 4
+	TerminationReason_TERMINATION_REASON_CONTRACT_END TerminationReason = 5
+	TerminationReason_TERMINATION_REASON_OTHER        TerminationReason = 6
+)
+
+// Enum value maps for TerminationReason.
+var (
+	TerminationReason_name = map[int32]string{
+		0: "TERMINATION_REASON_UNSPECIFIED",
+		1: "TERMINATION_REASON_RESIGNATION",
+		2: "TERMINATION_REASON_DISMISSAL",
+		3: "TERMINATION_REASON_REDUNDANCY",
+		4: "TERMINATION_REASON_RETIREMENT",
+		5: "TERMINATION_REASON_CONTRACT_END",
+		6: "TERMINATION_REASON_OTHER",
+	}
+	TerminationReason_value = map[string]int32{
+		"TERMINATION_REASON_UNSPECIFIED":  0,
+		"TERMINATION_REASON_RESIGNATION":  1,
+		"TERMINATION_REASON_DISMISSAL":    2,
+		"TERMINATION_REASON_REDUNDANCY":   3,
+		"TERMINATION_REASON_RETIREMENT":   4,
+		"TERMINATION_REASON_CONTRACT_END": 5,
+		"TERMINATION_REASON_OTHER":        6,
+	}
+)
+
+func (x TerminationReason) Enum() *TerminationReason {
+	p := new(TerminationReason)
+	*p = x
+	return p
+}
+
+func (x TerminationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TerminationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_employee_v1_employee_proto_enumTypes[2].Descriptor()
+}
+
+func (TerminationReason) Type() protoreflect.EnumType {
+	return &file_proto_employee_v1_employee_proto_enumTypes[2]
+}
+
+func (x TerminationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TerminationReason.Descriptor instead.
+func (TerminationReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{2}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0
+	SortOrder_SORT_ORDER_ASC         SortOrder = 1
+	SortOrder_SORT_ORDER_DESC        SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_ASC",
+		2: "SORT_ORDER_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED": 0,
+		"SORT_ORDER_ASC":         1,
+		"SORT_ORDER_DESC":        2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_employee_v1_employee_proto_enumTypes[3].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_proto_employee_v1_employee_proto_enumTypes[3]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{3}
+}
+
+type ChangeAction int32
+
+const (
+	ChangeAction_CHANGE_ACTION_UNSPECIFIED ChangeAction = 0
+	ChangeAction_CHANGE_ACTION_CREATED     ChangeAction = 1
+	ChangeAction_CHANGE_ACTION_UPDATED     ChangeAction = 2
+	ChangeAction_CHANGE_ACTION_DELETED     ChangeAction = 3
+	ChangeAction_CHANGE_ACTION_RESTORED    ChangeAction = 4
+	// CHANGE_ACTION_PURGED records the permanent removal of a deleted
+	// employee; it has no field changes.
+	ChangeAction_CHANGE_ACTION_PURGED ChangeAction = 5
+)
+
+// Enum value maps for ChangeAction.
+var (
+	ChangeAction_name = map[int32]string{
+		0: "CHANGE_ACTION_UNSPECIFIED",
+		1: "CHANGE_ACTION_CREATED",
+		2: "CHANGE_ACTION_UPDATED",
+		3: "CHANGE_ACTION_DELETED",
+		4: "CHANGE_ACTION_RESTORED",
+		5: "CHANGE_ACTION_PURGED",
+	}
+	ChangeAction_value = map[string]int32{
+		"CHANGE_ACTION_UNSPECIFIED": 0,
+		"CHANGE_ACTION_CREATED":     1,
+		"CHANGE_ACTION_UPDATED":     2,
+		"CHANGE_ACTION_DELETED":     3,
+		"CHANGE_ACTION_RESTORED":    4,
+		"CHANGE_ACTION_PURGED":      5,
+	}
+)
+
+func (x ChangeAction) Enum() *ChangeAction {
+	p := new(ChangeAction)
+	*p = x
+	return p
+}
+
+func (x ChangeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_employee_v1_employee_proto_enumTypes[4].Descriptor()
+}
+
+func (ChangeAction) Type() protoreflect.EnumType {
+	return &file_proto_employee_v1_employee_proto_enumTypes[4]
+}
+
+func (x ChangeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeAction.Descriptor instead.
+func (ChangeAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{4}
+}
+
+type EmployeeEventType int32
+
+const (
+	EmployeeEventType_EMPLOYEE_EVENT_TYPE_UNSPECIFIED EmployeeEventType = 0
+	// EMPLOYEE_EVENT_TYPE_CREATED is also sent when a deleted employee is
+	// restored.
+	EmployeeEventType_EMPLOYEE_EVENT_TYPE_CREATED EmployeeEventType = 1
+	EmployeeEventType_EMPLOYEE_EVENT_TYPE_UPDATED EmployeeEventType = 2
+	EmployeeEventType_EMPLOYEE_EVENT_TYPE_DELETED EmployeeEventType = 3
+)
+
+// Enum value maps for EmployeeEventType.
+var (
+	EmployeeEventType_name = map[int32]string{
+		0: "EMPLOYEE_EVENT_TYPE_UNSPECIFIED",
+		1: "EMPLOYEE_EVENT_TYPE_CREATED",
+		2: "EMPLOYEE_EVENT_TYPE_UPDATED",
+		3: "EMPLOYEE_EVENT_TYPE_DELETED",
+	}
+	EmployeeEventType_value = map[string]int32{
+		"EMPLOYEE_EVENT_TYPE_UNSPECIFIED": 0,
+		"EMPLOYEE_EVENT_TYPE_CREATED":     1,
+		"EMPLOYEE_EVENT_TYPE_UPDATED":     2,
+		"EMPLOYEE_EVENT_TYPE_DELETED":     3,
+	}
+)
+
+func (x EmployeeEventType) Enum() *EmployeeEventType {
+	p := new(EmployeeEventType)
+	*p = x
+	return p
+}
+
+func (x EmployeeEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmployeeEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_employee_v1_employee_proto_enumTypes[5].Descriptor()
+}
+
+func (EmployeeEventType) Type() protoreflect.EnumType {
+	return &file_proto_employee_v1_employee_proto_enumTypes[5]
+}
+
+func (x EmployeeEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmployeeEventType.Descriptor instead.
+func (EmployeeEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{5}
+}
+
+type CompensationReason int32
+
+const (
+	CompensationReason_COMPENSATION_REASON_UNSPECIFIED CompensationReason = 0
+	// COMPENSATION_REASON_HIRE is the starting salary; it cannot be scheduled.
+	CompensationReason_COMPENSATION_REASON_HIRE       CompensationReason = 1
+	CompensationReason_COMPENSATION_REASON_PROMOTION  CompensationReason = 2
+	CompensationReason_COMPENSATION_REASON_MERIT      CompensationReason = 3
+	CompensationReason_COMPENSATION_REASON_ADJUSTMENT CompensationReason = 4
+)
+
+// Enum value maps for CompensationReason.
+var (
+	CompensationReason_name = map[int32]string{
+		0: "COMPENSATION_REASON_UNSPECIFIED",
+		1: "COMPENSATION_REASON_HIRE",
+		2: "COMPENSATION_REASON_PROMOTION",
+		3: "COMPENSATION_REASON_MERIT",
+		4: "COMPENSATION_REASON_ADJUSTMENT",
+	}
+	CompensationReason_value = map[string]int32{
+		"COMPENSATION_REASON_UNSPECIFIED": 0,
+		"COMPENSATION_REASON_HIRE":        1,
+		"COMPENSATION_REASON_PROMOTION":   2,
+		"COMPENSATION_REASON_MERIT":       3,
+		"COMPENSATION_REASON_ADJUSTMENT":  4,
+	}
+)
+
+func (x CompensationReason) Enum() *CompensationReason {
+	p := new(CompensationReason)
+	*p = x
+	return p
+}
+
+func (x CompensationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompensationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_employee_v1_employee_proto_enumTypes[6].Descriptor()
+}
+
+func (CompensationReason) Type() protoreflect.EnumType {
+	return &file_proto_employee_v1_employee_proto_enumTypes[6]
+}
+
+func (x CompensationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompensationReason.Descriptor instead.
+func (CompensationReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{6}
+}
+
+type ExportFormat int32
+
+const (
+	// EXPORT_FORMAT_UNSPECIFIED exports CSV.
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	// EXPORT_FORMAT_JSONL writes one JSON object per line.
+	ExportFormat_EXPORT_FORMAT_JSONL   ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_PARQUET ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_JSONL",
+		3: "EXPORT_FORMAT_PARQUET",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_JSONL":       2,
+		"EXPORT_FORMAT_PARQUET":     3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_employee_v1_employee_proto_enumTypes[7].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_proto_employee_v1_employee_proto_enumTypes[7]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{7}
+}
+
+type OrgChartFormat int32
+
+const (
+	// ORG_CHART_FORMAT_UNSPECIFIED exports Graphviz DOT.
+	OrgChartFormat_ORG_CHART_FORMAT_UNSPECIFIED OrgChartFormat = 0
+	OrgChartFormat_ORG_CHART_FORMAT_DOT         OrgChartFormat = 1
+	// ORG_CHART_FORMAT_JSON nests each employee's direct reports under it.
+	OrgChartFormat_ORG_CHART_FORMAT_JSON OrgChartFormat = 2
+)
+
+// Enum value maps for OrgChartFormat.
+var (
+	OrgChartFormat_name = map[int32]string{
+		0: "ORG_CHART_FORMAT_UNSPECIFIED",
+		1: "ORG_CHART_FORMAT_DOT",
+		2: "ORG_CHART_FORMAT_JSON",
+	}
+	OrgChartFormat_value = map[string]int32{
+		"ORG_CHART_FORMAT_UNSPECIFIED": 0,
+		"ORG_CHART_FORMAT_DOT":         1,
+		"ORG_CHART_FORMAT_JSON":        2,
+	}
+)
+
+func (x OrgChartFormat) Enum() *OrgChartFormat {
+	p := new(OrgChartFormat)
+	*p = x
+	return p
+}
+
+func (x OrgChartFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrgChartFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_employee_v1_employee_proto_enumTypes[8].Descriptor()
+}
+
+func (OrgChartFormat) Type() protoreflect.EnumType {
+	return &file_proto_employee_v1_employee_proto_enumTypes[8]
+}
+
+func (x OrgChartFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrgChartFormat.Descriptor instead.
+func (OrgChartFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{8}
+}
+
 type CreateEmployeeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullName      string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	JobTitle      string                 `protobuf:"bytes,2,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
-	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
-	GrossSalary   string                 `protobuf:"bytes,4,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FullName    string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	JobTitle    string                 `protobuf:"bytes,2,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	Country     string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	GrossSalary string                 `protobuf:"bytes,4,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
+	// currency is the ISO 4217 code gross_salary is paid in. It defaults to the
+	// country's currency where there is one (INR for India, USD for the United
+	// States) and is required otherwise.
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// manager_id is the employee the new employee reports to, if any.
+	ManagerId string `protobuf:"bytes,6,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	// department_id and cost_center_id place the employee in a department and
+	// charge its payroll to a cost center, if set.
+	DepartmentId string `protobuf:"bytes,7,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	CostCenterId string `protobuf:"bytes,8,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	// hire_date is the UTC date of the first working day; defaults to today.
+	// An employee hired for a later date is pending until activated.
+	HireDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=hire_date,json=hireDate,proto3" json:"hire_date,omitempty"`
+	// employment_type defaults to full time.
+	EmploymentType EmploymentType `protobuf:"varint,10,opt,name=employment_type,json=employmentType,proto3,enum=employee.v1.EmploymentType" json:"employment_type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateEmployeeRequest) Reset() {
@@ -90,17 +603,81 @@ func (x *CreateEmployeeRequest) GetGrossSalary() string {
 	return ""
 }
 
+func (x *CreateEmployeeRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetHireDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HireDate
+	}
+	return nil
+}
+
+func (x *CreateEmployeeRequest) GetEmploymentType() EmploymentType {
+	if x != nil {
+		return x.EmploymentType
+	}
+	return EmploymentType_EMPLOYMENT_TYPE_UNSPECIFIED
+}
+
 type Employee struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	JobTitle      string                 `protobuf:"bytes,3,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
-	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
-	GrossSalary   string                 `protobuf:"bytes,5,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName    string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	JobTitle    string                 `protobuf:"bytes,3,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	Country     string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	GrossSalary string                 `protobuf:"bytes,5,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// version increases with every update. Send it back in
+	// UpdateEmployeeRequest to update this revision of the employee.
+	Version int64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at is only set on deleted employees.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// currency is the ISO 4217 code gross_salary is paid in.
+	Currency string `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	// manager_id is the employee this one reports to, empty at the top of the
+	// organisation.
+	ManagerId string `protobuf:"bytes,11,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	// department_id and cost_center_id are empty when the employee has none.
+	DepartmentId string `protobuf:"bytes,12,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	CostCenterId string `protobuf:"bytes,13,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	// hire_date is the UTC date of the first working day of the current
+	// employment.
+	HireDate       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=hire_date,json=hireDate,proto3" json:"hire_date,omitempty"`
+	EmploymentType EmploymentType         `protobuf:"varint,15,opt,name=employment_type,json=employmentType,proto3,enum=employee.v1.EmploymentType" json:"employment_type,omitempty"`
+	Status         EmploymentStatus       `protobuf:"varint,16,opt,name=status,proto3,enum=employee.v1.EmploymentStatus" json:"status,omitempty"`
+	// termination_reason and last_working_day are only set on terminated
+	// employees.
+	TerminationReason TerminationReason      `protobuf:"varint,17,opt,name=termination_reason,json=terminationReason,proto3,enum=employee.v1.TerminationReason" json:"termination_reason,omitempty"`
+	LastWorkingDay    *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=last_working_day,json=lastWorkingDay,proto3" json:"last_working_day,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Employee) Reset() {
@@ -140,70 +717,2442 @@ func (x *Employee) GetId() string {
 	return ""
 }
 
-func (x *Employee) GetFullName() string {
+func (x *Employee) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Employee) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *Employee) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Employee) GetGrossSalary() string {
+	if x != nil {
+		return x.GrossSalary
+	}
+	return ""
+}
+
+func (x *Employee) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Employee) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Employee) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Employee) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Employee) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Employee) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *Employee) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *Employee) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *Employee) GetHireDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HireDate
+	}
+	return nil
+}
+
+func (x *Employee) GetEmploymentType() EmploymentType {
+	if x != nil {
+		return x.EmploymentType
+	}
+	return EmploymentType_EMPLOYMENT_TYPE_UNSPECIFIED
+}
+
+func (x *Employee) GetStatus() EmploymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EmploymentStatus_EMPLOYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Employee) GetTerminationReason() TerminationReason {
+	if x != nil {
+		return x.TerminationReason
+	}
+	return TerminationReason_TERMINATION_REASON_UNSPECIFIED
+}
+
+func (x *Employee) GetLastWorkingDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastWorkingDay
+	}
+	return nil
+}
+
+type GetEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeRequest) Reset() {
+	*x = GetEmployeeRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeRequest) ProtoMessage() {}
+
+func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	JobTitle      string                 `protobuf:"bytes,4,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	MinSalary     string                 `protobuf:"bytes,5,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary     string                 `protobuf:"bytes,6,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	UpdatedAfter  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	// sort_by is one of: created_at, updated_at, job_title, country, gross_salary.
+	SortBy    string    `protobuf:"bytes,11,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder SortOrder `protobuf:"varint,12,opt,name=sort_order,json=sortOrder,proto3,enum=employee.v1.SortOrder" json:"sort_order,omitempty"`
+	// page_token is the opaque next_page_token of a previous response. When set,
+	// keyset pagination is used and page is ignored.
+	PageToken string `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// manager_id keeps the direct reports of a manager; reports_to keeps
+	// everyone below a manager, directly or indirectly.
+	ManagerId string `protobuf:"bytes,14,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	ReportsTo string `protobuf:"bytes,15,opt,name=reports_to,json=reportsTo,proto3" json:"reports_to,omitempty"`
+	// department_id keeps the employees of a department and its
+	// sub-departments.
+	DepartmentId string `protobuf:"bytes,16,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	CostCenterId string `protobuf:"bytes,17,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	// statuses keeps the employees with any of these statuses; all employees
+	// are listed when it is empty.
+	Statuses      []EmploymentStatus `protobuf:"varint,18,rep,packed,name=statuses,proto3,enum=employee.v1.EmploymentStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeesRequest) Reset() {
+	*x = ListEmployeesRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesRequest) ProtoMessage() {}
+
+func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ListEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListEmployeesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEmployeesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEmployeesRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetMinSalary() string {
+	if x != nil {
+		return x.MinSalary
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetMaxSalary() string {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListEmployeesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListEmployeesRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *ListEmployeesRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *ListEmployeesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *ListEmployeesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetReportsTo() string {
+	if x != nil {
+		return x.ReportsTo
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetStatuses() []EmploymentStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeesResponse) Reset() {
+	*x = ListEmployeesResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesResponse) ProtoMessage() {}
+
+func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListEmployeesResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+func (x *ListEmployeesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListEmployeesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEmployeesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEmployeesResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListEmployeesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is matched against full_name and job_title: every word as a prefix
+	// of a word in either, or the whole query by trigram similarity.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// limit is the maximum number of results, 20 by default and at most 100.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchEmployeesRequest) Reset() {
+	*x = SearchEmployeesRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEmployeesRequest) ProtoMessage() {}
+
+func (x *SearchEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*SearchEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchEmployeesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchEmployeesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// EmployeeSearchResult is an employee found by a search. The highlights are
+// full_name and job_title with the words matching the query enclosed in
+// <mark> and </mark>; words matched only by similarity are not marked.
+type EmployeeSearchResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Employee *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// rank orders the results; higher is more relevant.
+	Rank              float64 `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	FullNameHighlight string  `protobuf:"bytes,3,opt,name=full_name_highlight,json=fullNameHighlight,proto3" json:"full_name_highlight,omitempty"`
+	JobTitleHighlight string  `protobuf:"bytes,4,opt,name=job_title_highlight,json=jobTitleHighlight,proto3" json:"job_title_highlight,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EmployeeSearchResult) Reset() {
+	*x = EmployeeSearchResult{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeSearchResult) ProtoMessage() {}
+
+func (x *EmployeeSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeSearchResult.ProtoReflect.Descriptor instead.
+func (*EmployeeSearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EmployeeSearchResult) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *EmployeeSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *EmployeeSearchResult) GetFullNameHighlight() string {
+	if x != nil {
+		return x.FullNameHighlight
+	}
+	return ""
+}
+
+func (x *EmployeeSearchResult) GetJobTitleHighlight() string {
+	if x != nil {
+		return x.JobTitleHighlight
+	}
+	return ""
+}
+
+type SearchEmployeesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*EmployeeSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchEmployeesResponse) Reset() {
+	*x = SearchEmployeesResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEmployeesResponse) ProtoMessage() {}
+
+func (x *SearchEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*SearchEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchEmployeesResponse) GetResults() []*EmployeeSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type UpdateEmployeeRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName    string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	JobTitle    string                 `protobuf:"bytes,3,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	Country     string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	GrossSalary string                 `protobuf:"bytes,5,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
+	// version is the version of the employee being updated, as last read. The
+	// update fails with ABORTED if the employee has changed since.
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// update_mask names the fields to change: full_name, job_title, country,
+	// gross_salary, currency, manager_id, department_id, cost_center_id,
+	// hire_date, employment_type and status. Other fields may be left empty.
+	// An unset mask, or "*", replaces full_name, job_title, country,
+	// gross_salary and currency; the other fields are only changed when named.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// currency defaults to the currency of country, as in CreateEmployeeRequest.
+	Currency string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	// manager_id is the new manager, or empty to leave the employee without
+	// one. It cannot be the employee or anyone below it.
+	ManagerId string `protobuf:"bytes,9,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	// department_id and cost_center_id are the new department and cost
+	// center, or empty to leave the employee without one.
+	DepartmentId   string                 `protobuf:"bytes,10,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	CostCenterId   string                 `protobuf:"bytes,11,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	HireDate       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=hire_date,json=hireDate,proto3" json:"hire_date,omitempty"`
+	EmploymentType EmploymentType         `protobuf:"varint,13,opt,name=employment_type,json=employmentType,proto3,enum=employee.v1.EmploymentType" json:"employment_type,omitempty"`
+	// status moves the employee between pending, active and on leave. It
+	// cannot be set to or from terminated; use TerminateEmployee and
+	// RehireEmployee.
+	Status        EmploymentStatus `protobuf:"varint,14,opt,name=status,proto3,enum=employee.v1.EmploymentStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeRequest) Reset() {
+	*x = UpdateEmployeeRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeRequest) ProtoMessage() {}
+
+func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetGrossSalary() string {
+	if x != nil {
+		return x.GrossSalary
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateEmployeeRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetHireDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HireDate
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetEmploymentType() EmploymentType {
+	if x != nil {
+		return x.EmploymentType
+	}
+	return EmploymentType_EMPLOYMENT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateEmployeeRequest) GetStatus() EmploymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EmploymentStatus_EMPLOYMENT_STATUS_UNSPECIFIED
+}
+
+type DeleteEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeRequest) Reset() {
+	*x = DeleteEmployeeRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeRequest) ProtoMessage() {}
+
+func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeResponse) Reset() {
+	*x = DeleteEmployeeResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeResponse) ProtoMessage() {}
+
+func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteEmployeeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type TerminateEmployeeRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason TerminationReason      `protobuf:"varint,2,opt,name=reason,proto3,enum=employee.v1.TerminationReason" json:"reason,omitempty"`
+	// last_working_day is a UTC date, today by default. It cannot be before
+	// the hire date.
+	LastWorkingDay *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_working_day,json=lastWorkingDay,proto3" json:"last_working_day,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TerminateEmployeeRequest) Reset() {
+	*x = TerminateEmployeeRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateEmployeeRequest) ProtoMessage() {}
+
+func (x *TerminateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*TerminateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TerminateEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TerminateEmployeeRequest) GetReason() TerminationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return TerminationReason_TERMINATION_REASON_UNSPECIFIED
+}
+
+func (x *TerminateEmployeeRequest) GetLastWorkingDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastWorkingDay
+	}
+	return nil
+}
+
+type RehireEmployeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// hire_date defaults to today and must be after the last working day.
+	HireDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=hire_date,json=hireDate,proto3" json:"hire_date,omitempty"`
+	// employment_type defaults to the type of the previous employment.
+	EmploymentType EmploymentType `protobuf:"varint,3,opt,name=employment_type,json=employmentType,proto3,enum=employee.v1.EmploymentType" json:"employment_type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RehireEmployeeRequest) Reset() {
+	*x = RehireEmployeeRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RehireEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RehireEmployeeRequest) ProtoMessage() {}
+
+func (x *RehireEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RehireEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*RehireEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RehireEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RehireEmployeeRequest) GetHireDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HireDate
+	}
+	return nil
+}
+
+func (x *RehireEmployeeRequest) GetEmploymentType() EmploymentType {
+	if x != nil {
+		return x.EmploymentType
+	}
+	return EmploymentType_EMPLOYMENT_TYPE_UNSPECIFIED
+}
+
+type ListDeletedEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedEmployeesRequest) Reset() {
+	*x = ListDeletedEmployeesRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedEmployeesRequest) ProtoMessage() {}
+
+func (x *ListDeletedEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListDeletedEmployeesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedEmployeesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDeletedEmployeesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// employees are ordered most recently deleted first.
+	Employees     []*Employee `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	TotalCount    int64       `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32       `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32       `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages    int32       `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedEmployeesResponse) Reset() {
+	*x = ListDeletedEmployeesResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedEmployeesResponse) ProtoMessage() {}
+
+func (x *ListDeletedEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListDeletedEmployeesResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+func (x *ListDeletedEmployeesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListDeletedEmployeesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedEmployeesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedEmployeesResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type RestoreEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEmployeeRequest) Reset() {
+	*x = RestoreEmployeeRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEmployeeRequest) ProtoMessage() {}
+
+func (x *RestoreEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RestoreEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeEmployeeRequest) Reset() {
+	*x = PurgeEmployeeRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeEmployeeRequest) ProtoMessage() {}
+
+func (x *PurgeEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*PurgeEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PurgeEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeEmployeeResponse) Reset() {
+	*x = PurgeEmployeeResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeEmployeeResponse) ProtoMessage() {}
+
+func (x *PurgeEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*PurgeEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PurgeEmployeeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetEmployeeHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeHistoryRequest) Reset() {
+	*x = GetEmployeeHistoryRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeHistoryRequest) ProtoMessage() {}
+
+func (x *GetEmployeeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetEmployeeHistoryRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *GetEmployeeHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetEmployeeHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// FieldChange is the value of one field before and after a change. old_value
+// is unset for a created employee and new_value for a deleted one.
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      *string                `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3,oneof" json:"old_value,omitempty"`
+	NewValue      *string                `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3,oneof" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil && x.OldValue != nil {
+		return *x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil && x.NewValue != nil {
+		return *x.NewValue
+	}
+	return ""
+}
+
+type EmployeeChange struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Action     ChangeAction           `protobuf:"varint,3,opt,name=action,proto3,enum=employee.v1.ChangeAction" json:"action,omitempty"`
+	// actor_id is the user who made the change, empty for system changes.
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeChange) Reset() {
+	*x = EmployeeChange{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeChange) ProtoMessage() {}
+
+func (x *EmployeeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeChange.ProtoReflect.Descriptor instead.
+func (*EmployeeChange) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EmployeeChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EmployeeChange) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *EmployeeChange) GetAction() ChangeAction {
+	if x != nil {
+		return x.Action
+	}
+	return ChangeAction_CHANGE_ACTION_UNSPECIFIED
+}
+
+func (x *EmployeeChange) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *EmployeeChange) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *EmployeeChange) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type WatchEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// after_sequence resumes the stream after the event with that sequence;
+	// zero starts from the first event. A sequence that no stored event has
+	// fails with NOT_FOUND.
+	AfterSequence int64 `protobuf:"varint,1,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	// country and types only send events for employees in that country and of
+	// those types. Empty values send every event. With a country, an employee
+	// moving out of it is sent as deleted and one moving in as created.
+	Country       string              `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	Types         []EmployeeEventType `protobuf:"varint,3,rep,packed,name=types,proto3,enum=employee.v1.EmployeeEventType" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEmployeesRequest) Reset() {
+	*x = WatchEmployeesRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEmployeesRequest) ProtoMessage() {}
+
+func (x *WatchEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*WatchEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WatchEmployeesRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+func (x *WatchEmployeesRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *WatchEmployeesRequest) GetTypes() []EmployeeEventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// EmployeeEvent is a committed change to an employee. Events are durable:
+// a watcher that reconnects with the sequence of the last event it handled
+// receives every later event exactly once.
+type EmployeeEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type     EmployeeEventType      `protobuf:"varint,2,opt,name=type,proto3,enum=employee.v1.EmployeeEventType" json:"type,omitempty"`
+	// employee is the employee after the change, or as it was when deleted.
+	Employee *Employee `protobuf:"bytes,3,opt,name=employee,proto3" json:"employee,omitempty"`
+	// actor_id is the user who made the change, empty for system changes.
+	ActorId    string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Changes    []*FieldChange         `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	// previous_country is the employee's country before an update that moved
+	// it, empty otherwise.
+	PreviousCountry string `protobuf:"bytes,7,opt,name=previous_country,json=previousCountry,proto3" json:"previous_country,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EmployeeEvent) Reset() {
+	*x = EmployeeEvent{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeEvent) ProtoMessage() {}
+
+func (x *EmployeeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeEvent.ProtoReflect.Descriptor instead.
+func (*EmployeeEvent) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EmployeeEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *EmployeeEvent) GetType() EmployeeEventType {
+	if x != nil {
+		return x.Type
+	}
+	return EmployeeEventType_EMPLOYEE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *EmployeeEvent) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *EmployeeEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *EmployeeEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *EmployeeEvent) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *EmployeeEvent) GetPreviousCountry() string {
+	if x != nil {
+		return x.PreviousCountry
+	}
+	return ""
+}
+
+type GetEmployeeHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// changes are ordered newest first.
+	Changes       []*EmployeeChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	TotalCount    int64             `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32             `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32             `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeHistoryResponse) Reset() {
+	*x = GetEmployeeHistoryResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeHistoryResponse) ProtoMessage() {}
+
+func (x *GetEmployeeHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetEmployeeHistoryResponse) GetChanges() []*EmployeeChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *GetEmployeeHistoryResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetEmployeeHistoryResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetEmployeeHistoryResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// CompensationRecord is an employee's gross salary from effective_from until
+// effective_to, which is unset while no later change is scheduled.
+type CompensationRecord struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId  string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	GrossSalary string                 `protobuf:"bytes,3,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
+	Reason      CompensationReason     `protobuf:"varint,4,opt,name=reason,proto3,enum=employee.v1.CompensationReason" json:"reason,omitempty"`
+	// approver_id is the user who made the change, empty for salaries that
+	// predate the compensation history.
+	ApproverId    string                 `protobuf:"bytes,5,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// currency is the ISO 4217 code gross_salary is paid in.
+	Currency      string `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompensationRecord) Reset() {
+	*x = CompensationRecord{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompensationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompensationRecord) ProtoMessage() {}
+
+func (x *CompensationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompensationRecord.ProtoReflect.Descriptor instead.
+func (*CompensationRecord) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CompensationRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompensationRecord) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *CompensationRecord) GetGrossSalary() string {
+	if x != nil {
+		return x.GrossSalary
+	}
+	return ""
+}
+
+func (x *CompensationRecord) GetReason() CompensationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CompensationReason_COMPENSATION_REASON_UNSPECIFIED
+}
+
+func (x *CompensationRecord) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *CompensationRecord) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *CompensationRecord) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+func (x *CompensationRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CompensationRecord) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ScheduleCompensationChangeRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId  string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	GrossSalary string                 `protobuf:"bytes,2,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
+	Reason      CompensationReason     `protobuf:"varint,3,opt,name=reason,proto3,enum=employee.v1.CompensationReason" json:"reason,omitempty"`
+	// effective_from defaults to now and cannot be in the past.
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// currency defaults to the employee's current currency.
+	Currency      string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleCompensationChangeRequest) Reset() {
+	*x = ScheduleCompensationChangeRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleCompensationChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCompensationChangeRequest) ProtoMessage() {}
+
+func (x *ScheduleCompensationChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCompensationChangeRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleCompensationChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ScheduleCompensationChangeRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ScheduleCompensationChangeRequest) GetGrossSalary() string {
+	if x != nil {
+		return x.GrossSalary
+	}
+	return ""
+}
+
+func (x *ScheduleCompensationChangeRequest) GetReason() CompensationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CompensationReason_COMPENSATION_REASON_UNSPECIFIED
+}
+
+func (x *ScheduleCompensationChangeRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *ScheduleCompensationChangeRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ListCompensationHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompensationHistoryRequest) Reset() {
+	*x = ListCompensationHistoryRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompensationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompensationHistoryRequest) ProtoMessage() {}
+
+func (x *ListCompensationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompensationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListCompensationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCompensationHistoryRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+type ListCompensationHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// records are ordered by effective_from, oldest first.
+	Records       []*CompensationRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompensationHistoryResponse) Reset() {
+	*x = ListCompensationHistoryResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompensationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompensationHistoryResponse) ProtoMessage() {}
+
+func (x *ListCompensationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompensationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListCompensationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListCompensationHistoryResponse) GetRecords() []*CompensationRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// ImportEmployeesRequest is one message of an import stream. The options, if
+// sent, must be the first message; every other message carries the next chunk
+// of a CSV file whose header names the columns full_name, job_title, country
+// and gross_salary, and optionally currency, hire_date (YYYY-MM-DD) and
+// employment_type (full_time, part_time or contractor).
+type ImportEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportEmployeesRequest_Options
+	//	*ImportEmployeesRequest_Chunk
+	Payload       isImportEmployeesRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEmployeesRequest) Reset() {
+	*x = ImportEmployeesRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEmployeesRequest) ProtoMessage() {}
+
+func (x *ImportEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ImportEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportEmployeesRequest) GetPayload() isImportEmployeesRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportEmployeesRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportEmployeesRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportEmployeesRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportEmployeesRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportEmployeesRequest_Payload interface {
+	isImportEmployeesRequest_Payload()
+}
+
+type ImportEmployeesRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportEmployeesRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportEmployeesRequest_Options) isImportEmployeesRequest_Payload() {}
+
+func (*ImportEmployeesRequest_Chunk) isImportEmployeesRequest_Payload() {}
+
+type ImportOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dry_run validates every row without creating any employee.
+	DryRun        bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line is the line of the CSV file the row starts on; the header is line 1.
+	Line          int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ImportRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportEmployeesResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows    int32                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ImportedRows int32                  `protobuf:"varint,2,opt,name=imported_rows,json=importedRows,proto3" json:"imported_rows,omitempty"`
+	DryRun       bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// committed is true when every row was valid and the employees were
+	// created. Otherwise nothing was written.
+	Committed  bool  `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"`
+	ErrorCount int32 `protobuf:"varint,5,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	// errors lists the rejected rows, truncated to the first 1000.
+	Errors        []*ImportRowError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEmployeesResponse) Reset() {
+	*x = ImportEmployeesResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEmployeesResponse) ProtoMessage() {}
+
+func (x *ImportEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ImportEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImportEmployeesResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportEmployeesResponse) GetImportedRows() int32 {
+	if x != nil {
+		return x.ImportedRows
+	}
+	return 0
+}
+
+func (x *ImportEmployeesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportEmployeesResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *ImportEmployeesResponse) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *ImportEmployeesResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ExportEmployeesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Country   string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	JobTitle  string                 `protobuf:"bytes,2,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	MinSalary string                 `protobuf:"bytes,3,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary string                 `protobuf:"bytes,4,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	Format    ExportFormat           `protobuf:"varint,5,opt,name=format,proto3,enum=employee.v1.ExportFormat" json:"format,omitempty"`
+	// include_net_salary adds the tax_amount, tax_rate and net_salary columns,
+	// calculated as CalculateNetSalary does today on each employee's current
+	// salary, in the currency of the employee's country.
+	IncludeNetSalary bool `protobuf:"varint,6,opt,name=include_net_salary,json=includeNetSalary,proto3" json:"include_net_salary,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExportEmployeesRequest) Reset() {
+	*x = ExportEmployeesRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmployeesRequest) ProtoMessage() {}
+
+func (x *ExportEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ExportEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExportEmployeesRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetMinSalary() string {
+	if x != nil {
+		return x.MinSalary
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetMaxSalary() string {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportEmployeesRequest) GetIncludeNetSalary() bool {
+	if x != nil {
+		return x.IncludeNetSalary
+	}
+	return false
+}
+
+// ExportEmployeesResponse carries the next chunk of the export file. The
+// chunks concatenated in order form the complete file.
+type ExportEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEmployeesResponse) Reset() {
+	*x = ExportEmployeesResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmployeesResponse) ProtoMessage() {}
+
+func (x *ExportEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ExportEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExportEmployeesResponse) GetChunk() []byte {
 	if x != nil {
-		return x.FullName
+		return x.Chunk
 	}
-	return ""
+	return nil
 }
 
-func (x *Employee) GetJobTitle() string {
-	if x != nil {
-		return x.JobTitle
-	}
-	return ""
+type ListDirectReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ManagerId     string                 `protobuf:"bytes,1,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Employee) GetCountry() string {
-	if x != nil {
-		return x.Country
-	}
-	return ""
+func (x *ListDirectReportsRequest) Reset() {
+	*x = ListDirectReportsRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Employee) GetGrossSalary() string {
-	if x != nil {
-		return x.GrossSalary
-	}
-	return ""
+func (x *ListDirectReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Employee) GetCreatedAt() *timestamppb.Timestamp {
+func (*ListDirectReportsRequest) ProtoMessage() {}
+
+func (x *ListDirectReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[34]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Employee) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use ListDirectReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListDirectReportsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListDirectReportsRequest) GetManagerId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ManagerId
 	}
-	return nil
+	return ""
 }
 
-type GetEmployeeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type ListDirectReportsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// employees are ordered by full_name.
+	Employees     []*Employee `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeRequest) Reset() {
-	*x = GetEmployeeRequest{}
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[2]
+func (x *ListDirectReportsResponse) Reset() {
+	*x = ListDirectReportsResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeRequest) String() string {
+func (x *ListDirectReportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeRequest) ProtoMessage() {}
+func (*ListDirectReportsResponse) ProtoMessage() {}
 
-func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[2]
+func (x *ListDirectReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,41 +3163,43 @@ func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*GetEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListDirectReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListDirectReportsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetEmployeeRequest) GetId() string {
+func (x *ListDirectReportsResponse) GetEmployees() []*Employee {
 	if x != nil {
-		return x.Id
+		return x.Employees
 	}
-	return ""
+	return nil
 }
 
-type ListEmployeesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+type ListReportsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ManagerId string                 `protobuf:"bytes,1,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	// max_depth limits the levels below the manager returned; 1 returns the
+	// direct reports only. Zero returns all levels.
+	MaxDepth      int32 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEmployeesRequest) Reset() {
-	*x = ListEmployeesRequest{}
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[3]
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEmployeesRequest) String() string {
+func (x *ListReportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEmployeesRequest) ProtoMessage() {}
+func (*ListReportsRequest) ProtoMessage() {}
 
-func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[3]
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,51 +3210,50 @@ func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEmployeesRequest.ProtoReflect.Descriptor instead.
-func (*ListEmployeesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListEmployeesRequest) GetPage() int32 {
+func (x *ListReportsRequest) GetManagerId() string {
 	if x != nil {
-		return x.Page
+		return x.ManagerId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListEmployeesRequest) GetPageSize() int32 {
+func (x *ListReportsRequest) GetMaxDepth() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.MaxDepth
 	}
 	return 0
 }
 
-type ListEmployeesResponse struct {
+// OrgNode is an employee depth levels below the manager or root it was
+// listed under.
+type OrgNode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEmployeesResponse) Reset() {
-	*x = ListEmployeesResponse{}
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[4]
+func (x *OrgNode) Reset() {
+	*x = OrgNode{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEmployeesResponse) String() string {
+func (x *OrgNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEmployeesResponse) ProtoMessage() {}
+func (*OrgNode) ProtoMessage() {}
 
-func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[4]
+func (x *OrgNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,72 +3264,92 @@ func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEmployeesResponse.ProtoReflect.Descriptor instead.
-func (*ListEmployeesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use OrgNode.ProtoReflect.Descriptor instead.
+func (*OrgNode) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListEmployeesResponse) GetEmployees() []*Employee {
+func (x *OrgNode) GetEmployee() *Employee {
 	if x != nil {
-		return x.Employees
+		return x.Employee
 	}
 	return nil
 }
 
-func (x *ListEmployeesResponse) GetTotalCount() int64 {
+func (x *OrgNode) GetDepth() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.Depth
 	}
 	return 0
 }
 
-func (x *ListEmployeesResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+type ListReportsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// reports are ordered by depth, then full_name.
+	Reports       []*OrgNode `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEmployeesResponse) GetPageSize() int32 {
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[38]
 	if x != nil {
-		return x.PageSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListEmployeesResponse) GetTotalPages() int32 {
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListReportsResponse) GetReports() []*OrgNode {
 	if x != nil {
-		return x.TotalPages
+		return x.Reports
 	}
-	return 0
+	return nil
 }
 
-type UpdateEmployeeRequest struct {
+type GetManagementChainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	JobTitle      string                 `protobuf:"bytes,3,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
-	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
-	GrossSalary   string                 `protobuf:"bytes,5,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEmployeeRequest) Reset() {
-	*x = UpdateEmployeeRequest{}
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[5]
+func (x *GetManagementChainRequest) Reset() {
+	*x = GetManagementChainRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployeeRequest) String() string {
+func (x *GetManagementChainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEmployeeRequest) ProtoMessage() {}
+func (*GetManagementChainRequest) ProtoMessage() {}
 
-func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[5]
+func (x *GetManagementChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,68 +3360,92 @@ func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetManagementChainRequest.ProtoReflect.Descriptor instead.
+func (*GetManagementChainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UpdateEmployeeRequest) GetId() string {
+func (x *GetManagementChainRequest) GetEmployeeId() string {
 	if x != nil {
-		return x.Id
+		return x.EmployeeId
 	}
 	return ""
 }
 
-func (x *UpdateEmployeeRequest) GetFullName() string {
-	if x != nil {
-		return x.FullName
-	}
-	return ""
+type GetManagementChainResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// managers are ordered from the employee's direct manager up to the top
+	// of the organisation.
+	Managers      []*Employee `protobuf:"bytes,1,rep,name=managers,proto3" json:"managers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEmployeeRequest) GetJobTitle() string {
-	if x != nil {
-		return x.JobTitle
-	}
-	return ""
+func (x *GetManagementChainResponse) Reset() {
+	*x = GetManagementChainResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployeeRequest) GetCountry() string {
+func (x *GetManagementChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagementChainResponse) ProtoMessage() {}
+
+func (x *GetManagementChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[40]
 	if x != nil {
-		return x.Country
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateEmployeeRequest) GetGrossSalary() string {
+// Deprecated: Use GetManagementChainResponse.ProtoReflect.Descriptor instead.
+func (*GetManagementChainResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetManagementChainResponse) GetManagers() []*Employee {
 	if x != nil {
-		return x.GrossSalary
+		return x.Managers
 	}
-	return ""
+	return nil
 }
 
-type DeleteEmployeeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type ExportOrgChartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// root_id charts the employee and everyone below it. When empty, every
+	// employee without a manager is a root.
+	RootId string `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
+	// max_depth limits the levels below the roots charted. Zero charts all
+	// levels.
+	MaxDepth      int32          `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	Format        OrgChartFormat `protobuf:"varint,3,opt,name=format,proto3,enum=employee.v1.OrgChartFormat" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEmployeeRequest) Reset() {
-	*x = DeleteEmployeeRequest{}
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[6]
+func (x *ExportOrgChartRequest) Reset() {
+	*x = ExportOrgChartRequest{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEmployeeRequest) String() string {
+func (x *ExportOrgChartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEmployeeRequest) ProtoMessage() {}
+func (*ExportOrgChartRequest) ProtoMessage() {}
 
-func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[6]
+func (x *ExportOrgChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,40 +3456,56 @@ func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExportOrgChartRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrgChartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DeleteEmployeeRequest) GetId() string {
+func (x *ExportOrgChartRequest) GetRootId() string {
 	if x != nil {
-		return x.Id
+		return x.RootId
 	}
 	return ""
 }
 
-type DeleteEmployeeResponse struct {
+func (x *ExportOrgChartRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *ExportOrgChartRequest) GetFormat() OrgChartFormat {
+	if x != nil {
+		return x.Format
+	}
+	return OrgChartFormat_ORG_CHART_FORMAT_UNSPECIFIED
+}
+
+type ExportOrgChartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEmployeeResponse) Reset() {
-	*x = DeleteEmployeeResponse{}
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[7]
+func (x *ExportOrgChartResponse) Reset() {
+	*x = ExportOrgChartResponse{}
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEmployeeResponse) String() string {
+func (x *ExportOrgChartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEmployeeResponse) ProtoMessage() {}
+func (*ExportOrgChartResponse) ProtoMessage() {}
 
-func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_employee_v1_employee_proto_msgTypes[7]
+func (x *ExportOrgChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_employee_v1_employee_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,28 +3516,50 @@ func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEmployeeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ExportOrgChartResponse.ProtoReflect.Descriptor instead.
+func (*ExportOrgChartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_employee_v1_employee_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DeleteEmployeeResponse) GetSuccess() bool {
+func (x *ExportOrgChartResponse) GetContent() []byte {
 	if x != nil {
-		return x.Success
+		return x.Content
 	}
-	return false
+	return nil
+}
+
+func (x *ExportOrgChartResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportOrgChartResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
 }
 
 var File_proto_employee_v1_employee_proto protoreflect.FileDescriptor
 
 const file_proto_employee_v1_employee_proto_rawDesc = "" +
 	"\n" +
-	" proto/employee/v1/employee.proto\x12\vemployee.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8e\x01\n" +
+	" proto/employee/v1/employee.proto\x12\vemployee.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x03\n" +
 	"\x15CreateEmployeeRequest\x12\x1b\n" +
 	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x1b\n" +
 	"\tjob_title\x18\x02 \x01(\tR\bjobTitle\x12\x18\n" +
 	"\acountry\x18\x03 \x01(\tR\acountry\x12!\n" +
-	"\fgross_salary\x18\x04 \x01(\tR\vgrossSalary\"\x87\x02\n" +
+	"\fgross_salary\x18\x04 \x01(\tR\vgrossSalary\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x06 \x01(\tR\tmanagerId\x12#\n" +
+	"\rdepartment_id\x18\a \x01(\tR\fdepartmentId\x12$\n" +
+	"\x0ecost_center_id\x18\b \x01(\tR\fcostCenterId\x127\n" +
+	"\thire_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bhireDate\x12D\n" +
+	"\x0femployment_type\x18\n" +
+	" \x01(\x0e2\x1b.employee.v1.EmploymentTypeR\x0eemploymentType\"\xad\x06\n" +
 	"\bEmployee\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x1b\n" +
@@ -537,12 +3569,49 @@ const file_proto_employee_v1_employee_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"$\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\v \x01(\tR\tmanagerId\x12#\n" +
+	"\rdepartment_id\x18\f \x01(\tR\fdepartmentId\x12$\n" +
+	"\x0ecost_center_id\x18\r \x01(\tR\fcostCenterId\x127\n" +
+	"\thire_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\bhireDate\x12D\n" +
+	"\x0femployment_type\x18\x0f \x01(\x0e2\x1b.employee.v1.EmploymentTypeR\x0eemploymentType\x125\n" +
+	"\x06status\x18\x10 \x01(\x0e2\x1d.employee.v1.EmploymentStatusR\x06status\x12M\n" +
+	"\x12termination_reason\x18\x11 \x01(\x0e2\x1e.employee.v1.TerminationReasonR\x11terminationReason\x12D\n" +
+	"\x10last_working_day\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastWorkingDay\"$\n" +
 	"\x12GetEmployeeRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xf7\x05\n" +
 	"\x14ListEmployeesRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\xbf\x01\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x1b\n" +
+	"\tjob_title\x18\x04 \x01(\tR\bjobTitle\x12\x1d\n" +
+	"\n" +
+	"min_salary\x18\x05 \x01(\tR\tminSalary\x12\x1d\n" +
+	"\n" +
+	"max_salary\x18\x06 \x01(\tR\tmaxSalary\x12?\n" +
+	"\rcreated_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12?\n" +
+	"\rupdated_after\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedAfter\x12A\n" +
+	"\x0eupdated_before\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rupdatedBefore\x12\x17\n" +
+	"\asort_by\x18\v \x01(\tR\x06sortBy\x125\n" +
+	"\n" +
+	"sort_order\x18\f \x01(\x0e2\x16.employee.v1.SortOrderR\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\r \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x0e \x01(\tR\tmanagerId\x12\x1d\n" +
+	"\n" +
+	"reports_to\x18\x0f \x01(\tR\treportsTo\x12#\n" +
+	"\rdepartment_id\x18\x10 \x01(\tR\fdepartmentId\x12$\n" +
+	"\x0ecost_center_id\x18\x11 \x01(\tR\fcostCenterId\x129\n" +
+	"\bstatuses\x18\x12 \x03(\x0e2\x1d.employee.v1.EmploymentStatusR\bstatuses\"\xe7\x01\n" +
 	"\x15ListEmployeesResponse\x123\n" +
 	"\temployees\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\temployees\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
@@ -550,23 +3619,259 @@ const file_proto_employee_v1_employee_proto_rawDesc = "" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
-	"totalPages\"\x9e\x01\n" +
+	"totalPages\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\"D\n" +
+	"\x16SearchEmployeesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xbd\x01\n" +
+	"\x14EmployeeSearchResult\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x12.\n" +
+	"\x13full_name_highlight\x18\x03 \x01(\tR\x11fullNameHighlight\x12.\n" +
+	"\x13job_title_highlight\x18\x04 \x01(\tR\x11jobTitleHighlight\"V\n" +
+	"\x17SearchEmployeesResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.employee.v1.EmployeeSearchResultR\aresults\"\xb1\x04\n" +
 	"\x15UpdateEmployeeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x1b\n" +
 	"\tjob_title\x18\x03 \x01(\tR\bjobTitle\x12\x18\n" +
 	"\acountry\x18\x04 \x01(\tR\acountry\x12!\n" +
-	"\fgross_salary\x18\x05 \x01(\tR\vgrossSalary\"'\n" +
+	"\fgross_salary\x18\x05 \x01(\tR\vgrossSalary\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12;\n" +
+	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\t \x01(\tR\tmanagerId\x12#\n" +
+	"\rdepartment_id\x18\n" +
+	" \x01(\tR\fdepartmentId\x12$\n" +
+	"\x0ecost_center_id\x18\v \x01(\tR\fcostCenterId\x127\n" +
+	"\thire_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bhireDate\x12D\n" +
+	"\x0femployment_type\x18\r \x01(\x0e2\x1b.employee.v1.EmploymentTypeR\x0eemploymentType\x125\n" +
+	"\x06status\x18\x0e \x01(\x0e2\x1d.employee.v1.EmploymentStatusR\x06status\"'\n" +
 	"\x15DeleteEmployeeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
 	"\x16DeleteEmployeeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xa5\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa8\x01\n" +
+	"\x18TerminateEmployeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1e.employee.v1.TerminationReasonR\x06reason\x12D\n" +
+	"\x10last_working_day\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastWorkingDay\"\xa6\x01\n" +
+	"\x15RehireEmployeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\thire_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bhireDate\x12D\n" +
+	"\x0femployment_type\x18\x03 \x01(\x0e2\x1b.employee.v1.EmploymentTypeR\x0eemploymentType\"N\n" +
+	"\x1bListDeletedEmployeesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\xc6\x01\n" +
+	"\x1cListDeletedEmployeesResponse\x123\n" +
+	"\temployees\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\temployees\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"(\n" +
+	"\x16RestoreEmployeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"&\n" +
+	"\x14PurgeEmployeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x15PurgeEmployeeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"m\n" +
+	"\x19GetEmployeeHistoryRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x83\x01\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12 \n" +
+	"\told_value\x18\x02 \x01(\tH\x00R\boldValue\x88\x01\x01\x12 \n" +
+	"\tnew_value\x18\x03 \x01(\tH\x01R\bnewValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_old_valueB\f\n" +
+	"\n" +
+	"_new_value\"\x80\x02\n" +
+	"\x0eEmployeeChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x121\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x19.employee.v1.ChangeActionR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x122\n" +
+	"\achanges\x18\x06 \x03(\v2\x18.employee.v1.FieldChangeR\achanges\"\x8e\x01\n" +
+	"\x15WatchEmployeesRequest\x12%\n" +
+	"\x0eafter_sequence\x18\x01 \x01(\x03R\rafterSequence\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x124\n" +
+	"\x05types\x18\x03 \x03(\x0e2\x1e.employee.v1.EmployeeEventTypeR\x05types\"\xc9\x02\n" +
+	"\rEmployeeEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x122\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.employee.v1.EmployeeEventTypeR\x04type\x121\n" +
+	"\bemployee\x18\x03 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x122\n" +
+	"\achanges\x18\x06 \x03(\v2\x18.employee.v1.FieldChangeR\achanges\x12)\n" +
+	"\x10previous_country\x18\a \x01(\tR\x0fpreviousCountry\"\xa5\x01\n" +
+	"\x1aGetEmployeeHistoryResponse\x125\n" +
+	"\achanges\x18\x01 \x03(\v2\x1b.employee.v1.EmployeeChangeR\achanges\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9b\x03\n" +
+	"\x12CompensationRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12!\n" +
+	"\fgross_salary\x18\x03 \x01(\tR\vgrossSalary\x127\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x1f.employee.v1.CompensationReasonR\x06reason\x12\x1f\n" +
+	"\vapprover_id\x18\x05 \x01(\tR\n" +
+	"approverId\x12A\n" +
+	"\x0eeffective_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12=\n" +
+	"\feffective_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveTo\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\"\xff\x01\n" +
+	"!ScheduleCompensationChangeRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12!\n" +
+	"\fgross_salary\x18\x02 \x01(\tR\vgrossSalary\x127\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x1f.employee.v1.CompensationReasonR\x06reason\x12A\n" +
+	"\x0eeffective_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"A\n" +
+	"\x1eListCompensationHistoryRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\"\\\n" +
+	"\x1fListCompensationHistoryResponse\x129\n" +
+	"\arecords\x18\x01 \x03(\v2\x1f.employee.v1.CompensationRecordR\arecords\"s\n" +
+	"\x16ImportEmployeesRequest\x126\n" +
+	"\aoptions\x18\x01 \x01(\v2\x1a.employee.v1.ImportOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"(\n" +
+	"\rImportOptions\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\">\n" +
+	"\x0eImportRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xea\x01\n" +
+	"\x17ImportEmployeesResponse\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x12#\n" +
+	"\rimported_rows\x18\x02 \x01(\x05R\fimportedRows\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1c\n" +
+	"\tcommitted\x18\x04 \x01(\bR\tcommitted\x12\x1f\n" +
+	"\verror_count\x18\x05 \x01(\x05R\n" +
+	"errorCount\x123\n" +
+	"\x06errors\x18\x06 \x03(\v2\x1b.employee.v1.ImportRowErrorR\x06errors\"\xee\x01\n" +
+	"\x16ExportEmployeesRequest\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x1b\n" +
+	"\tjob_title\x18\x02 \x01(\tR\bjobTitle\x12\x1d\n" +
+	"\n" +
+	"min_salary\x18\x03 \x01(\tR\tminSalary\x12\x1d\n" +
+	"\n" +
+	"max_salary\x18\x04 \x01(\tR\tmaxSalary\x121\n" +
+	"\x06format\x18\x05 \x01(\x0e2\x19.employee.v1.ExportFormatR\x06format\x12,\n" +
+	"\x12include_net_salary\x18\x06 \x01(\bR\x10includeNetSalary\"/\n" +
+	"\x17ExportEmployeesResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"9\n" +
+	"\x18ListDirectReportsRequest\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x01 \x01(\tR\tmanagerId\"P\n" +
+	"\x19ListDirectReportsResponse\x123\n" +
+	"\temployees\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\temployees\"P\n" +
+	"\x12ListReportsRequest\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x01 \x01(\tR\tmanagerId\x12\x1b\n" +
+	"\tmax_depth\x18\x02 \x01(\x05R\bmaxDepth\"R\n" +
+	"\aOrgNode\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\"E\n" +
+	"\x13ListReportsResponse\x12.\n" +
+	"\areports\x18\x01 \x03(\v2\x14.employee.v1.OrgNodeR\areports\"<\n" +
+	"\x19GetManagementChainRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\"O\n" +
+	"\x1aGetManagementChainResponse\x121\n" +
+	"\bmanagers\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\bmanagers\"\x82\x01\n" +
+	"\x15ExportOrgChartRequest\x12\x17\n" +
+	"\aroot_id\x18\x01 \x01(\tR\x06rootId\x12\x1b\n" +
+	"\tmax_depth\x18\x02 \x01(\x05R\bmaxDepth\x123\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1b.employee.v1.OrgChartFormatR\x06format\"q\n" +
+	"\x16ExportOrgChartResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename*\x8f\x01\n" +
+	"\x0eEmploymentType\x12\x1f\n" +
+	"\x1bEMPLOYMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19EMPLOYMENT_TYPE_FULL_TIME\x10\x01\x12\x1d\n" +
+	"\x19EMPLOYMENT_TYPE_PART_TIME\x10\x02\x12\x1e\n" +
+	"\x1aEMPLOYMENT_TYPE_CONTRACTOR\x10\x03*\xb4\x01\n" +
+	"\x10EmploymentStatus\x12!\n" +
+	"\x1dEMPLOYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19EMPLOYMENT_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18EMPLOYMENT_STATUS_ACTIVE\x10\x02\x12\x1e\n" +
+	"\x1aEMPLOYMENT_STATUS_ON_LEAVE\x10\x03\x12 \n" +
+	"\x1cEMPLOYMENT_STATUS_TERMINATED\x10\x04*\x86\x02\n" +
+	"\x11TerminationReason\x12\"\n" +
+	"\x1eTERMINATION_REASON_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eTERMINATION_REASON_RESIGNATION\x10\x01\x12 \n" +
+	"\x1cTERMINATION_REASON_DISMISSAL\x10\x02\x12!\n" +
+	"\x1dTERMINATION_REASON_REDUNDANCY\x10\x03\x12!\n" +
+	"\x1dTERMINATION_REASON_RETIREMENT\x10\x04\x12#\n" +
+	"\x1fTERMINATION_REASON_CONTRACT_END\x10\x05\x12\x1c\n" +
+	"\x18TERMINATION_REASON_OTHER\x10\x06*P\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x02*\xb4\x01\n" +
+	"\fChangeAction\x12\x1d\n" +
+	"\x19CHANGE_ACTION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15CHANGE_ACTION_CREATED\x10\x01\x12\x19\n" +
+	"\x15CHANGE_ACTION_UPDATED\x10\x02\x12\x19\n" +
+	"\x15CHANGE_ACTION_DELETED\x10\x03\x12\x1a\n" +
+	"\x16CHANGE_ACTION_RESTORED\x10\x04\x12\x18\n" +
+	"\x14CHANGE_ACTION_PURGED\x10\x05*\x9b\x01\n" +
+	"\x11EmployeeEventType\x12#\n" +
+	"\x1fEMPLOYEE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bEMPLOYEE_EVENT_TYPE_CREATED\x10\x01\x12\x1f\n" +
+	"\x1bEMPLOYEE_EVENT_TYPE_UPDATED\x10\x02\x12\x1f\n" +
+	"\x1bEMPLOYEE_EVENT_TYPE_DELETED\x10\x03*\xbd\x01\n" +
+	"\x12CompensationReason\x12#\n" +
+	"\x1fCOMPENSATION_REASON_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18COMPENSATION_REASON_HIRE\x10\x01\x12!\n" +
+	"\x1dCOMPENSATION_REASON_PROMOTION\x10\x02\x12\x1d\n" +
+	"\x19COMPENSATION_REASON_MERIT\x10\x03\x12\"\n" +
+	"\x1eCOMPENSATION_REASON_ADJUSTMENT\x10\x04*x\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x17\n" +
+	"\x13EXPORT_FORMAT_JSONL\x10\x02\x12\x19\n" +
+	"\x15EXPORT_FORMAT_PARQUET\x10\x03*g\n" +
+	"\x0eOrgChartFormat\x12 \n" +
+	"\x1cORG_CHART_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ORG_CHART_FORMAT_DOT\x10\x01\x12\x19\n" +
+	"\x15ORG_CHART_FORMAT_JSON\x10\x022\x8f\x0f\n" +
 	"\x0fEmployeeService\x12K\n" +
 	"\x0eCreateEmployee\x12\".employee.v1.CreateEmployeeRequest\x1a\x15.employee.v1.Employee\x12E\n" +
 	"\vGetEmployee\x12\x1f.employee.v1.GetEmployeeRequest\x1a\x15.employee.v1.Employee\x12V\n" +
-	"\rListEmployees\x12!.employee.v1.ListEmployeesRequest\x1a\".employee.v1.ListEmployeesResponse\x12K\n" +
+	"\rListEmployees\x12!.employee.v1.ListEmployeesRequest\x1a\".employee.v1.ListEmployeesResponse\x12\\\n" +
+	"\x0fSearchEmployees\x12#.employee.v1.SearchEmployeesRequest\x1a$.employee.v1.SearchEmployeesResponse\x12K\n" +
 	"\x0eUpdateEmployee\x12\".employee.v1.UpdateEmployeeRequest\x1a\x15.employee.v1.Employee\x12Y\n" +
-	"\x0eDeleteEmployee\x12\".employee.v1.DeleteEmployeeRequest\x1a#.employee.v1.DeleteEmployeeResponseB6Z4github.com/employee-api/proto/employee/v1;employeev1b\x06proto3"
+	"\x0eDeleteEmployee\x12\".employee.v1.DeleteEmployeeRequest\x1a#.employee.v1.DeleteEmployeeResponse\x12Q\n" +
+	"\x11TerminateEmployee\x12%.employee.v1.TerminateEmployeeRequest\x1a\x15.employee.v1.Employee\x12K\n" +
+	"\x0eRehireEmployee\x12\".employee.v1.RehireEmployeeRequest\x1a\x15.employee.v1.Employee\x12e\n" +
+	"\x12GetEmployeeHistory\x12&.employee.v1.GetEmployeeHistoryRequest\x1a'.employee.v1.GetEmployeeHistoryResponse\x12k\n" +
+	"\x14ListDeletedEmployees\x12(.employee.v1.ListDeletedEmployeesRequest\x1a).employee.v1.ListDeletedEmployeesResponse\x12M\n" +
+	"\x0fRestoreEmployee\x12#.employee.v1.RestoreEmployeeRequest\x1a\x15.employee.v1.Employee\x12V\n" +
+	"\rPurgeEmployee\x12!.employee.v1.PurgeEmployeeRequest\x1a\".employee.v1.PurgeEmployeeResponse\x12m\n" +
+	"\x1aScheduleCompensationChange\x12..employee.v1.ScheduleCompensationChangeRequest\x1a\x1f.employee.v1.CompensationRecord\x12t\n" +
+	"\x17ListCompensationHistory\x12+.employee.v1.ListCompensationHistoryRequest\x1a,.employee.v1.ListCompensationHistoryResponse\x12^\n" +
+	"\x0fImportEmployees\x12#.employee.v1.ImportEmployeesRequest\x1a$.employee.v1.ImportEmployeesResponse(\x01\x12^\n" +
+	"\x0fExportEmployees\x12#.employee.v1.ExportEmployeesRequest\x1a$.employee.v1.ExportEmployeesResponse0\x01\x12b\n" +
+	"\x11ListDirectReports\x12%.employee.v1.ListDirectReportsRequest\x1a&.employee.v1.ListDirectReportsResponse\x12P\n" +
+	"\vListReports\x12\x1f.employee.v1.ListReportsRequest\x1a .employee.v1.ListReportsResponse\x12e\n" +
+	"\x12GetManagementChain\x12&.employee.v1.GetManagementChainRequest\x1a'.employee.v1.GetManagementChainResponse\x12Y\n" +
+	"\x0eExportOrgChart\x12\".employee.v1.ExportOrgChartRequest\x1a#.employee.v1.ExportOrgChartResponse\x12R\n" +
+	"\x0eWatchEmployees\x12\".employee.v1.WatchEmployeesRequest\x1a\x1a.employee.v1.EmployeeEvent0\x01B6Z4github.com/employee-api/proto/employee/v1;employeev1b\x06proto3"
 
 var (
 	file_proto_employee_v1_employee_proto_rawDescOnce sync.Once
@@ -580,37 +3885,164 @@ func file_proto_employee_v1_employee_proto_rawDescGZIP() []byte {
 	return file_proto_employee_v1_employee_proto_rawDescData
 }
 
-var file_proto_employee_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_employee_v1_employee_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_proto_employee_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_proto_employee_v1_employee_proto_goTypes = []any{
-	(*CreateEmployeeRequest)(nil),  // 0: employee.v1.CreateEmployeeRequest
-	(*Employee)(nil),               // 1: employee.v1.Employee
-	(*GetEmployeeRequest)(nil),     // 2: employee.v1.GetEmployeeRequest
-	(*ListEmployeesRequest)(nil),   // 3: employee.v1.ListEmployeesRequest
-	(*ListEmployeesResponse)(nil),  // 4: employee.v1.ListEmployeesResponse
-	(*UpdateEmployeeRequest)(nil),  // 5: employee.v1.UpdateEmployeeRequest
-	(*DeleteEmployeeRequest)(nil),  // 6: employee.v1.DeleteEmployeeRequest
-	(*DeleteEmployeeResponse)(nil), // 7: employee.v1.DeleteEmployeeResponse
-	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
+	(EmploymentType)(0),                       // 0: employee.v1.EmploymentType
+	(EmploymentStatus)(0),                     // 1: employee.v1.EmploymentStatus
+	(TerminationReason)(0),                    // 2: employee.v1.TerminationReason
+	(SortOrder)(0),                            // 3: employee.v1.SortOrder
+	(ChangeAction)(0),                         // 4: employee.v1.ChangeAction
+	(EmployeeEventType)(0),                    // 5: employee.v1.EmployeeEventType
+	(CompensationReason)(0),                   // 6: employee.v1.CompensationReason
+	(ExportFormat)(0),                         // 7: employee.v1.ExportFormat
+	(OrgChartFormat)(0),                       // 8: employee.v1.OrgChartFormat
+	(*CreateEmployeeRequest)(nil),             // 9: employee.v1.CreateEmployeeRequest
+	(*Employee)(nil),                          // 10: employee.v1.Employee
+	(*GetEmployeeRequest)(nil),                // 11: employee.v1.GetEmployeeRequest
+	(*ListEmployeesRequest)(nil),              // 12: employee.v1.ListEmployeesRequest
+	(*ListEmployeesResponse)(nil),             // 13: employee.v1.ListEmployeesResponse
+	(*SearchEmployeesRequest)(nil),            // 14: employee.v1.SearchEmployeesRequest
+	(*EmployeeSearchResult)(nil),              // 15: employee.v1.EmployeeSearchResult
+	(*SearchEmployeesResponse)(nil),           // 16: employee.v1.SearchEmployeesResponse
+	(*UpdateEmployeeRequest)(nil),             // 17: employee.v1.UpdateEmployeeRequest
+	(*DeleteEmployeeRequest)(nil),             // 18: employee.v1.DeleteEmployeeRequest
+	(*DeleteEmployeeResponse)(nil),            // 19: employee.v1.DeleteEmployeeResponse
+	(*TerminateEmployeeRequest)(nil),          // 20: employee.v1.TerminateEmployeeRequest
+	(*RehireEmployeeRequest)(nil),             // 21: employee.v1.RehireEmployeeRequest
+	(*ListDeletedEmployeesRequest)(nil),       // 22: employee.v1.ListDeletedEmployeesRequest
+	(*ListDeletedEmployeesResponse)(nil),      // 23: employee.v1.ListDeletedEmployeesResponse
+	(*RestoreEmployeeRequest)(nil),            // 24: employee.v1.RestoreEmployeeRequest
+	(*PurgeEmployeeRequest)(nil),              // 25: employee.v1.PurgeEmployeeRequest
+	(*PurgeEmployeeResponse)(nil),             // 26: employee.v1.PurgeEmployeeResponse
+	(*GetEmployeeHistoryRequest)(nil),         // 27: employee.v1.GetEmployeeHistoryRequest
+	(*FieldChange)(nil),                       // 28: employee.v1.FieldChange
+	(*EmployeeChange)(nil),                    // 29: employee.v1.EmployeeChange
+	(*WatchEmployeesRequest)(nil),             // 30: employee.v1.WatchEmployeesRequest
+	(*EmployeeEvent)(nil),                     // 31: employee.v1.EmployeeEvent
+	(*GetEmployeeHistoryResponse)(nil),        // 32: employee.v1.GetEmployeeHistoryResponse
+	(*CompensationRecord)(nil),                // 33: employee.v1.CompensationRecord
+	(*ScheduleCompensationChangeRequest)(nil), // 34: employee.v1.ScheduleCompensationChangeRequest
+	(*ListCompensationHistoryRequest)(nil),    // 35: employee.v1.ListCompensationHistoryRequest
+	(*ListCompensationHistoryResponse)(nil),   // 36: employee.v1.ListCompensationHistoryResponse
+	(*ImportEmployeesRequest)(nil),            // 37: employee.v1.ImportEmployeesRequest
+	(*ImportOptions)(nil),                     // 38: employee.v1.ImportOptions
+	(*ImportRowError)(nil),                    // 39: employee.v1.ImportRowError
+	(*ImportEmployeesResponse)(nil),           // 40: employee.v1.ImportEmployeesResponse
+	(*ExportEmployeesRequest)(nil),            // 41: employee.v1.ExportEmployeesRequest
+	(*ExportEmployeesResponse)(nil),           // 42: employee.v1.ExportEmployeesResponse
+	(*ListDirectReportsRequest)(nil),          // 43: employee.v1.ListDirectReportsRequest
+	(*ListDirectReportsResponse)(nil),         // 44: employee.v1.ListDirectReportsResponse
+	(*ListReportsRequest)(nil),                // 45: employee.v1.ListReportsRequest
+	(*OrgNode)(nil),                           // 46: employee.v1.OrgNode
+	(*ListReportsResponse)(nil),               // 47: employee.v1.ListReportsResponse
+	(*GetManagementChainRequest)(nil),         // 48: employee.v1.GetManagementChainRequest
+	(*GetManagementChainResponse)(nil),        // 49: employee.v1.GetManagementChainResponse
+	(*ExportOrgChartRequest)(nil),             // 50: employee.v1.ExportOrgChartRequest
+	(*ExportOrgChartResponse)(nil),            // 51: employee.v1.ExportOrgChartResponse
+	(*timestamppb.Timestamp)(nil),             // 52: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),             // 53: google.protobuf.FieldMask
 }
 var file_proto_employee_v1_employee_proto_depIdxs = []int32{
-	8, // 0: employee.v1.Employee.created_at:type_name -> google.protobuf.Timestamp
-	8, // 1: employee.v1.Employee.updated_at:type_name -> google.protobuf.Timestamp
-	1, // 2: employee.v1.ListEmployeesResponse.employees:type_name -> employee.v1.Employee
-	0, // 3: employee.v1.EmployeeService.CreateEmployee:input_type -> employee.v1.CreateEmployeeRequest
-	2, // 4: employee.v1.EmployeeService.GetEmployee:input_type -> employee.v1.GetEmployeeRequest
-	3, // 5: employee.v1.EmployeeService.ListEmployees:input_type -> employee.v1.ListEmployeesRequest
-	5, // 6: employee.v1.EmployeeService.UpdateEmployee:input_type -> employee.v1.UpdateEmployeeRequest
-	6, // 7: employee.v1.EmployeeService.DeleteEmployee:input_type -> employee.v1.DeleteEmployeeRequest
-	1, // 8: employee.v1.EmployeeService.CreateEmployee:output_type -> employee.v1.Employee
-	1, // 9: employee.v1.EmployeeService.GetEmployee:output_type -> employee.v1.Employee
-	4, // 10: employee.v1.EmployeeService.ListEmployees:output_type -> employee.v1.ListEmployeesResponse
-	1, // 11: employee.v1.EmployeeService.UpdateEmployee:output_type -> employee.v1.Employee
-	7, // 12: employee.v1.EmployeeService.DeleteEmployee:output_type -> employee.v1.DeleteEmployeeResponse
-	8, // [8:13] is the sub-list for method output_type
-	3, // [3:8] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	52, // 0: employee.v1.CreateEmployeeRequest.hire_date:type_name -> google.protobuf.Timestamp
+	0,  // 1: employee.v1.CreateEmployeeRequest.employment_type:type_name -> employee.v1.EmploymentType
+	52, // 2: employee.v1.Employee.created_at:type_name -> google.protobuf.Timestamp
+	52, // 3: employee.v1.Employee.updated_at:type_name -> google.protobuf.Timestamp
+	52, // 4: employee.v1.Employee.deleted_at:type_name -> google.protobuf.Timestamp
+	52, // 5: employee.v1.Employee.hire_date:type_name -> google.protobuf.Timestamp
+	0,  // 6: employee.v1.Employee.employment_type:type_name -> employee.v1.EmploymentType
+	1,  // 7: employee.v1.Employee.status:type_name -> employee.v1.EmploymentStatus
+	2,  // 8: employee.v1.Employee.termination_reason:type_name -> employee.v1.TerminationReason
+	52, // 9: employee.v1.Employee.last_working_day:type_name -> google.protobuf.Timestamp
+	52, // 10: employee.v1.ListEmployeesRequest.created_after:type_name -> google.protobuf.Timestamp
+	52, // 11: employee.v1.ListEmployeesRequest.created_before:type_name -> google.protobuf.Timestamp
+	52, // 12: employee.v1.ListEmployeesRequest.updated_after:type_name -> google.protobuf.Timestamp
+	52, // 13: employee.v1.ListEmployeesRequest.updated_before:type_name -> google.protobuf.Timestamp
+	3,  // 14: employee.v1.ListEmployeesRequest.sort_order:type_name -> employee.v1.SortOrder
+	1,  // 15: employee.v1.ListEmployeesRequest.statuses:type_name -> employee.v1.EmploymentStatus
+	10, // 16: employee.v1.ListEmployeesResponse.employees:type_name -> employee.v1.Employee
+	10, // 17: employee.v1.EmployeeSearchResult.employee:type_name -> employee.v1.Employee
+	15, // 18: employee.v1.SearchEmployeesResponse.results:type_name -> employee.v1.EmployeeSearchResult
+	53, // 19: employee.v1.UpdateEmployeeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	52, // 20: employee.v1.UpdateEmployeeRequest.hire_date:type_name -> google.protobuf.Timestamp
+	0,  // 21: employee.v1.UpdateEmployeeRequest.employment_type:type_name -> employee.v1.EmploymentType
+	1,  // 22: employee.v1.UpdateEmployeeRequest.status:type_name -> employee.v1.EmploymentStatus
+	2,  // 23: employee.v1.TerminateEmployeeRequest.reason:type_name -> employee.v1.TerminationReason
+	52, // 24: employee.v1.TerminateEmployeeRequest.last_working_day:type_name -> google.protobuf.Timestamp
+	52, // 25: employee.v1.RehireEmployeeRequest.hire_date:type_name -> google.protobuf.Timestamp
+	0,  // 26: employee.v1.RehireEmployeeRequest.employment_type:type_name -> employee.v1.EmploymentType
+	10, // 27: employee.v1.ListDeletedEmployeesResponse.employees:type_name -> employee.v1.Employee
+	4,  // 28: employee.v1.EmployeeChange.action:type_name -> employee.v1.ChangeAction
+	52, // 29: employee.v1.EmployeeChange.occurred_at:type_name -> google.protobuf.Timestamp
+	28, // 30: employee.v1.EmployeeChange.changes:type_name -> employee.v1.FieldChange
+	5,  // 31: employee.v1.WatchEmployeesRequest.types:type_name -> employee.v1.EmployeeEventType
+	5,  // 32: employee.v1.EmployeeEvent.type:type_name -> employee.v1.EmployeeEventType
+	10, // 33: employee.v1.EmployeeEvent.employee:type_name -> employee.v1.Employee
+	52, // 34: employee.v1.EmployeeEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	28, // 35: employee.v1.EmployeeEvent.changes:type_name -> employee.v1.FieldChange
+	29, // 36: employee.v1.GetEmployeeHistoryResponse.changes:type_name -> employee.v1.EmployeeChange
+	6,  // 37: employee.v1.CompensationRecord.reason:type_name -> employee.v1.CompensationReason
+	52, // 38: employee.v1.CompensationRecord.effective_from:type_name -> google.protobuf.Timestamp
+	52, // 39: employee.v1.CompensationRecord.effective_to:type_name -> google.protobuf.Timestamp
+	52, // 40: employee.v1.CompensationRecord.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 41: employee.v1.ScheduleCompensationChangeRequest.reason:type_name -> employee.v1.CompensationReason
+	52, // 42: employee.v1.ScheduleCompensationChangeRequest.effective_from:type_name -> google.protobuf.Timestamp
+	33, // 43: employee.v1.ListCompensationHistoryResponse.records:type_name -> employee.v1.CompensationRecord
+	38, // 44: employee.v1.ImportEmployeesRequest.options:type_name -> employee.v1.ImportOptions
+	39, // 45: employee.v1.ImportEmployeesResponse.errors:type_name -> employee.v1.ImportRowError
+	7,  // 46: employee.v1.ExportEmployeesRequest.format:type_name -> employee.v1.ExportFormat
+	10, // 47: employee.v1.ListDirectReportsResponse.employees:type_name -> employee.v1.Employee
+	10, // 48: employee.v1.OrgNode.employee:type_name -> employee.v1.Employee
+	46, // 49: employee.v1.ListReportsResponse.reports:type_name -> employee.v1.OrgNode
+	10, // 50: employee.v1.GetManagementChainResponse.managers:type_name -> employee.v1.Employee
+	8,  // 51: employee.v1.ExportOrgChartRequest.format:type_name -> employee.v1.OrgChartFormat
+	9,  // 52: employee.v1.EmployeeService.CreateEmployee:input_type -> employee.v1.CreateEmployeeRequest
+	11, // 53: employee.v1.EmployeeService.GetEmployee:input_type -> employee.v1.GetEmployeeRequest
+	12, // 54: employee.v1.EmployeeService.ListEmployees:input_type -> employee.v1.ListEmployeesRequest
+	14, // 55: employee.v1.EmployeeService.SearchEmployees:input_type -> employee.v1.SearchEmployeesRequest
+	17, // 56: employee.v1.EmployeeService.UpdateEmployee:input_type -> employee.v1.UpdateEmployeeRequest
+	18, // 57: employee.v1.EmployeeService.DeleteEmployee:input_type -> employee.v1.DeleteEmployeeRequest
+	20, // 58: employee.v1.EmployeeService.TerminateEmployee:input_type -> employee.v1.TerminateEmployeeRequest
+	21, // 59: employee.v1.EmployeeService.RehireEmployee:input_type -> employee.v1.RehireEmployeeRequest
+	27, // 60: employee.v1.EmployeeService.GetEmployeeHistory:input_type -> employee.v1.GetEmployeeHistoryRequest
+	22, // 61: employee.v1.EmployeeService.ListDeletedEmployees:input_type -> employee.v1.ListDeletedEmployeesRequest
+	24, // 62: employee.v1.EmployeeService.RestoreEmployee:input_type -> employee.v1.RestoreEmployeeRequest
+	25, // 63: employee.v1.EmployeeService.PurgeEmployee:input_type -> employee.v1.PurgeEmployeeRequest
+	34, // 64: employee.v1.EmployeeService.ScheduleCompensationChange:input_type -> employee.v1.ScheduleCompensationChangeRequest
+	35, // 65: employee.v1.EmployeeService.ListCompensationHistory:input_type -> employee.v1.ListCompensationHistoryRequest
+	37, // 66: employee.v1.EmployeeService.ImportEmployees:input_type -> employee.v1.ImportEmployeesRequest
+	41, // 67: employee.v1.EmployeeService.ExportEmployees:input_type -> employee.v1.ExportEmployeesRequest
+	43, // 68: employee.v1.EmployeeService.ListDirectReports:input_type -> employee.v1.ListDirectReportsRequest
+	45, // 69: employee.v1.EmployeeService.ListReports:input_type -> employee.v1.ListReportsRequest
+	48, // 70: employee.v1.EmployeeService.GetManagementChain:input_type -> employee.v1.GetManagementChainRequest
+	50, // 71: employee.v1.EmployeeService.ExportOrgChart:input_type -> employee.v1.ExportOrgChartRequest
+	30, // 72: employee.v1.EmployeeService.WatchEmployees:input_type -> employee.v1.WatchEmployeesRequest
+	10, // 73: employee.v1.EmployeeService.CreateEmployee:output_type -> employee.v1.Employee
+	10, // 74: employee.v1.EmployeeService.GetEmployee:output_type -> employee.v1.Employee
+	13, // 75: employee.v1.EmployeeService.ListEmployees:output_type -> employee.v1.ListEmployeesResponse
+	16, // 76: employee.v1.EmployeeService.SearchEmployees:output_type -> employee.v1.SearchEmployeesResponse
+	10, // 77: employee.v1.EmployeeService.UpdateEmployee:output_type -> employee.v1.Employee
+	19, // 78: employee.v1.EmployeeService.DeleteEmployee:output_type -> employee.v1.DeleteEmployeeResponse
+	10, // 79: employee.v1.EmployeeService.TerminateEmployee:output_type -> employee.v1.Employee
+	10, // 80: employee.v1.EmployeeService.RehireEmployee:output_type -> employee.v1.Employee
+	32, // 81: employee.v1.EmployeeService.GetEmployeeHistory:output_type -> employee.v1.GetEmployeeHistoryResponse
+	23, // 82: employee.v1.EmployeeService.ListDeletedEmployees:output_type -> employee.v1.ListDeletedEmployeesResponse
+	10, // 83: employee.v1.EmployeeService.RestoreEmployee:output_type -> employee.v1.Employee
+	26, // 84: employee.v1.EmployeeService.PurgeEmployee:output_type -> employee.v1.PurgeEmployeeResponse
+	33, // 85: employee.v1.EmployeeService.ScheduleCompensationChange:output_type -> employee.v1.CompensationRecord
+	36, // 86: employee.v1.EmployeeService.ListCompensationHistory:output_type -> employee.v1.ListCompensationHistoryResponse
+	40, // 87: employee.v1.EmployeeService.ImportEmployees:output_type -> employee.v1.ImportEmployeesResponse
+	42, // 88: employee.v1.EmployeeService.ExportEmployees:output_type -> employee.v1.ExportEmployeesResponse
+	44, // 89: employee.v1.EmployeeService.ListDirectReports:output_type -> employee.v1.ListDirectReportsResponse
+	47, // 90: employee.v1.EmployeeService.ListReports:output_type -> employee.v1.ListReportsResponse
+	49, // 91: employee.v1.EmployeeService.GetManagementChain:output_type -> employee.v1.GetManagementChainResponse
+	51, // 92: employee.v1.EmployeeService.ExportOrgChart:output_type -> employee.v1.ExportOrgChartResponse
+	31, // 93: employee.v1.EmployeeService.WatchEmployees:output_type -> employee.v1.EmployeeEvent
+	73, // [73:94] is the sub-list for method output_type
+	52, // [52:73] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_proto_employee_v1_employee_proto_init() }
@@ -618,18 +4050,24 @@ func file_proto_employee_v1_employee_proto_init() {
 	if File_proto_employee_v1_employee_proto != nil {
 		return
 	}
+	file_proto_employee_v1_employee_proto_msgTypes[19].OneofWrappers = []any{}
+	file_proto_employee_v1_employee_proto_msgTypes[28].OneofWrappers = []any{
+		(*ImportEmployeesRequest_Options)(nil),
+		(*ImportEmployeesRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_employee_v1_employee_proto_rawDesc), len(file_proto_employee_v1_employee_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      9,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_employee_v1_employee_proto_goTypes,
 		DependencyIndexes: file_proto_employee_v1_employee_proto_depIdxs,
+		EnumInfos:         file_proto_employee_v1_employee_proto_enumTypes,
 		MessageInfos:      file_proto_employee_v1_employee_proto_msgTypes,
 	}.Build()
 	File_proto_employee_v1_employee_proto = out.File
@@ -19,11 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EmployeeService_CreateEmployee_FullMethodName = "/employee.v1.EmployeeService/CreateEmployee"
-	EmployeeService_GetEmployee_FullMethodName    = "/employee.v1.EmployeeService/GetEmployee"
-	EmployeeService_ListEmployees_FullMethodName  = "/employee.v1.EmployeeService/ListEmployees"
-	EmployeeService_UpdateEmployee_FullMethodName = "/employee.v1.EmployeeService/UpdateEmployee"
-	EmployeeService_DeleteEmployee_FullMethodName = "/employee.v1.EmployeeService/DeleteEmployee"
+	EmployeeService_CreateEmployee_FullMethodName             = "/employee.v1.EmployeeService/CreateEmployee"
+	EmployeeService_GetEmployee_FullMethodName                = "/employee.v1.EmployeeService/GetEmployee"
+	EmployeeService_ListEmployees_FullMethodName              = "/employee.v1.EmployeeService/ListEmployees"
+	EmployeeService_SearchEmployees_FullMethodName            = "/employee.v1.EmployeeService/SearchEmployees"
+	EmployeeService_UpdateEmployee_FullMethodName             = "/employee.v1.EmployeeService/UpdateEmployee"
+	EmployeeService_DeleteEmployee_FullMethodName             = "/employee.v1.EmployeeService/DeleteEmployee"
+	EmployeeService_TerminateEmployee_FullMethodName          = "/employee.v1.EmployeeService/TerminateEmployee"
+	EmployeeService_RehireEmployee_FullMethodName             = "/employee.v1.EmployeeService/RehireEmployee"
+	EmployeeService_GetEmployeeHistory_FullMethodName         = "/employee.v1.EmployeeService/GetEmployeeHistory"
+	EmployeeService_ListDeletedEmployees_FullMethodName       = "/employee.v1.EmployeeService/ListDeletedEmployees"
+	EmployeeService_RestoreEmployee_FullMethodName            = "/employee.v1.EmployeeService/RestoreEmployee"
+	EmployeeService_PurgeEmployee_FullMethodName              = "/employee.v1.EmployeeService/PurgeEmployee"
+	EmployeeService_ScheduleCompensationChange_FullMethodName = "/employee.v1.EmployeeService/ScheduleCompensationChange"
+	EmployeeService_ListCompensationHistory_FullMethodName    = "/employee.v1.EmployeeService/ListCompensationHistory"
+	EmployeeService_ImportEmployees_FullMethodName            = "/employee.v1.EmployeeService/ImportEmployees"
+	EmployeeService_ExportEmployees_FullMethodName            = "/employee.v1.EmployeeService/ExportEmployees"
+	EmployeeService_ListDirectReports_FullMethodName          = "/employee.v1.EmployeeService/ListDirectReports"
+	EmployeeService_ListReports_FullMethodName                = "/employee.v1.EmployeeService/ListReports"
+	EmployeeService_GetManagementChain_FullMethodName         = "/employee.v1.EmployeeService/GetManagementChain"
+	EmployeeService_ExportOrgChart_FullMethodName             = "/employee.v1.EmployeeService/ExportOrgChart"
+	EmployeeService_WatchEmployees_FullMethodName             = "/employee.v1.EmployeeService/WatchEmployees"
 )
 
 // EmployeeServiceClient is the client API for EmployeeService service.
@@ -33,8 +49,47 @@ type EmployeeServiceClient interface {
 	CreateEmployee(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
 	GetEmployee(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
 	ListEmployees(ctx context.Context, in *ListEmployeesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error)
+	// SearchEmployees finds employees by partial or misspelled full name or
+	// job title, most relevant first.
+	SearchEmployees(ctx context.Context, in *SearchEmployeesRequest, opts ...grpc.CallOption) (*SearchEmployeesResponse, error)
 	UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
 	DeleteEmployee(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*DeleteEmployeeResponse, error)
+	// TerminateEmployee ends the employment of an active or on-leave employee.
+	// The employee is kept, with its history, and can be rehired.
+	TerminateEmployee(ctx context.Context, in *TerminateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
+	// RehireEmployee starts a new employment for a terminated employee.
+	RehireEmployee(ctx context.Context, in *RehireEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
+	GetEmployeeHistory(ctx context.Context, in *GetEmployeeHistoryRequest, opts ...grpc.CallOption) (*GetEmployeeHistoryResponse, error)
+	// ListDeletedEmployees, RestoreEmployee and PurgeEmployee manage
+	// soft-deleted employees. They are restricted to admins.
+	ListDeletedEmployees(ctx context.Context, in *ListDeletedEmployeesRequest, opts ...grpc.CallOption) (*ListDeletedEmployeesResponse, error)
+	RestoreEmployee(ctx context.Context, in *RestoreEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
+	// PurgeEmployee permanently removes a deleted employee. Its history is kept.
+	PurgeEmployee(ctx context.Context, in *PurgeEmployeeRequest, opts ...grpc.CallOption) (*PurgeEmployeeResponse, error)
+	// ScheduleCompensationChange sets an employee's gross salary from
+	// effective_from on. A change effective now is applied immediately; a
+	// future-dated one when it takes effect.
+	ScheduleCompensationChange(ctx context.Context, in *ScheduleCompensationChangeRequest, opts ...grpc.CallOption) (*CompensationRecord, error)
+	// ListCompensationHistory returns an employee's compensation timeline,
+	// including scheduled changes.
+	ListCompensationHistory(ctx context.Context, in *ListCompensationHistoryRequest, opts ...grpc.CallOption) (*ListCompensationHistoryResponse, error)
+	// ImportEmployees creates employees from a CSV file streamed in chunks.
+	ImportEmployees(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportEmployeesRequest, ImportEmployeesResponse], error)
+	// ExportEmployees streams the matching employees as a file in chunks.
+	ExportEmployees(ctx context.Context, in *ExportEmployeesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportEmployeesResponse], error)
+	// ListDirectReports returns the employees reporting directly to a manager.
+	ListDirectReports(ctx context.Context, in *ListDirectReportsRequest, opts ...grpc.CallOption) (*ListDirectReportsResponse, error)
+	// ListReports returns everyone below a manager, directly or indirectly.
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	// GetManagementChain returns the managers above an employee up to the top
+	// of the organisation.
+	GetManagementChain(ctx context.Context, in *GetManagementChainRequest, opts ...grpc.CallOption) (*GetManagementChainResponse, error)
+	// ExportOrgChart renders the reporting lines as a Graphviz or JSON file.
+	ExportOrgChart(ctx context.Context, in *ExportOrgChartRequest, opts ...grpc.CallOption) (*ExportOrgChartResponse, error)
+	// WatchEmployees streams committed employee changes in commit order,
+	// starting after the event with after_sequence, and keeps the stream open
+	// for new ones.
+	WatchEmployees(ctx context.Context, in *WatchEmployeesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EmployeeEvent], error)
 }
 
 type employeeServiceClient struct {
@@ -75,6 +130,16 @@ func (c *employeeServiceClient) ListEmployees(ctx context.Context, in *ListEmplo
 	return out, nil
 }
 
+func (c *employeeServiceClient) SearchEmployees(ctx context.Context, in *SearchEmployeesRequest, opts ...grpc.CallOption) (*SearchEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_SearchEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *employeeServiceClient) UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Employee)
@@ -95,6 +160,177 @@ func (c *employeeServiceClient) DeleteEmployee(ctx context.Context, in *DeleteEm
 	return out, nil
 }
 
+func (c *employeeServiceClient) TerminateEmployee(ctx context.Context, in *TerminateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Employee)
+	err := c.cc.Invoke(ctx, EmployeeService_TerminateEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) RehireEmployee(ctx context.Context, in *RehireEmployeeRequest, opts ...grpc.CallOption) (*Employee, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Employee)
+	err := c.cc.Invoke(ctx, EmployeeService_RehireEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetEmployeeHistory(ctx context.Context, in *GetEmployeeHistoryRequest, opts ...grpc.CallOption) (*GetEmployeeHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmployeeHistoryResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetEmployeeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListDeletedEmployees(ctx context.Context, in *ListDeletedEmployeesRequest, opts ...grpc.CallOption) (*ListDeletedEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListDeletedEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) RestoreEmployee(ctx context.Context, in *RestoreEmployeeRequest, opts ...grpc.CallOption) (*Employee, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Employee)
+	err := c.cc.Invoke(ctx, EmployeeService_RestoreEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) PurgeEmployee(ctx context.Context, in *PurgeEmployeeRequest, opts ...grpc.CallOption) (*PurgeEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_PurgeEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ScheduleCompensationChange(ctx context.Context, in *ScheduleCompensationChangeRequest, opts ...grpc.CallOption) (*CompensationRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompensationRecord)
+	err := c.cc.Invoke(ctx, EmployeeService_ScheduleCompensationChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListCompensationHistory(ctx context.Context, in *ListCompensationHistoryRequest, opts ...grpc.CallOption) (*ListCompensationHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompensationHistoryResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListCompensationHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ImportEmployees(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportEmployeesRequest, ImportEmployeesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EmployeeService_ServiceDesc.Streams[0], EmployeeService_ImportEmployees_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportEmployeesRequest, ImportEmployeesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_ImportEmployeesClient = grpc.ClientStreamingClient[ImportEmployeesRequest, ImportEmployeesResponse]
+
+func (c *employeeServiceClient) ExportEmployees(ctx context.Context, in *ExportEmployeesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportEmployeesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EmployeeService_ServiceDesc.Streams[1], EmployeeService_ExportEmployees_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportEmployeesRequest, ExportEmployeesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_ExportEmployeesClient = grpc.ServerStreamingClient[ExportEmployeesResponse]
+
+func (c *employeeServiceClient) ListDirectReports(ctx context.Context, in *ListDirectReportsRequest, opts ...grpc.CallOption) (*ListDirectReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDirectReportsResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListDirectReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetManagementChain(ctx context.Context, in *GetManagementChainRequest, opts ...grpc.CallOption) (*GetManagementChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetManagementChainResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetManagementChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ExportOrgChart(ctx context.Context, in *ExportOrgChartRequest, opts ...grpc.CallOption) (*ExportOrgChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportOrgChartResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ExportOrgChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) WatchEmployees(ctx context.Context, in *WatchEmployeesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EmployeeEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EmployeeService_ServiceDesc.Streams[2], EmployeeService_WatchEmployees_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEmployeesRequest, EmployeeEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_WatchEmployeesClient = grpc.ServerStreamingClient[EmployeeEvent]
+
 // EmployeeServiceServer is the server API for EmployeeService service.
 // All implementations must embed UnimplementedEmployeeServiceServer
 // for forward compatibility.
@@ -102,8 +338,47 @@ type EmployeeServiceServer interface {
 	CreateEmployee(context.Context, *CreateEmployeeRequest) (*Employee, error)
 	GetEmployee(context.Context, *GetEmployeeRequest) (*Employee, error)
 	ListEmployees(context.Context, *ListEmployeesRequest) (*ListEmployeesResponse, error)
+	// SearchEmployees finds employees by partial or misspelled full name or
+	// job title, most relevant first.
+	SearchEmployees(context.Context, *SearchEmployeesRequest) (*SearchEmployeesResponse, error)
 	UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*Employee, error)
 	DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error)
+	// TerminateEmployee ends the employment of an active or on-leave employee.
+	// The employee is kept, with its history, and can be rehired.
+	TerminateEmployee(context.Context, *TerminateEmployeeRequest) (*Employee, error)
+	// RehireEmployee starts a new employment for a terminated employee.
+	RehireEmployee(context.Context, *RehireEmployeeRequest) (*Employee, error)
+	GetEmployeeHistory(context.Context, *GetEmployeeHistoryRequest) (*GetEmployeeHistoryResponse, error)
+	// ListDeletedEmployees, RestoreEmployee and PurgeEmployee manage
+	// soft-deleted employees. They are restricted to admins.
+	ListDeletedEmployees(context.Context, *ListDeletedEmployeesRequest) (*ListDeletedEmployeesResponse, error)
+	RestoreEmployee(context.Context, *RestoreEmployeeRequest) (*Employee, error)
+	// PurgeEmployee permanently removes a deleted employee. Its history is kept.
+	PurgeEmployee(context.Context, *PurgeEmployeeRequest) (*PurgeEmployeeResponse, error)
+	// ScheduleCompensationChange sets an employee's gross salary from
+	// effective_from on. A change effective now is applied immediately; a
+	// future-dated one when it takes effect.
+	ScheduleCompensationChange(context.Context, *ScheduleCompensationChangeRequest) (*CompensationRecord, error)
+	// ListCompensationHistory returns an employee's compensation timeline,
+	// including scheduled changes.
+	ListCompensationHistory(context.Context, *ListCompensationHistoryRequest) (*ListCompensationHistoryResponse, error)
+	// ImportEmployees creates employees from a CSV file streamed in chunks.
+	ImportEmployees(grpc.ClientStreamingServer[ImportEmployeesRequest, ImportEmployeesResponse]) error
+	// ExportEmployees streams the matching employees as a file in chunks.
+	ExportEmployees(*ExportEmployeesRequest, grpc.ServerStreamingServer[ExportEmployeesResponse]) error
+	// ListDirectReports returns the employees reporting directly to a manager.
+	ListDirectReports(context.Context, *ListDirectReportsRequest) (*ListDirectReportsResponse, error)
+	// ListReports returns everyone below a manager, directly or indirectly.
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	// GetManagementChain returns the managers above an employee up to the top
+	// of the organisation.
+	GetManagementChain(context.Context, *GetManagementChainRequest) (*GetManagementChainResponse, error)
+	// ExportOrgChart renders the reporting lines as a Graphviz or JSON file.
+	ExportOrgChart(context.Context, *ExportOrgChartRequest) (*ExportOrgChartResponse, error)
+	// WatchEmployees streams committed employee changes in commit order,
+	// starting after the event with after_sequence, and keeps the stream open
+	// for new ones.
+	WatchEmployees(*WatchEmployeesRequest, grpc.ServerStreamingServer[EmployeeEvent]) error
 	mustEmbedUnimplementedEmployeeServiceServer()
 }
 
@@ -123,12 +398,60 @@ func (UnimplementedEmployeeServiceServer) GetEmployee(context.Context, *GetEmplo
 func (UnimplementedEmployeeServiceServer) ListEmployees(context.Context, *ListEmployeesRequest) (*ListEmployeesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEmployees not implemented")
 }
+func (UnimplementedEmployeeServiceServer) SearchEmployees(context.Context, *SearchEmployeesRequest) (*SearchEmployeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchEmployees not implemented")
+}
 func (UnimplementedEmployeeServiceServer) UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*Employee, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateEmployee not implemented")
 }
 func (UnimplementedEmployeeServiceServer) DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteEmployee not implemented")
 }
+func (UnimplementedEmployeeServiceServer) TerminateEmployee(context.Context, *TerminateEmployeeRequest) (*Employee, error) {
+	return nil, status.Error(codes.Unimplemented, "method TerminateEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RehireEmployee(context.Context, *RehireEmployeeRequest) (*Employee, error) {
+	return nil, status.Error(codes.Unimplemented, "method RehireEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetEmployeeHistory(context.Context, *GetEmployeeHistoryRequest) (*GetEmployeeHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEmployeeHistory not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListDeletedEmployees(context.Context, *ListDeletedEmployeesRequest) (*ListDeletedEmployeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeletedEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RestoreEmployee(context.Context, *RestoreEmployeeRequest) (*Employee, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) PurgeEmployee(context.Context, *PurgeEmployeeRequest) (*PurgeEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ScheduleCompensationChange(context.Context, *ScheduleCompensationChangeRequest) (*CompensationRecord, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduleCompensationChange not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListCompensationHistory(context.Context, *ListCompensationHistoryRequest) (*ListCompensationHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCompensationHistory not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ImportEmployees(grpc.ClientStreamingServer[ImportEmployeesRequest, ImportEmployeesResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ExportEmployees(*ExportEmployeesRequest, grpc.ServerStreamingServer[ExportEmployeesResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListDirectReports(context.Context, *ListDirectReportsRequest) (*ListDirectReportsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDirectReports not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetManagementChain(context.Context, *GetManagementChainRequest) (*GetManagementChainResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetManagementChain not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ExportOrgChart(context.Context, *ExportOrgChartRequest) (*ExportOrgChartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportOrgChart not implemented")
+}
+func (UnimplementedEmployeeServiceServer) WatchEmployees(*WatchEmployeesRequest, grpc.ServerStreamingServer[EmployeeEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchEmployees not implemented")
+}
 func (UnimplementedEmployeeServiceServer) mustEmbedUnimplementedEmployeeServiceServer() {}
 func (UnimplementedEmployeeServiceServer) testEmbeddedByValue()                         {}
 
@@ -204,6 +527,24 @@ func _EmployeeService_ListEmployees_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EmployeeService_SearchEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).SearchEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_SearchEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).SearchEmployees(ctx, req.(*SearchEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EmployeeService_UpdateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateEmployeeRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +581,251 @@ func _EmployeeService_DeleteEmployee_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EmployeeService_TerminateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).TerminateEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_TerminateEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).TerminateEmployee(ctx, req.(*TerminateEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_RehireEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RehireEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RehireEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RehireEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RehireEmployee(ctx, req.(*RehireEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetEmployeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetEmployeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetEmployeeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetEmployeeHistory(ctx, req.(*GetEmployeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ListDeletedEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListDeletedEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListDeletedEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListDeletedEmployees(ctx, req.(*ListDeletedEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_RestoreEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RestoreEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RestoreEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RestoreEmployee(ctx, req.(*RestoreEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_PurgeEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).PurgeEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_PurgeEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).PurgeEmployee(ctx, req.(*PurgeEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ScheduleCompensationChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleCompensationChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ScheduleCompensationChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ScheduleCompensationChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ScheduleCompensationChange(ctx, req.(*ScheduleCompensationChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ListCompensationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompensationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListCompensationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListCompensationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListCompensationHistory(ctx, req.(*ListCompensationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ImportEmployees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EmployeeServiceServer).ImportEmployees(&grpc.GenericServerStream[ImportEmployeesRequest, ImportEmployeesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_ImportEmployeesServer = grpc.ClientStreamingServer[ImportEmployeesRequest, ImportEmployeesResponse]
+
+func _EmployeeService_ExportEmployees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportEmployeesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EmployeeServiceServer).ExportEmployees(m, &grpc.GenericServerStream[ExportEmployeesRequest, ExportEmployeesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_ExportEmployeesServer = grpc.ServerStreamingServer[ExportEmployeesResponse]
+
+func _EmployeeService_ListDirectReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirectReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListDirectReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListDirectReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListDirectReports(ctx, req.(*ListDirectReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetManagementChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManagementChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetManagementChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetManagementChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetManagementChain(ctx, req.(*GetManagementChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ExportOrgChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOrgChartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ExportOrgChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ExportOrgChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ExportOrgChart(ctx, req.(*ExportOrgChartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_WatchEmployees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEmployeesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EmployeeServiceServer).WatchEmployees(m, &grpc.GenericServerStream[WatchEmployeesRequest, EmployeeEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_WatchEmployeesServer = grpc.ServerStreamingServer[EmployeeEvent]
+
 // EmployeeService_ServiceDesc is the grpc.ServiceDesc for EmployeeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -259,6 +845,10 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEmployees",
 			Handler:    _EmployeeService_ListEmployees_Handler,
 		},
+		{
+			MethodName: "SearchEmployees",
+			Handler:    _EmployeeService_SearchEmployees_Handler,
+		},
 		{
 			MethodName: "UpdateEmployee",
 			Handler:    _EmployeeService_UpdateEmployee_Handler,
@@ -267,7 +857,71 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEmployee",
 			Handler:    _EmployeeService_DeleteEmployee_Handler,
 		},
+		{
+			MethodName: "TerminateEmployee",
+			Handler:    _EmployeeService_TerminateEmployee_Handler,
+		},
+		{
+			MethodName: "RehireEmployee",
+			Handler:    _EmployeeService_RehireEmployee_Handler,
+		},
+		{
+			MethodName: "GetEmployeeHistory",
+			Handler:    _EmployeeService_GetEmployeeHistory_Handler,
+		},
+		{
+			MethodName: "ListDeletedEmployees",
+			Handler:    _EmployeeService_ListDeletedEmployees_Handler,
+		},
+		{
+			MethodName: "RestoreEmployee",
+			Handler:    _EmployeeService_RestoreEmployee_Handler,
+		},
+		{
+			MethodName: "PurgeEmployee",
+			Handler:    _EmployeeService_PurgeEmployee_Handler,
+		},
+		{
+			MethodName: "ScheduleCompensationChange",
+			Handler:    _EmployeeService_ScheduleCompensationChange_Handler,
+		},
+		{
+			MethodName: "ListCompensationHistory",
+			Handler:    _EmployeeService_ListCompensationHistory_Handler,
+		},
+		{
+			MethodName: "ListDirectReports",
+			Handler:    _EmployeeService_ListDirectReports_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _EmployeeService_ListReports_Handler,
+		},
+		{
+			MethodName: "GetManagementChain",
+			Handler:    _EmployeeService_GetManagementChain_Handler,
+		},
+		{
+			MethodName: "ExportOrgChart",
+			Handler:    _EmployeeService_ExportOrgChart_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportEmployees",
+			Handler:       _EmployeeService_ImportEmployees_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportEmployees",
+			Handler:       _EmployeeService_ExportEmployees_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchEmployees",
+			Handler:       _EmployeeService_WatchEmployees_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/employee/v1/employee.proto",
 }
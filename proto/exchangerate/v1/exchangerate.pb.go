@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: proto/exchangerate/v1/exchangerate.proto
+
+package exchangeratev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExchangeRate is the number of units of quote_currency one unit of
+// base_currency buys, from valid_from (a UTC date) until the next rate for the
+// pair. A rate also converts in the opposite direction.
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_proto_exchangerate_v1_exchangerate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchangerate_v1_exchangerate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_proto_exchangerate_v1_exchangerate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExchangeRate) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+type SetExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRatesRequest) Reset() {
+	*x = SetExchangeRatesRequest{}
+	mi := &file_proto_exchangerate_v1_exchangerate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRatesRequest) ProtoMessage() {}
+
+func (x *SetExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchangerate_v1_exchangerate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchangerate_v1_exchangerate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetExchangeRatesRequest) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type SetExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stored        int32                  `protobuf:"varint,1,opt,name=stored,proto3" json:"stored,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRatesResponse) Reset() {
+	*x = SetExchangeRatesResponse{}
+	mi := &file_proto_exchangerate_v1_exchangerate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRatesResponse) ProtoMessage() {}
+
+func (x *SetExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchangerate_v1_exchangerate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*SetExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_exchangerate_v1_exchangerate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetExchangeRatesResponse) GetStored() int32 {
+	if x != nil {
+		return x.Stored
+	}
+	return 0
+}
+
+type ListExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_proto_exchangerate_v1_exchangerate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchangerate_v1_exchangerate_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchangerate_v1_exchangerate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListExchangeRatesRequest) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type ListExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_proto_exchangerate_v1_exchangerate_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchangerate_v1_exchangerate_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_exchangerate_v1_exchangerate_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+var File_proto_exchangerate_v1_exchangerate_proto protoreflect.FileDescriptor
+
+const file_proto_exchangerate_v1_exchangerate_proto_rawDesc = "" +
+	"\n" +
+	"(proto/exchangerate/v1/exchangerate.proto\x12\x0fexchangerate.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa9\x01\n" +
+	"\fExchangeRate\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x129\n" +
+	"\n" +
+	"valid_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\"N\n" +
+	"\x17SetExchangeRatesRequest\x123\n" +
+	"\x05rates\x18\x01 \x03(\v2\x1d.exchangerate.v1.ExchangeRateR\x05rates\"2\n" +
+	"\x18SetExchangeRatesResponse\x12\x16\n" +
+	"\x06stored\x18\x01 \x01(\x05R\x06stored\"?\n" +
+	"\x18ListExchangeRatesRequest\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\"P\n" +
+	"\x19ListExchangeRatesResponse\x123\n" +
+	"\x05rates\x18\x01 \x03(\v2\x1d.exchangerate.v1.ExchangeRateR\x05rates2\xea\x01\n" +
+	"\x13ExchangeRateService\x12g\n" +
+	"\x10SetExchangeRates\x12(.exchangerate.v1.SetExchangeRatesRequest\x1a).exchangerate.v1.SetExchangeRatesResponse\x12j\n" +
+	"\x11ListExchangeRates\x12).exchangerate.v1.ListExchangeRatesRequest\x1a*.exchangerate.v1.ListExchangeRatesResponseB>Z<github.com/employee-api/proto/exchangerate/v1;exchangeratev1b\x06proto3"
+
+var (
+	file_proto_exchangerate_v1_exchangerate_proto_rawDescOnce sync.Once
+	file_proto_exchangerate_v1_exchangerate_proto_rawDescData []byte
+)
+
+func file_proto_exchangerate_v1_exchangerate_proto_rawDescGZIP() []byte {
+	file_proto_exchangerate_v1_exchangerate_proto_rawDescOnce.Do(func() {
+		file_proto_exchangerate_v1_exchangerate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_exchangerate_v1_exchangerate_proto_rawDesc), len(file_proto_exchangerate_v1_exchangerate_proto_rawDesc)))
+	})
+	return file_proto_exchangerate_v1_exchangerate_proto_rawDescData
+}
+
+var file_proto_exchangerate_v1_exchangerate_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_exchangerate_v1_exchangerate_proto_goTypes = []any{
+	(*ExchangeRate)(nil),              // 0: exchangerate.v1.ExchangeRate
+	(*SetExchangeRatesRequest)(nil),   // 1: exchangerate.v1.SetExchangeRatesRequest
+	(*SetExchangeRatesResponse)(nil),  // 2: exchangerate.v1.SetExchangeRatesResponse
+	(*ListExchangeRatesRequest)(nil),  // 3: exchangerate.v1.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil), // 4: exchangerate.v1.ListExchangeRatesResponse
+	(*timestamppb.Timestamp)(nil),     // 5: google.protobuf.Timestamp
+}
+var file_proto_exchangerate_v1_exchangerate_proto_depIdxs = []int32{
+	5, // 0: exchangerate.v1.ExchangeRate.valid_from:type_name -> google.protobuf.Timestamp
+	0, // 1: exchangerate.v1.SetExchangeRatesRequest.rates:type_name -> exchangerate.v1.ExchangeRate
+	0, // 2: exchangerate.v1.ListExchangeRatesResponse.rates:type_name -> exchangerate.v1.ExchangeRate
+	1, // 3: exchangerate.v1.ExchangeRateService.SetExchangeRates:input_type -> exchangerate.v1.SetExchangeRatesRequest
+	3, // 4: exchangerate.v1.ExchangeRateService.ListExchangeRates:input_type -> exchangerate.v1.ListExchangeRatesRequest
+	2, // 5: exchangerate.v1.ExchangeRateService.SetExchangeRates:output_type -> exchangerate.v1.SetExchangeRatesResponse
+	4, // 6: exchangerate.v1.ExchangeRateService.ListExchangeRates:output_type -> exchangerate.v1.ListExchangeRatesResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_exchangerate_v1_exchangerate_proto_init() }
+func file_proto_exchangerate_v1_exchangerate_proto_init() {
+	if File_proto_exchangerate_v1_exchangerate_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_exchangerate_v1_exchangerate_proto_rawDesc), len(file_proto_exchangerate_v1_exchangerate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_exchangerate_v1_exchangerate_proto_goTypes,
+		DependencyIndexes: file_proto_exchangerate_v1_exchangerate_proto_depIdxs,
+		MessageInfos:      file_proto_exchangerate_v1_exchangerate_proto_msgTypes,
+	}.Build()
+	File_proto_exchangerate_v1_exchangerate_proto = out.File
+	file_proto_exchangerate_v1_exchangerate_proto_goTypes = nil
+	file_proto_exchangerate_v1_exchangerate_proto_depIdxs = nil
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.4
+// source: proto/exchangerate/v1/exchangerate.proto
+
+package exchangeratev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ExchangeRateService_SetExchangeRates_FullMethodName  = "/exchangerate.v1.ExchangeRateService/SetExchangeRates"
+	ExchangeRateService_ListExchangeRates_FullMethodName = "/exchangerate.v1.ExchangeRateService/ListExchangeRates"
+)
+
+// ExchangeRateServiceClient is the client API for ExchangeRateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ExchangeRateService administers the exchange rates used to report salaries
+// in a common currency
+type ExchangeRateServiceClient interface {
+	// SetExchangeRates stores rates, replacing any already stored for the same pair and date
+	SetExchangeRates(ctx context.Context, in *SetExchangeRatesRequest, opts ...grpc.CallOption) (*SetExchangeRatesResponse, error)
+	// ListExchangeRates returns stored rates, optionally for one base currency
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+}
+
+type exchangeRateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExchangeRateServiceClient(cc grpc.ClientConnInterface) ExchangeRateServiceClient {
+	return &exchangeRateServiceClient{cc}
+}
+
+func (c *exchangeRateServiceClient) SetExchangeRates(ctx context.Context, in *SetExchangeRatesRequest, opts ...grpc.CallOption) (*SetExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, ExchangeRateService_SetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, ExchangeRateService_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExchangeRateServiceServer is the server API for ExchangeRateService service.
+// All implementations must embed UnimplementedExchangeRateServiceServer
+// for forward compatibility.
+//
+// ExchangeRateService administers the exchange rates used to report salaries
+// in a common currency
+type ExchangeRateServiceServer interface {
+	// SetExchangeRates stores rates, replacing any already stored for the same pair and date
+	SetExchangeRates(context.Context, *SetExchangeRatesRequest) (*SetExchangeRatesResponse, error)
+	// ListExchangeRates returns stored rates, optionally for one base currency
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	mustEmbedUnimplementedExchangeRateServiceServer()
+}
+
+// UnimplementedExchangeRateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExchangeRateServiceServer struct{}
+
+func (UnimplementedExchangeRateServiceServer) SetExchangeRates(context.Context, *SetExchangeRatesRequest) (*SetExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetExchangeRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) mustEmbedUnimplementedExchangeRateServiceServer() {}
+func (UnimplementedExchangeRateServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeExchangeRateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExchangeRateServiceServer will
+// result in compilation errors.
+type UnsafeExchangeRateServiceServer interface {
+	mustEmbedUnimplementedExchangeRateServiceServer()
+}
+
+func RegisterExchangeRateServiceServer(s grpc.ServiceRegistrar, srv ExchangeRateServiceServer) {
+	// If the following call panics, it indicates UnimplementedExchangeRateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExchangeRateService_ServiceDesc, srv)
+}
+
+func _ExchangeRateService_SetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).SetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRateService_SetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).SetExchangeRates(ctx, req.(*SetExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRateService_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExchangeRateService_ServiceDesc is the grpc.ServiceDesc for ExchangeRateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExchangeRateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "exchangerate.v1.ExchangeRateService",
+	HandlerType: (*ExchangeRateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetExchangeRates",
+			Handler:    _ExchangeRateService_SetExchangeRates_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _ExchangeRateService_ListExchangeRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/exchangerate/v1/exchangerate.proto",
+}
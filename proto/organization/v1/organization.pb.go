@@ -0,0 +1,1010 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: proto/organization/v1/organization.proto
+
+package organizationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Department struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is unique regardless of case.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// parent_id is the department this one belongs to, empty for divisions.
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Department) Reset() {
+	*x = Department{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Department) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Department) ProtoMessage() {}
+
+func (x *Department) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Department.ProtoReflect.Descriptor instead.
+func (*Department) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Department) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Department) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Department) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Department) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Department) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDepartmentRequest) Reset() {
+	*x = CreateDepartmentRequest{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDepartmentRequest) ProtoMessage() {}
+
+func (x *CreateDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateDepartmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type GetDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentRequest) Reset() {
+	*x = GetDepartmentRequest{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentRequest) ProtoMessage() {}
+
+func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*GetDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDepartmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDepartmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentsRequest) Reset() {
+	*x = ListDepartmentsRequest{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentsRequest) ProtoMessage() {}
+
+func (x *ListDepartmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDepartmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{3}
+}
+
+type ListDepartmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Departments   []*Department          `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentsResponse) Reset() {
+	*x = ListDepartmentsResponse{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentsResponse) ProtoMessage() {}
+
+func (x *ListDepartmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDepartmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDepartmentsResponse) GetDepartments() []*Department {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+// UpdateDepartmentRequest replaces the name and parent of a department. The
+// parent cannot be the department or one of its sub-departments.
+type UpdateDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDepartmentRequest) Reset() {
+	*x = UpdateDepartmentRequest{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDepartmentRequest) ProtoMessage() {}
+
+func (x *UpdateDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateDepartmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+// DeleteDepartmentRequest fails with a conflict when the department has
+// employees or sub-departments and reassign_to is empty. reassign_to cannot
+// be one of the department's sub-departments.
+type DeleteDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReassignTo    string                 `protobuf:"bytes,2,opt,name=reassign_to,json=reassignTo,proto3" json:"reassign_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDepartmentRequest) Reset() {
+	*x = DeleteDepartmentRequest{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepartmentRequest) ProtoMessage() {}
+
+func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteDepartmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteDepartmentRequest) GetReassignTo() string {
+	if x != nil {
+		return x.ReassignTo
+	}
+	return ""
+}
+
+type DeleteDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDepartmentResponse) Reset() {
+	*x = DeleteDepartmentResponse{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepartmentResponse) ProtoMessage() {}
+
+func (x *DeleteDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteDepartmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CostCenter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// code is stored in upper case and unique.
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CostCenter) Reset() {
+	*x = CostCenter{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CostCenter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostCenter) ProtoMessage() {}
+
+func (x *CostCenter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostCenter.ProtoReflect.Descriptor instead.
+func (*CostCenter) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CostCenter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CostCenter) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CostCenter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CostCenter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CostCenter) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateCostCenterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCostCenterRequest) Reset() {
+	*x = CreateCostCenterRequest{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCostCenterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCostCenterRequest) ProtoMessage() {}
+
+func (x *CreateCostCenterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCostCenterRequest.ProtoReflect.Descriptor instead.
+func (*CreateCostCenterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateCostCenterRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCostCenterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetCostCenterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCostCenterRequest) Reset() {
+	*x = GetCostCenterRequest{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCostCenterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCostCenterRequest) ProtoMessage() {}
+
+func (x *GetCostCenterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCostCenterRequest.ProtoReflect.Descriptor instead.
+func (*GetCostCenterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCostCenterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCostCentersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCostCentersRequest) Reset() {
+	*x = ListCostCentersRequest{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCostCentersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCostCentersRequest) ProtoMessage() {}
+
+func (x *ListCostCentersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCostCentersRequest.ProtoReflect.Descriptor instead.
+func (*ListCostCentersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{11}
+}
+
+type ListCostCentersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CostCenters   []*CostCenter          `protobuf:"bytes,1,rep,name=cost_centers,json=costCenters,proto3" json:"cost_centers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCostCentersResponse) Reset() {
+	*x = ListCostCentersResponse{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCostCentersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCostCentersResponse) ProtoMessage() {}
+
+func (x *ListCostCentersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCostCentersResponse.ProtoReflect.Descriptor instead.
+func (*ListCostCentersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListCostCentersResponse) GetCostCenters() []*CostCenter {
+	if x != nil {
+		return x.CostCenters
+	}
+	return nil
+}
+
+type UpdateCostCenterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCostCenterRequest) Reset() {
+	*x = UpdateCostCenterRequest{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCostCenterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCostCenterRequest) ProtoMessage() {}
+
+func (x *UpdateCostCenterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCostCenterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCostCenterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateCostCenterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCostCenterRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateCostCenterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DeleteCostCenterRequest fails with a conflict when the cost center has
+// employees and reassign_to is empty.
+type DeleteCostCenterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReassignTo    string                 `protobuf:"bytes,2,opt,name=reassign_to,json=reassignTo,proto3" json:"reassign_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCostCenterRequest) Reset() {
+	*x = DeleteCostCenterRequest{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCostCenterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCostCenterRequest) ProtoMessage() {}
+
+func (x *DeleteCostCenterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCostCenterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCostCenterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteCostCenterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCostCenterRequest) GetReassignTo() string {
+	if x != nil {
+		return x.ReassignTo
+	}
+	return ""
+}
+
+type DeleteCostCenterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCostCenterResponse) Reset() {
+	*x = DeleteCostCenterResponse{}
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCostCenterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCostCenterResponse) ProtoMessage() {}
+
+func (x *DeleteCostCenterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_v1_organization_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCostCenterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCostCenterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_v1_organization_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteCostCenterResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_proto_organization_v1_organization_proto protoreflect.FileDescriptor
+
+const file_proto_organization_v1_organization_proto_rawDesc = "" +
+	"\n" +
+	"(proto/organization/v1/organization.proto\x12\x0forganization.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x01\n" +
+	"\n" +
+	"Department\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"J\n" +
+	"\x17CreateDepartmentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"&\n" +
+	"\x14GetDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16ListDepartmentsRequest\"X\n" +
+	"\x17ListDepartmentsResponse\x12=\n" +
+	"\vdepartments\x18\x01 \x03(\v2\x1b.organization.v1.DepartmentR\vdepartments\"Z\n" +
+	"\x17UpdateDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\"J\n" +
+	"\x17DeleteDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vreassign_to\x18\x02 \x01(\tR\n" +
+	"reassignTo\"4\n" +
+	"\x18DeleteDepartmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xba\x01\n" +
+	"\n" +
+	"CostCenter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"A\n" +
+	"\x17CreateCostCenterRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"&\n" +
+	"\x14GetCostCenterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16ListCostCentersRequest\"Y\n" +
+	"\x17ListCostCentersResponse\x12>\n" +
+	"\fcost_centers\x18\x01 \x03(\v2\x1b.organization.v1.CostCenterR\vcostCenters\"Q\n" +
+	"\x17UpdateCostCenterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"J\n" +
+	"\x17DeleteCostCenterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vreassign_to\x18\x02 \x01(\tR\n" +
+	"reassignTo\"4\n" +
+	"\x18DeleteCostCenterResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xc9\a\n" +
+	"\x13OrganizationService\x12Y\n" +
+	"\x10CreateDepartment\x12(.organization.v1.CreateDepartmentRequest\x1a\x1b.organization.v1.Department\x12S\n" +
+	"\rGetDepartment\x12%.organization.v1.GetDepartmentRequest\x1a\x1b.organization.v1.Department\x12d\n" +
+	"\x0fListDepartments\x12'.organization.v1.ListDepartmentsRequest\x1a(.organization.v1.ListDepartmentsResponse\x12Y\n" +
+	"\x10UpdateDepartment\x12(.organization.v1.UpdateDepartmentRequest\x1a\x1b.organization.v1.Department\x12g\n" +
+	"\x10DeleteDepartment\x12(.organization.v1.DeleteDepartmentRequest\x1a).organization.v1.DeleteDepartmentResponse\x12Y\n" +
+	"\x10CreateCostCenter\x12(.organization.v1.CreateCostCenterRequest\x1a\x1b.organization.v1.CostCenter\x12S\n" +
+	"\rGetCostCenter\x12%.organization.v1.GetCostCenterRequest\x1a\x1b.organization.v1.CostCenter\x12d\n" +
+	"\x0fListCostCenters\x12'.organization.v1.ListCostCentersRequest\x1a(.organization.v1.ListCostCentersResponse\x12Y\n" +
+	"\x10UpdateCostCenter\x12(.organization.v1.UpdateCostCenterRequest\x1a\x1b.organization.v1.CostCenter\x12g\n" +
+	"\x10DeleteCostCenter\x12(.organization.v1.DeleteCostCenterRequest\x1a).organization.v1.DeleteCostCenterResponseB>Z<github.com/employee-api/proto/organization/v1;organizationv1b\x06proto3"
+
+var (
+	file_proto_organization_v1_organization_proto_rawDescOnce sync.Once
+	file_proto_organization_v1_organization_proto_rawDescData []byte
+)
+
+func file_proto_organization_v1_organization_proto_rawDescGZIP() []byte {
+	file_proto_organization_v1_organization_proto_rawDescOnce.Do(func() {
+		file_proto_organization_v1_organization_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_organization_v1_organization_proto_rawDesc), len(file_proto_organization_v1_organization_proto_rawDesc)))
+	})
+	return file_proto_organization_v1_organization_proto_rawDescData
+}
+
+var file_proto_organization_v1_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_organization_v1_organization_proto_goTypes = []any{
+	(*Department)(nil),               // 0: organization.v1.Department
+	(*CreateDepartmentRequest)(nil),  // 1: organization.v1.CreateDepartmentRequest
+	(*GetDepartmentRequest)(nil),     // 2: organization.v1.GetDepartmentRequest
+	(*ListDepartmentsRequest)(nil),   // 3: organization.v1.ListDepartmentsRequest
+	(*ListDepartmentsResponse)(nil),  // 4: organization.v1.ListDepartmentsResponse
+	(*UpdateDepartmentRequest)(nil),  // 5: organization.v1.UpdateDepartmentRequest
+	(*DeleteDepartmentRequest)(nil),  // 6: organization.v1.DeleteDepartmentRequest
+	(*DeleteDepartmentResponse)(nil), // 7: organization.v1.DeleteDepartmentResponse
+	(*CostCenter)(nil),               // 8: organization.v1.CostCenter
+	(*CreateCostCenterRequest)(nil),  // 9: organization.v1.CreateCostCenterRequest
+	(*GetCostCenterRequest)(nil),     // 10: organization.v1.GetCostCenterRequest
+	(*ListCostCentersRequest)(nil),   // 11: organization.v1.ListCostCentersRequest
+	(*ListCostCentersResponse)(nil),  // 12: organization.v1.ListCostCentersResponse
+	(*UpdateCostCenterRequest)(nil),  // 13: organization.v1.UpdateCostCenterRequest
+	(*DeleteCostCenterRequest)(nil),  // 14: organization.v1.DeleteCostCenterRequest
+	(*DeleteCostCenterResponse)(nil), // 15: organization.v1.DeleteCostCenterResponse
+	(*timestamppb.Timestamp)(nil),    // 16: google.protobuf.Timestamp
+}
+var file_proto_organization_v1_organization_proto_depIdxs = []int32{
+	16, // 0: organization.v1.Department.created_at:type_name -> google.protobuf.Timestamp
+	16, // 1: organization.v1.Department.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: organization.v1.ListDepartmentsResponse.departments:type_name -> organization.v1.Department
+	16, // 3: organization.v1.CostCenter.created_at:type_name -> google.protobuf.Timestamp
+	16, // 4: organization.v1.CostCenter.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 5: organization.v1.ListCostCentersResponse.cost_centers:type_name -> organization.v1.CostCenter
+	1,  // 6: organization.v1.OrganizationService.CreateDepartment:input_type -> organization.v1.CreateDepartmentRequest
+	2,  // 7: organization.v1.OrganizationService.GetDepartment:input_type -> organization.v1.GetDepartmentRequest
+	3,  // 8: organization.v1.OrganizationService.ListDepartments:input_type -> organization.v1.ListDepartmentsRequest
+	5,  // 9: organization.v1.OrganizationService.UpdateDepartment:input_type -> organization.v1.UpdateDepartmentRequest
+	6,  // 10: organization.v1.OrganizationService.DeleteDepartment:input_type -> organization.v1.DeleteDepartmentRequest
+	9,  // 11: organization.v1.OrganizationService.CreateCostCenter:input_type -> organization.v1.CreateCostCenterRequest
+	10, // 12: organization.v1.OrganizationService.GetCostCenter:input_type -> organization.v1.GetCostCenterRequest
+	11, // 13: organization.v1.OrganizationService.ListCostCenters:input_type -> organization.v1.ListCostCentersRequest
+	13, // 14: organization.v1.OrganizationService.UpdateCostCenter:input_type -> organization.v1.UpdateCostCenterRequest
+	14, // 15: organization.v1.OrganizationService.DeleteCostCenter:input_type -> organization.v1.DeleteCostCenterRequest
+	0,  // 16: organization.v1.OrganizationService.CreateDepartment:output_type -> organization.v1.Department
+	0,  // 17: organization.v1.OrganizationService.GetDepartment:output_type -> organization.v1.Department
+	4,  // 18: organization.v1.OrganizationService.ListDepartments:output_type -> organization.v1.ListDepartmentsResponse
+	0,  // 19: organization.v1.OrganizationService.UpdateDepartment:output_type -> organization.v1.Department
+	7,  // 20: organization.v1.OrganizationService.DeleteDepartment:output_type -> organization.v1.DeleteDepartmentResponse
+	8,  // 21: organization.v1.OrganizationService.CreateCostCenter:output_type -> organization.v1.CostCenter
+	8,  // 22: organization.v1.OrganizationService.GetCostCenter:output_type -> organization.v1.CostCenter
+	12, // 23: organization.v1.OrganizationService.ListCostCenters:output_type -> organization.v1.ListCostCentersResponse
+	8,  // 24: organization.v1.OrganizationService.UpdateCostCenter:output_type -> organization.v1.CostCenter
+	15, // 25: organization.v1.OrganizationService.DeleteCostCenter:output_type -> organization.v1.DeleteCostCenterResponse
+	16, // [16:26] is the sub-list for method output_type
+	6,  // [6:16] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_organization_v1_organization_proto_init() }
+func file_proto_organization_v1_organization_proto_init() {
+	if File_proto_organization_v1_organization_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_organization_v1_organization_proto_rawDesc), len(file_proto_organization_v1_organization_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_organization_v1_organization_proto_goTypes,
+		DependencyIndexes: file_proto_organization_v1_organization_proto_depIdxs,
+		MessageInfos:      file_proto_organization_v1_organization_proto_msgTypes,
+	}.Build()
+	File_proto_organization_v1_organization_proto = out.File
+	file_proto_organization_v1_organization_proto_goTypes = nil
+	file_proto_organization_v1_organization_proto_depIdxs = nil
+}
@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.4
+// source: proto/organization/v1/organization.proto
+
+package organizationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OrganizationService_CreateDepartment_FullMethodName = "/organization.v1.OrganizationService/CreateDepartment"
+	OrganizationService_GetDepartment_FullMethodName    = "/organization.v1.OrganizationService/GetDepartment"
+	OrganizationService_ListDepartments_FullMethodName  = "/organization.v1.OrganizationService/ListDepartments"
+	OrganizationService_UpdateDepartment_FullMethodName = "/organization.v1.OrganizationService/UpdateDepartment"
+	OrganizationService_DeleteDepartment_FullMethodName = "/organization.v1.OrganizationService/DeleteDepartment"
+	OrganizationService_CreateCostCenter_FullMethodName = "/organization.v1.OrganizationService/CreateCostCenter"
+	OrganizationService_GetCostCenter_FullMethodName    = "/organization.v1.OrganizationService/GetCostCenter"
+	OrganizationService_ListCostCenters_FullMethodName  = "/organization.v1.OrganizationService/ListCostCenters"
+	OrganizationService_UpdateCostCenter_FullMethodName = "/organization.v1.OrganizationService/UpdateCostCenter"
+	OrganizationService_DeleteCostCenter_FullMethodName = "/organization.v1.OrganizationService/DeleteCostCenter"
+)
+
+// OrganizationServiceClient is the client API for OrganizationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OrganizationService administers departments and cost centers
+type OrganizationServiceClient interface {
+	// CreateDepartment adds a department, or a division when it has no parent
+	CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
+	// GetDepartment returns a single department
+	GetDepartment(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
+	// ListDepartments returns every department ordered by name
+	ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error)
+	// UpdateDepartment renames a department or moves it under another parent
+	UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
+	// DeleteDepartment removes a department, moving its employees and
+	// sub-departments to reassign_to
+	DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*DeleteDepartmentResponse, error)
+	// CreateCostCenter adds a cost center
+	CreateCostCenter(ctx context.Context, in *CreateCostCenterRequest, opts ...grpc.CallOption) (*CostCenter, error)
+	// GetCostCenter returns a single cost center
+	GetCostCenter(ctx context.Context, in *GetCostCenterRequest, opts ...grpc.CallOption) (*CostCenter, error)
+	// ListCostCenters returns every cost center ordered by code
+	ListCostCenters(ctx context.Context, in *ListCostCentersRequest, opts ...grpc.CallOption) (*ListCostCentersResponse, error)
+	// UpdateCostCenter changes the code or name of a cost center
+	UpdateCostCenter(ctx context.Context, in *UpdateCostCenterRequest, opts ...grpc.CallOption) (*CostCenter, error)
+	// DeleteCostCenter removes a cost center, moving its employees to
+	// reassign_to
+	DeleteCostCenter(ctx context.Context, in *DeleteCostCenterRequest, opts ...grpc.CallOption) (*DeleteCostCenterResponse, error)
+}
+
+type organizationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrganizationServiceClient(cc grpc.ClientConnInterface) OrganizationServiceClient {
+	return &organizationServiceClient{cc}
+}
+
+func (c *organizationServiceClient) CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Department)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetDepartment(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Department)
+	err := c.cc.Invoke(ctx, OrganizationService_GetDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDepartmentsResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListDepartments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Department)
+	err := c.cc.Invoke(ctx, OrganizationService_UpdateDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*DeleteDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDepartmentResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_DeleteDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CreateCostCenter(ctx context.Context, in *CreateCostCenterRequest, opts ...grpc.CallOption) (*CostCenter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CostCenter)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateCostCenter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetCostCenter(ctx context.Context, in *GetCostCenterRequest, opts ...grpc.CallOption) (*CostCenter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CostCenter)
+	err := c.cc.Invoke(ctx, OrganizationService_GetCostCenter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListCostCenters(ctx context.Context, in *ListCostCentersRequest, opts ...grpc.CallOption) (*ListCostCentersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCostCentersResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListCostCenters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateCostCenter(ctx context.Context, in *UpdateCostCenterRequest, opts ...grpc.CallOption) (*CostCenter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CostCenter)
+	err := c.cc.Invoke(ctx, OrganizationService_UpdateCostCenter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DeleteCostCenter(ctx context.Context, in *DeleteCostCenterRequest, opts ...grpc.CallOption) (*DeleteCostCenterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCostCenterResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_DeleteCostCenter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrganizationServiceServer is the server API for OrganizationService service.
+// All implementations must embed UnimplementedOrganizationServiceServer
+// for forward compatibility.
+//
+// OrganizationService administers departments and cost centers
+type OrganizationServiceServer interface {
+	// CreateDepartment adds a department, or a division when it has no parent
+	CreateDepartment(context.Context, *CreateDepartmentRequest) (*Department, error)
+	// GetDepartment returns a single department
+	GetDepartment(context.Context, *GetDepartmentRequest) (*Department, error)
+	// ListDepartments returns every department ordered by name
+	ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error)
+	// UpdateDepartment renames a department or moves it under another parent
+	UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*Department, error)
+	// DeleteDepartment removes a department, moving its employees and
+	// sub-departments to reassign_to
+	DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error)
+	// CreateCostCenter adds a cost center
+	CreateCostCenter(context.Context, *CreateCostCenterRequest) (*CostCenter, error)
+	// GetCostCenter returns a single cost center
+	GetCostCenter(context.Context, *GetCostCenterRequest) (*CostCenter, error)
+	// ListCostCenters returns every cost center ordered by code
+	ListCostCenters(context.Context, *ListCostCentersRequest) (*ListCostCentersResponse, error)
+	// UpdateCostCenter changes the code or name of a cost center
+	UpdateCostCenter(context.Context, *UpdateCostCenterRequest) (*CostCenter, error)
+	// DeleteCostCenter removes a cost center, moving its employees to
+	// reassign_to
+	DeleteCostCenter(context.Context, *DeleteCostCenterRequest) (*DeleteCostCenterResponse, error)
+	mustEmbedUnimplementedOrganizationServiceServer()
+}
+
+// UnimplementedOrganizationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrganizationServiceServer struct{}
+
+func (UnimplementedOrganizationServiceServer) CreateDepartment(context.Context, *CreateDepartmentRequest) (*Department, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDepartment not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetDepartment(context.Context, *GetDepartmentRequest) (*Department, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDepartment not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDepartments not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*Department, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateDepartment not implemented")
+}
+func (UnimplementedOrganizationServiceServer) DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDepartment not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CreateCostCenter(context.Context, *CreateCostCenterRequest) (*CostCenter, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCostCenter not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetCostCenter(context.Context, *GetCostCenterRequest) (*CostCenter, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCostCenter not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListCostCenters(context.Context, *ListCostCentersRequest) (*ListCostCentersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCostCenters not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UpdateCostCenter(context.Context, *UpdateCostCenterRequest) (*CostCenter, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCostCenter not implemented")
+}
+func (UnimplementedOrganizationServiceServer) DeleteCostCenter(context.Context, *DeleteCostCenterRequest) (*DeleteCostCenterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCostCenter not implemented")
+}
+func (UnimplementedOrganizationServiceServer) mustEmbedUnimplementedOrganizationServiceServer() {}
+func (UnimplementedOrganizationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeOrganizationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrganizationServiceServer will
+// result in compilation errors.
+type UnsafeOrganizationServiceServer interface {
+	mustEmbedUnimplementedOrganizationServiceServer()
+}
+
+func RegisterOrganizationServiceServer(s grpc.ServiceRegistrar, srv OrganizationServiceServer) {
+	// If the following call panics, it indicates UnimplementedOrganizationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrganizationService_ServiceDesc, srv)
+}
+
+func _OrganizationService_CreateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CreateDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateDepartment(ctx, req.(*CreateDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetDepartment(ctx, req.(*GetDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListDepartments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepartmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListDepartments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListDepartments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListDepartments(ctx, req.(*ListDepartmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_UpdateDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateDepartment(ctx, req.(*UpdateDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeleteDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeleteDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_DeleteDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeleteDepartment(ctx, req.(*DeleteDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateCostCenter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCostCenterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateCostCenter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CreateCostCenter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateCostCenter(ctx, req.(*CreateCostCenterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetCostCenter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCostCenterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetCostCenter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetCostCenter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetCostCenter(ctx, req.(*GetCostCenterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListCostCenters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCostCentersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListCostCenters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListCostCenters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListCostCenters(ctx, req.(*ListCostCentersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateCostCenter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCostCenterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateCostCenter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_UpdateCostCenter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateCostCenter(ctx, req.(*UpdateCostCenterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeleteCostCenter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCostCenterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeleteCostCenter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_DeleteCostCenter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeleteCostCenter(ctx, req.(*DeleteCostCenterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrganizationService_ServiceDesc is the grpc.ServiceDesc for OrganizationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrganizationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "organization.v1.OrganizationService",
+	HandlerType: (*OrganizationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDepartment",
+			Handler:    _OrganizationService_CreateDepartment_Handler,
+		},
+		{
+			MethodName: "GetDepartment",
+			Handler:    _OrganizationService_GetDepartment_Handler,
+		},
+		{
+			MethodName: "ListDepartments",
+			Handler:    _OrganizationService_ListDepartments_Handler,
+		},
+		{
+			MethodName: "UpdateDepartment",
+			Handler:    _OrganizationService_UpdateDepartment_Handler,
+		},
+		{
+			MethodName: "DeleteDepartment",
+			Handler:    _OrganizationService_DeleteDepartment_Handler,
+		},
+		{
+			MethodName: "CreateCostCenter",
+			Handler:    _OrganizationService_CreateCostCenter_Handler,
+		},
+		{
+			MethodName: "GetCostCenter",
+			Handler:    _OrganizationService_GetCostCenter_Handler,
+		},
+		{
+			MethodName: "ListCostCenters",
+			Handler:    _OrganizationService_ListCostCenters_Handler,
+		},
+		{
+			MethodName: "UpdateCostCenter",
+			Handler:    _OrganizationService_UpdateCostCenter_Handler,
+		},
+		{
+			MethodName: "DeleteCostCenter",
+			Handler:    _OrganizationService_DeleteCostCenter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/organization/v1/organization.proto",
+}
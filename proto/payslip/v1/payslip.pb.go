@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: proto/payslip/v1/payslip.proto
+
+package payslipv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PayslipFormat int32
+
+const (
+	PayslipFormat_PAYSLIP_FORMAT_UNSPECIFIED PayslipFormat = 0
+	PayslipFormat_PAYSLIP_FORMAT_TEXT        PayslipFormat = 1
+	PayslipFormat_PAYSLIP_FORMAT_PDF         PayslipFormat = 2
+)
+
+// Enum value maps for PayslipFormat.
+var (
+	PayslipFormat_name = map[int32]string{
+		0: "PAYSLIP_FORMAT_UNSPECIFIED",
+		1: "PAYSLIP_FORMAT_TEXT",
+		2: "PAYSLIP_FORMAT_PDF",
+	}
+	PayslipFormat_value = map[string]int32{
+		"PAYSLIP_FORMAT_UNSPECIFIED": 0,
+		"PAYSLIP_FORMAT_TEXT":        1,
+		"PAYSLIP_FORMAT_PDF":         2,
+	}
+)
+
+func (x PayslipFormat) Enum() *PayslipFormat {
+	p := new(PayslipFormat)
+	*p = x
+	return p
+}
+
+func (x PayslipFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayslipFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_payslip_v1_payslip_proto_enumTypes[0].Descriptor()
+}
+
+func (PayslipFormat) Type() protoreflect.EnumType {
+	return &file_proto_payslip_v1_payslip_proto_enumTypes[0]
+}
+
+func (x PayslipFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayslipFormat.Descriptor instead.
+func (PayslipFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_payslip_v1_payslip_proto_rawDescGZIP(), []int{0}
+}
+
+type GeneratePayslipRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// year and month (1-12) select the pay period.
+	Year  int32 `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32 `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	// format defaults to PDF.
+	Format        PayslipFormat `protobuf:"varint,4,opt,name=format,proto3,enum=payslip.v1.PayslipFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePayslipRequest) Reset() {
+	*x = GeneratePayslipRequest{}
+	mi := &file_proto_payslip_v1_payslip_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePayslipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePayslipRequest) ProtoMessage() {}
+
+func (x *GeneratePayslipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payslip_v1_payslip_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePayslipRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePayslipRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payslip_v1_payslip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GeneratePayslipRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *GeneratePayslipRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GeneratePayslipRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *GeneratePayslipRequest) GetFormat() PayslipFormat {
+	if x != nil {
+		return x.Format
+	}
+	return PayslipFormat_PAYSLIP_FORMAT_UNSPECIFIED
+}
+
+// GeneratePayslipResponse is the rendered document. The same employee,
+// period and data always render to the same bytes.
+type GeneratePayslipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePayslipResponse) Reset() {
+	*x = GeneratePayslipResponse{}
+	mi := &file_proto_payslip_v1_payslip_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePayslipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePayslipResponse) ProtoMessage() {}
+
+func (x *GeneratePayslipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payslip_v1_payslip_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePayslipResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePayslipResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payslip_v1_payslip_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GeneratePayslipResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GeneratePayslipResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GeneratePayslipResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+var File_proto_payslip_v1_payslip_proto protoreflect.FileDescriptor
+
+const file_proto_payslip_v1_payslip_proto_rawDesc = "" +
+	"\n" +
+	"\x1eproto/payslip/v1/payslip.proto\x12\n" +
+	"payslip.v1\"\x96\x01\n" +
+	"\x16GeneratePayslipRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\x05R\x05month\x121\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x19.payslip.v1.PayslipFormatR\x06format\"r\n" +
+	"\x17GeneratePayslipResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename*`\n" +
+	"\rPayslipFormat\x12\x1e\n" +
+	"\x1aPAYSLIP_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PAYSLIP_FORMAT_TEXT\x10\x01\x12\x16\n" +
+	"\x12PAYSLIP_FORMAT_PDF\x10\x022l\n" +
+	"\x0ePayslipService\x12Z\n" +
+	"\x0fGeneratePayslip\x12\".payslip.v1.GeneratePayslipRequest\x1a#.payslip.v1.GeneratePayslipResponseB4Z2github.com/employee-api/proto/payslip/v1;payslipv1b\x06proto3"
+
+var (
+	file_proto_payslip_v1_payslip_proto_rawDescOnce sync.Once
+	file_proto_payslip_v1_payslip_proto_rawDescData []byte
+)
+
+func file_proto_payslip_v1_payslip_proto_rawDescGZIP() []byte {
+	file_proto_payslip_v1_payslip_proto_rawDescOnce.Do(func() {
+		file_proto_payslip_v1_payslip_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_payslip_v1_payslip_proto_rawDesc), len(file_proto_payslip_v1_payslip_proto_rawDesc)))
+	})
+	return file_proto_payslip_v1_payslip_proto_rawDescData
+}
+
+var file_proto_payslip_v1_payslip_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_payslip_v1_payslip_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_payslip_v1_payslip_proto_goTypes = []any{
+	(PayslipFormat)(0),              // 0: payslip.v1.PayslipFormat
+	(*GeneratePayslipRequest)(nil),  // 1: payslip.v1.GeneratePayslipRequest
+	(*GeneratePayslipResponse)(nil), // 2: payslip.v1.GeneratePayslipResponse
+}
+var file_proto_payslip_v1_payslip_proto_depIdxs = []int32{
+	0, // 0: payslip.v1.GeneratePayslipRequest.format:type_name -> payslip.v1.PayslipFormat
+	1, // 1: payslip.v1.PayslipService.GeneratePayslip:input_type -> payslip.v1.GeneratePayslipRequest
+	2, // 2: payslip.v1.PayslipService.GeneratePayslip:output_type -> payslip.v1.GeneratePayslipResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_payslip_v1_payslip_proto_init() }
+func file_proto_payslip_v1_payslip_proto_init() {
+	if File_proto_payslip_v1_payslip_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_payslip_v1_payslip_proto_rawDesc), len(file_proto_payslip_v1_payslip_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_payslip_v1_payslip_proto_goTypes,
+		DependencyIndexes: file_proto_payslip_v1_payslip_proto_depIdxs,
+		EnumInfos:         file_proto_payslip_v1_payslip_proto_enumTypes,
+		MessageInfos:      file_proto_payslip_v1_payslip_proto_msgTypes,
+	}.Build()
+	File_proto_payslip_v1_payslip_proto = out.File
+	file_proto_payslip_v1_payslip_proto_goTypes = nil
+	file_proto_payslip_v1_payslip_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.4
+// source: proto/payslip/v1/payslip.proto
+
+package payslipv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PayslipService_GeneratePayslip_FullMethodName = "/payslip.v1.PayslipService/GeneratePayslip"
+)
+
+// PayslipServiceClient is the client API for PayslipService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PayslipService renders employees' monthly payslips
+type PayslipServiceClient interface {
+	// GeneratePayslip renders an employee's payslip for a calendar month
+	GeneratePayslip(ctx context.Context, in *GeneratePayslipRequest, opts ...grpc.CallOption) (*GeneratePayslipResponse, error)
+}
+
+type payslipServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPayslipServiceClient(cc grpc.ClientConnInterface) PayslipServiceClient {
+	return &payslipServiceClient{cc}
+}
+
+func (c *payslipServiceClient) GeneratePayslip(ctx context.Context, in *GeneratePayslipRequest, opts ...grpc.CallOption) (*GeneratePayslipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratePayslipResponse)
+	err := c.cc.Invoke(ctx, PayslipService_GeneratePayslip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayslipServiceServer is the server API for PayslipService service.
+// All implementations must embed UnimplementedPayslipServiceServer
+// for forward compatibility.
+//
+// PayslipService renders employees' monthly payslips
+type PayslipServiceServer interface {
+	// GeneratePayslip renders an employee's payslip for a calendar month
+	GeneratePayslip(context.Context, *GeneratePayslipRequest) (*GeneratePayslipResponse, error)
+	mustEmbedUnimplementedPayslipServiceServer()
+}
+
+// UnimplementedPayslipServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPayslipServiceServer struct{}
+
+func (UnimplementedPayslipServiceServer) GeneratePayslip(context.Context, *GeneratePayslipRequest) (*GeneratePayslipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GeneratePayslip not implemented")
+}
+func (UnimplementedPayslipServiceServer) mustEmbedUnimplementedPayslipServiceServer() {}
+func (UnimplementedPayslipServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePayslipServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PayslipServiceServer will
+// result in compilation errors.
+type UnsafePayslipServiceServer interface {
+	mustEmbedUnimplementedPayslipServiceServer()
+}
+
+func RegisterPayslipServiceServer(s grpc.ServiceRegistrar, srv PayslipServiceServer) {
+	// If the following call panics, it indicates UnimplementedPayslipServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PayslipService_ServiceDesc, srv)
+}
+
+func _PayslipService_GeneratePayslip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePayslipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayslipServiceServer).GeneratePayslip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayslipService_GeneratePayslip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayslipServiceServer).GeneratePayslip(ctx, req.(*GeneratePayslipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PayslipService_ServiceDesc is the grpc.ServiceDesc for PayslipService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PayslipService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "payslip.v1.PayslipService",
+	HandlerType: (*PayslipServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GeneratePayslip",
+			Handler:    _PayslipService_GeneratePayslip_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/payslip/v1/payslip.proto",
+}
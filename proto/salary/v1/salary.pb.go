@@ -9,6 +9,7 @@ package salaryv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,9 +22,200 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// EmploymentStatus selects employees by their employment status, as in
+// employee.v1.EmploymentStatus. Statistics only count active employees
+// unless a request names the statuses to include.
+type EmploymentStatus int32
+
+const (
+	EmploymentStatus_EMPLOYMENT_STATUS_UNSPECIFIED EmploymentStatus = 0
+	EmploymentStatus_EMPLOYMENT_STATUS_PENDING     EmploymentStatus = 1
+	EmploymentStatus_EMPLOYMENT_STATUS_ACTIVE      EmploymentStatus = 2
+	EmploymentStatus_EMPLOYMENT_STATUS_ON_LEAVE    EmploymentStatus = 3
+	EmploymentStatus_EMPLOYMENT_STATUS_TERMINATED  EmploymentStatus = 4
+)
+
+// Enum value maps for EmploymentStatus.
+var (
+	EmploymentStatus_name = map[int32]string{
+		0: "EMPLOYMENT_STATUS_UNSPECIFIED",
+		1: "EMPLOYMENT_STATUS_PENDING",
+		2: "EMPLOYMENT_STATUS_ACTIVE",
+		3: "EMPLOYMENT_STATUS_ON_LEAVE",
+		4: "EMPLOYMENT_STATUS_TERMINATED",
+	}
+	EmploymentStatus_value = map[string]int32{
+		"EMPLOYMENT_STATUS_UNSPECIFIED": 0,
+		"EMPLOYMENT_STATUS_PENDING":     1,
+		"EMPLOYMENT_STATUS_ACTIVE":      2,
+		"EMPLOYMENT_STATUS_ON_LEAVE":    3,
+		"EMPLOYMENT_STATUS_TERMINATED":  4,
+	}
+)
+
+func (x EmploymentStatus) Enum() *EmploymentStatus {
+	p := new(EmploymentStatus)
+	*p = x
+	return p
+}
+
+func (x EmploymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmploymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_salary_v1_salary_proto_enumTypes[0].Descriptor()
+}
+
+func (EmploymentStatus) Type() protoreflect.EnumType {
+	return &file_proto_salary_v1_salary_proto_enumTypes[0]
+}
+
+func (x EmploymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmploymentStatus.Descriptor instead.
+func (EmploymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{0}
+}
+
+// SalaryDimension is an employee attribute salaries can be grouped by.
+type SalaryDimension int32
+
+const (
+	SalaryDimension_SALARY_DIMENSION_UNSPECIFIED SalaryDimension = 0
+	SalaryDimension_SALARY_DIMENSION_COUNTRY     SalaryDimension = 1
+	SalaryDimension_SALARY_DIMENSION_JOB_TITLE   SalaryDimension = 2
+	// SALARY_DIMENSION_HIRE_YEAR is the year of the employee's hire date.
+	SalaryDimension_SALARY_DIMENSION_HIRE_YEAR SalaryDimension = 3
+	SalaryDimension_SALARY_DIMENSION_CURRENCY  SalaryDimension = 4
+	// SALARY_DIMENSION_DEPARTMENT is the department name and
+	// SALARY_DIMENSION_COST_CENTER the cost center code, both empty for
+	// employees without one.
+	SalaryDimension_SALARY_DIMENSION_DEPARTMENT  SalaryDimension = 5
+	SalaryDimension_SALARY_DIMENSION_COST_CENTER SalaryDimension = 6
+)
+
+// Enum value maps for SalaryDimension.
+var (
+	SalaryDimension_name = map[int32]string{
+		0: "SALARY_DIMENSION_UNSPECIFIED",
+		1: "SALARY_DIMENSION_COUNTRY",
+		2: "SALARY_DIMENSION_JOB_TITLE",
+		3: "SALARY_DIMENSION_HIRE_YEAR",
+		4: "SALARY_DIMENSION_CURRENCY",
+		5: "SALARY_DIMENSION_DEPARTMENT",
+		6: "SALARY_DIMENSION_COST_CENTER",
+	}
+	SalaryDimension_value = map[string]int32{
+		"SALARY_DIMENSION_UNSPECIFIED": 0,
+		"SALARY_DIMENSION_COUNTRY":     1,
+		"SALARY_DIMENSION_JOB_TITLE":   2,
+		"SALARY_DIMENSION_HIRE_YEAR":   3,
+		"SALARY_DIMENSION_CURRENCY":    4,
+		"SALARY_DIMENSION_DEPARTMENT":  5,
+		"SALARY_DIMENSION_COST_CENTER": 6,
+	}
+)
+
+func (x SalaryDimension) Enum() *SalaryDimension {
+	p := new(SalaryDimension)
+	*p = x
+	return p
+}
+
+func (x SalaryDimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SalaryDimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_salary_v1_salary_proto_enumTypes[1].Descriptor()
+}
+
+func (SalaryDimension) Type() protoreflect.EnumType {
+	return &file_proto_salary_v1_salary_proto_enumTypes[1]
+}
+
+func (x SalaryDimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SalaryDimension.Descriptor instead.
+func (SalaryDimension) EnumDescriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{1}
+}
+
+type SalaryMetric int32
+
+const (
+	SalaryMetric_SALARY_METRIC_UNSPECIFIED SalaryMetric = 0
+	SalaryMetric_SALARY_METRIC_COUNT       SalaryMetric = 1
+	SalaryMetric_SALARY_METRIC_SUM         SalaryMetric = 2
+	SalaryMetric_SALARY_METRIC_MIN         SalaryMetric = 3
+	SalaryMetric_SALARY_METRIC_MAX         SalaryMetric = 4
+	SalaryMetric_SALARY_METRIC_AVG         SalaryMetric = 5
+	SalaryMetric_SALARY_METRIC_MEDIAN      SalaryMetric = 6
+	// SALARY_METRIC_STDDEV is the population standard deviation.
+	SalaryMetric_SALARY_METRIC_STDDEV SalaryMetric = 7
+)
+
+// Enum value maps for SalaryMetric.
+var (
+	SalaryMetric_name = map[int32]string{
+		0: "SALARY_METRIC_UNSPECIFIED",
+		1: "SALARY_METRIC_COUNT",
+		2: "SALARY_METRIC_SUM",
+		3: "SALARY_METRIC_MIN",
+		4: "SALARY_METRIC_MAX",
+		5: "SALARY_METRIC_AVG",
+		6: "SALARY_METRIC_MEDIAN",
+		7: "SALARY_METRIC_STDDEV",
+	}
+	SalaryMetric_value = map[string]int32{
+		"SALARY_METRIC_UNSPECIFIED": 0,
+		"SALARY_METRIC_COUNT":       1,
+		"SALARY_METRIC_SUM":         2,
+		"SALARY_METRIC_MIN":         3,
+		"SALARY_METRIC_MAX":         4,
+		"SALARY_METRIC_AVG":         5,
+		"SALARY_METRIC_MEDIAN":      6,
+		"SALARY_METRIC_STDDEV":      7,
+	}
+)
+
+func (x SalaryMetric) Enum() *SalaryMetric {
+	p := new(SalaryMetric)
+	*p = x
+	return p
+}
+
+func (x SalaryMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SalaryMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_salary_v1_salary_proto_enumTypes[2].Descriptor()
+}
+
+func (SalaryMetric) Type() protoreflect.EnumType {
+	return &file_proto_salary_v1_salary_proto_enumTypes[2]
+}
+
+func (x SalaryMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SalaryMetric.Descriptor instead.
+func (SalaryMetric) EnumDescriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{2}
+}
+
 type CalculateNetSalaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// as_of selects the tax rule in force at that time; defaults to now.
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,12 +257,31 @@ func (x *CalculateNetSalaryRequest) GetEmployeeId() string {
 	return ""
 }
 
+func (x *CalculateNetSalaryRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+// CalculateNetSalaryResponse amounts are in currency, the currency of the
+// employee's country, which its tax bands are in. A salary paid in another
+// currency is converted at the exchange rate of as_of.
 type CalculateNetSalaryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GrossSalary   string                 `protobuf:"bytes,1,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
-	TaxRate       string                 `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
-	TaxAmount     string                 `protobuf:"bytes,3,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
-	NetSalary     string                 `protobuf:"bytes,4,opt,name=net_salary,json=netSalary,proto3" json:"net_salary,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GrossSalary string                 `protobuf:"bytes,1,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
+	// tax_rate is the effective rate: tax_amount / gross_salary.
+	TaxRate           string `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxAmount         string `protobuf:"bytes,3,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	NetSalary         string `protobuf:"bytes,4,opt,name=net_salary,json=netSalary,proto3" json:"net_salary,omitempty"`
+	StandardDeduction string `protobuf:"bytes,5,opt,name=standard_deduction,json=standardDeduction,proto3" json:"standard_deduction,omitempty"`
+	TaxableIncome     string `protobuf:"bytes,6,opt,name=taxable_income,json=taxableIncome,proto3" json:"taxable_income,omitempty"`
+	// brackets lists the tax due in each marginal band, lowest first.
+	Brackets []*TaxBracketBreakdown `protobuf:"bytes,7,rep,name=brackets,proto3" json:"brackets,omitempty"`
+	// cap_adjustment is zero or negative; it is non-zero when the country caps
+	// the total tax, so that sum(brackets.tax_amount) + cap_adjustment = tax_amount.
+	CapAdjustment string `protobuf:"bytes,8,opt,name=cap_adjustment,json=capAdjustment,proto3" json:"cap_adjustment,omitempty"`
+	Currency      string `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,16 +344,136 @@ func (x *CalculateNetSalaryResponse) GetNetSalary() string {
 	return ""
 }
 
+func (x *CalculateNetSalaryResponse) GetStandardDeduction() string {
+	if x != nil {
+		return x.StandardDeduction
+	}
+	return ""
+}
+
+func (x *CalculateNetSalaryResponse) GetTaxableIncome() string {
+	if x != nil {
+		return x.TaxableIncome
+	}
+	return ""
+}
+
+func (x *CalculateNetSalaryResponse) GetBrackets() []*TaxBracketBreakdown {
+	if x != nil {
+		return x.Brackets
+	}
+	return nil
+}
+
+func (x *CalculateNetSalaryResponse) GetCapAdjustment() string {
+	if x != nil {
+		return x.CapAdjustment
+	}
+	return ""
+}
+
+func (x *CalculateNetSalaryResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type TaxBracketBreakdown struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LowerBound string                 `protobuf:"bytes,1,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	// upper_bound is empty for the unbounded top bracket.
+	UpperBound    string `protobuf:"bytes,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	Rate          string `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	TaxableAmount string `protobuf:"bytes,4,opt,name=taxable_amount,json=taxableAmount,proto3" json:"taxable_amount,omitempty"`
+	TaxAmount     string `protobuf:"bytes,5,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxBracketBreakdown) Reset() {
+	*x = TaxBracketBreakdown{}
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxBracketBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxBracketBreakdown) ProtoMessage() {}
+
+func (x *TaxBracketBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxBracketBreakdown.ProtoReflect.Descriptor instead.
+func (*TaxBracketBreakdown) Descriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaxBracketBreakdown) GetLowerBound() string {
+	if x != nil {
+		return x.LowerBound
+	}
+	return ""
+}
+
+func (x *TaxBracketBreakdown) GetUpperBound() string {
+	if x != nil {
+		return x.UpperBound
+	}
+	return ""
+}
+
+func (x *TaxBracketBreakdown) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *TaxBracketBreakdown) GetTaxableAmount() string {
+	if x != nil {
+		return x.TaxableAmount
+	}
+	return ""
+}
+
+func (x *TaxBracketBreakdown) GetTaxAmount() string {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return ""
+}
+
 type GetSalaryStatsByCountryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Country string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	// reporting_currency converts every salary to this ISO 4217 currency. It
+	// is required when the employees are paid in more than one currency.
+	ReportingCurrency string `protobuf:"bytes,2,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	// as_of selects the exchange rates of that date; defaults to today.
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// reports_to only counts the employees below this manager, directly or
+	// indirectly.
+	ReportsTo     string `protobuf:"bytes,4,opt,name=reports_to,json=reportsTo,proto3" json:"reports_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSalaryStatsByCountryRequest) Reset() {
 	*x = GetSalaryStatsByCountryRequest{}
-	mi := &file_proto_salary_v1_salary_proto_msgTypes[2]
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -154,7 +485,7 @@ func (x *GetSalaryStatsByCountryRequest) String() string {
 func (*GetSalaryStatsByCountryRequest) ProtoMessage() {}
 
 func (x *GetSalaryStatsByCountryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_salary_v1_salary_proto_msgTypes[2]
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +498,7 @@ func (x *GetSalaryStatsByCountryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSalaryStatsByCountryRequest.ProtoReflect.Descriptor instead.
 func (*GetSalaryStatsByCountryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{2}
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetSalaryStatsByCountryRequest) GetCountry() string {
@@ -177,19 +508,42 @@ func (x *GetSalaryStatsByCountryRequest) GetCountry() string {
 	return ""
 }
 
+func (x *GetSalaryStatsByCountryRequest) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetSalaryStatsByCountryRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *GetSalaryStatsByCountryRequest) GetReportsTo() string {
+	if x != nil {
+		return x.ReportsTo
+	}
+	return ""
+}
+
+// SalaryStatsResponse amounts are in currency.
 type SalaryStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MinSalary     string                 `protobuf:"bytes,1,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
 	MaxSalary     string                 `protobuf:"bytes,2,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
 	AvgSalary     string                 `protobuf:"bytes,3,opt,name=avg_salary,json=avgSalary,proto3" json:"avg_salary,omitempty"`
 	Count         int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SalaryStatsResponse) Reset() {
 	*x = SalaryStatsResponse{}
-	mi := &file_proto_salary_v1_salary_proto_msgTypes[3]
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -201,7 +555,7 @@ func (x *SalaryStatsResponse) String() string {
 func (*SalaryStatsResponse) ProtoMessage() {}
 
 func (x *SalaryStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_salary_v1_salary_proto_msgTypes[3]
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,7 +568,7 @@ func (x *SalaryStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SalaryStatsResponse.ProtoReflect.Descriptor instead.
 func (*SalaryStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{3}
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SalaryStatsResponse) GetMinSalary() string {
@@ -245,16 +599,28 @@ func (x *SalaryStatsResponse) GetCount() int64 {
 	return 0
 }
 
+func (x *SalaryStatsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type GetAvgSalaryByJobTitleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobTitle      string                 `protobuf:"bytes,1,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	JobTitle string                 `protobuf:"bytes,1,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	// reporting_currency, as_of and reports_to work as in
+	// GetSalaryStatsByCountryRequest.
+	ReportingCurrency string                 `protobuf:"bytes,2,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	AsOf              *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	ReportsTo         string                 `protobuf:"bytes,4,opt,name=reports_to,json=reportsTo,proto3" json:"reports_to,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetAvgSalaryByJobTitleRequest) Reset() {
 	*x = GetAvgSalaryByJobTitleRequest{}
-	mi := &file_proto_salary_v1_salary_proto_msgTypes[4]
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -266,7 +632,7 @@ func (x *GetAvgSalaryByJobTitleRequest) String() string {
 func (*GetAvgSalaryByJobTitleRequest) ProtoMessage() {}
 
 func (x *GetAvgSalaryByJobTitleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_salary_v1_salary_proto_msgTypes[4]
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,7 +645,7 @@ func (x *GetAvgSalaryByJobTitleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvgSalaryByJobTitleRequest.ProtoReflect.Descriptor instead.
 func (*GetAvgSalaryByJobTitleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{4}
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetAvgSalaryByJobTitleRequest) GetJobTitle() string {
@@ -289,18 +655,41 @@ func (x *GetAvgSalaryByJobTitleRequest) GetJobTitle() string {
 	return ""
 }
 
+func (x *GetAvgSalaryByJobTitleRequest) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetAvgSalaryByJobTitleRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *GetAvgSalaryByJobTitleRequest) GetReportsTo() string {
+	if x != nil {
+		return x.ReportsTo
+	}
+	return ""
+}
+
 type JobTitleSalaryStatsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobTitle      string                 `protobuf:"bytes,1,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
-	AvgSalary     string                 `protobuf:"bytes,2,opt,name=avg_salary,json=avgSalary,proto3" json:"avg_salary,omitempty"`
-	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JobTitle  string                 `protobuf:"bytes,1,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	AvgSalary string                 `protobuf:"bytes,2,opt,name=avg_salary,json=avgSalary,proto3" json:"avg_salary,omitempty"`
+	Count     int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// currency is the currency of avg_salary.
+	Currency      string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JobTitleSalaryStatsResponse) Reset() {
 	*x = JobTitleSalaryStatsResponse{}
-	mi := &file_proto_salary_v1_salary_proto_msgTypes[5]
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +701,7 @@ func (x *JobTitleSalaryStatsResponse) String() string {
 func (*JobTitleSalaryStatsResponse) ProtoMessage() {}
 
 func (x *JobTitleSalaryStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_salary_v1_salary_proto_msgTypes[5]
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +714,7 @@ func (x *JobTitleSalaryStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobTitleSalaryStatsResponse.ProtoReflect.Descriptor instead.
 func (*JobTitleSalaryStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{5}
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *JobTitleSalaryStatsResponse) GetJobTitle() string {
@@ -349,42 +738,1079 @@ func (x *JobTitleSalaryStatsResponse) GetCount() int64 {
 	return 0
 }
 
-var File_proto_salary_v1_salary_proto protoreflect.FileDescriptor
+func (x *JobTitleSalaryStatsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
 
-const file_proto_salary_v1_salary_proto_rawDesc = "" +
-	"\n" +
-	"\x1cproto/salary/v1/salary.proto\x12\tsalary.v1\"<\n" +
-	"\x19CalculateNetSalaryRequest\x12\x1f\n" +
-	"\vemployee_id\x18\x01 \x01(\tR\n" +
-	"employeeId\"\x98\x01\n" +
-	"\x1aCalculateNetSalaryResponse\x12!\n" +
-	"\fgross_salary\x18\x01 \x01(\tR\vgrossSalary\x12\x19\n" +
-	"\btax_rate\x18\x02 \x01(\tR\ataxRate\x12\x1d\n" +
-	"\n" +
-	"tax_amount\x18\x03 \x01(\tR\ttaxAmount\x12\x1d\n" +
-	"\n" +
-	"net_salary\x18\x04 \x01(\tR\tnetSalary\":\n" +
-	"\x1eGetSalaryStatsByCountryRequest\x12\x18\n" +
-	"\acountry\x18\x01 \x01(\tR\acountry\"\x88\x01\n" +
-	"\x13SalaryStatsResponse\x12\x1d\n" +
-	"\n" +
-	"min_salary\x18\x01 \x01(\tR\tminSalary\x12\x1d\n" +
-	"\n" +
-	"max_salary\x18\x02 \x01(\tR\tmaxSalary\x12\x1d\n" +
-	"\n" +
-	"avg_salary\x18\x03 \x01(\tR\tavgSalary\x12\x14\n" +
-	"\x05count\x18\x04 \x01(\x03R\x05count\"<\n" +
-	"\x1dGetAvgSalaryByJobTitleRequest\x12\x1b\n" +
-	"\tjob_title\x18\x01 \x01(\tR\bjobTitle\"o\n" +
-	"\x1bJobTitleSalaryStatsResponse\x12\x1b\n" +
-	"\tjob_title\x18\x01 \x01(\tR\bjobTitle\x12\x1d\n" +
-	"\n" +
-	"avg_salary\x18\x02 \x01(\tR\tavgSalary\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x03R\x05count2\xc4\x02\n" +
+// GetSalaryDistributionRequest filters employees like ListEmployeesRequest;
+// min_salary and max_salary compare salaries in the currency they are paid in.
+type GetSalaryDistributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	JobTitle      string                 `protobuf:"bytes,2,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	MinSalary     string                 `protobuf:"bytes,3,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary     string                 `protobuf:"bytes,4,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	UpdatedAfter  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	// reporting_currency and as_of work as in GetSalaryStatsByCountryRequest.
+	ReportingCurrency string                 `protobuf:"bytes,9,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	AsOf              *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// histogram_buckets is the number of equal-width histogram buckets between
+	// the lowest and highest salary: 1 to 100, 10 by default.
+	HistogramBuckets int32  `protobuf:"varint,11,opt,name=histogram_buckets,json=histogramBuckets,proto3" json:"histogram_buckets,omitempty"`
+	ReportsTo        string `protobuf:"bytes,12,opt,name=reports_to,json=reportsTo,proto3" json:"reports_to,omitempty"`
+	// department_id keeps the employees of a department and its
+	// sub-departments.
+	DepartmentId string `protobuf:"bytes,13,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	CostCenterId string `protobuf:"bytes,14,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	// statuses defaults to active employees only.
+	Statuses      []EmploymentStatus `protobuf:"varint,15,rep,packed,name=statuses,proto3,enum=salary.v1.EmploymentStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSalaryDistributionRequest) Reset() {
+	*x = GetSalaryDistributionRequest{}
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalaryDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalaryDistributionRequest) ProtoMessage() {}
+
+func (x *GetSalaryDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalaryDistributionRequest.ProtoReflect.Descriptor instead.
+func (*GetSalaryDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSalaryDistributionRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *GetSalaryDistributionRequest) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *GetSalaryDistributionRequest) GetMinSalary() string {
+	if x != nil {
+		return x.MinSalary
+	}
+	return ""
+}
+
+func (x *GetSalaryDistributionRequest) GetMaxSalary() string {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return ""
+}
+
+func (x *GetSalaryDistributionRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *GetSalaryDistributionRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *GetSalaryDistributionRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *GetSalaryDistributionRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *GetSalaryDistributionRequest) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetSalaryDistributionRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *GetSalaryDistributionRequest) GetHistogramBuckets() int32 {
+	if x != nil {
+		return x.HistogramBuckets
+	}
+	return 0
+}
+
+func (x *GetSalaryDistributionRequest) GetReportsTo() string {
+	if x != nil {
+		return x.ReportsTo
+	}
+	return ""
+}
+
+func (x *GetSalaryDistributionRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *GetSalaryDistributionRequest) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *GetSalaryDistributionRequest) GetStatuses() []EmploymentStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// SalaryDistributionResponse amounts are in currency. Percentiles are
+// interpolated between neighbouring salaries.
+type SalaryDistributionResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Currency     string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Count        int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	MinSalary    string                 `protobuf:"bytes,3,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary    string                 `protobuf:"bytes,4,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	AvgSalary    string                 `protobuf:"bytes,5,opt,name=avg_salary,json=avgSalary,proto3" json:"avg_salary,omitempty"`
+	MedianSalary string                 `protobuf:"bytes,6,opt,name=median_salary,json=medianSalary,proto3" json:"median_salary,omitempty"`
+	P10Salary    string                 `protobuf:"bytes,7,opt,name=p10_salary,json=p10Salary,proto3" json:"p10_salary,omitempty"`
+	P25Salary    string                 `protobuf:"bytes,8,opt,name=p25_salary,json=p25Salary,proto3" json:"p25_salary,omitempty"`
+	P75Salary    string                 `protobuf:"bytes,9,opt,name=p75_salary,json=p75Salary,proto3" json:"p75_salary,omitempty"`
+	P90Salary    string                 `protobuf:"bytes,10,opt,name=p90_salary,json=p90Salary,proto3" json:"p90_salary,omitempty"`
+	// stddev is the population standard deviation.
+	Stddev        string          `protobuf:"bytes,11,opt,name=stddev,proto3" json:"stddev,omitempty"`
+	Histogram     []*SalaryBucket `protobuf:"bytes,12,rep,name=histogram,proto3" json:"histogram,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalaryDistributionResponse) Reset() {
+	*x = SalaryDistributionResponse{}
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalaryDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalaryDistributionResponse) ProtoMessage() {}
+
+func (x *SalaryDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalaryDistributionResponse.ProtoReflect.Descriptor instead.
+func (*SalaryDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SalaryDistributionResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SalaryDistributionResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SalaryDistributionResponse) GetMinSalary() string {
+	if x != nil {
+		return x.MinSalary
+	}
+	return ""
+}
+
+func (x *SalaryDistributionResponse) GetMaxSalary() string {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return ""
+}
+
+func (x *SalaryDistributionResponse) GetAvgSalary() string {
+	if x != nil {
+		return x.AvgSalary
+	}
+	return ""
+}
+
+func (x *SalaryDistributionResponse) GetMedianSalary() string {
+	if x != nil {
+		return x.MedianSalary
+	}
+	return ""
+}
+
+func (x *SalaryDistributionResponse) GetP10Salary() string {
+	if x != nil {
+		return x.P10Salary
+	}
+	return ""
+}
+
+func (x *SalaryDistributionResponse) GetP25Salary() string {
+	if x != nil {
+		return x.P25Salary
+	}
+	return ""
+}
+
+func (x *SalaryDistributionResponse) GetP75Salary() string {
+	if x != nil {
+		return x.P75Salary
+	}
+	return ""
+}
+
+func (x *SalaryDistributionResponse) GetP90Salary() string {
+	if x != nil {
+		return x.P90Salary
+	}
+	return ""
+}
+
+func (x *SalaryDistributionResponse) GetStddev() string {
+	if x != nil {
+		return x.Stddev
+	}
+	return ""
+}
+
+func (x *SalaryDistributionResponse) GetHistogram() []*SalaryBucket {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+// SalaryBucket counts the salaries from lower_bound (inclusive) to
+// upper_bound (exclusive, inclusive for the last bucket).
+type SalaryBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LowerBound    string                 `protobuf:"bytes,1,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound    string                 `protobuf:"bytes,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalaryBucket) Reset() {
+	*x = SalaryBucket{}
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalaryBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalaryBucket) ProtoMessage() {}
+
+func (x *SalaryBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalaryBucket.ProtoReflect.Descriptor instead.
+func (*SalaryBucket) Descriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SalaryBucket) GetLowerBound() string {
+	if x != nil {
+		return x.LowerBound
+	}
+	return ""
+}
+
+func (x *SalaryBucket) GetUpperBound() string {
+	if x != nil {
+		return x.UpperBound
+	}
+	return ""
+}
+
+func (x *SalaryBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// AggregateSalariesRequest filters employees like GetSalaryDistributionRequest.
+type AggregateSalariesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// group_by lists at least one dimension, each at most once.
+	GroupBy []SalaryDimension `protobuf:"varint,1,rep,packed,name=group_by,json=groupBy,proto3,enum=salary.v1.SalaryDimension" json:"group_by,omitempty"`
+	// metrics defaults to count, min, max and avg.
+	Metrics       []SalaryMetric         `protobuf:"varint,2,rep,packed,name=metrics,proto3,enum=salary.v1.SalaryMetric" json:"metrics,omitempty"`
+	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	JobTitle      string                 `protobuf:"bytes,4,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	MinSalary     string                 `protobuf:"bytes,5,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary     string                 `protobuf:"bytes,6,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	UpdatedAfter  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	// reporting_currency and as_of work as in GetSalaryStatsByCountryRequest,
+	// except that salaries grouped by currency need no reporting_currency.
+	ReportingCurrency string                 `protobuf:"bytes,11,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	AsOf              *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	ReportsTo         string                 `protobuf:"bytes,13,opt,name=reports_to,json=reportsTo,proto3" json:"reports_to,omitempty"`
+	DepartmentId      string                 `protobuf:"bytes,14,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	CostCenterId      string                 `protobuf:"bytes,15,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	Statuses          []EmploymentStatus     `protobuf:"varint,16,rep,packed,name=statuses,proto3,enum=salary.v1.EmploymentStatus" json:"statuses,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AggregateSalariesRequest) Reset() {
+	*x = AggregateSalariesRequest{}
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateSalariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateSalariesRequest) ProtoMessage() {}
+
+func (x *AggregateSalariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateSalariesRequest.ProtoReflect.Descriptor instead.
+func (*AggregateSalariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AggregateSalariesRequest) GetGroupBy() []SalaryDimension {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateSalariesRequest) GetMetrics() []SalaryMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *AggregateSalariesRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *AggregateSalariesRequest) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *AggregateSalariesRequest) GetMinSalary() string {
+	if x != nil {
+		return x.MinSalary
+	}
+	return ""
+}
+
+func (x *AggregateSalariesRequest) GetMaxSalary() string {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return ""
+}
+
+func (x *AggregateSalariesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *AggregateSalariesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *AggregateSalariesRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *AggregateSalariesRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *AggregateSalariesRequest) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *AggregateSalariesRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *AggregateSalariesRequest) GetReportsTo() string {
+	if x != nil {
+		return x.ReportsTo
+	}
+	return ""
+}
+
+func (x *AggregateSalariesRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *AggregateSalariesRequest) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *AggregateSalariesRequest) GetStatuses() []EmploymentStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// AggregateSalariesResponse is a table with a column per group_by dimension
+// and per metric, at most 1000 groups ordered by their dimension values.
+type AggregateSalariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupBy       []SalaryDimension      `protobuf:"varint,1,rep,packed,name=group_by,json=groupBy,proto3,enum=salary.v1.SalaryDimension" json:"group_by,omitempty"`
+	Metrics       []SalaryMetric         `protobuf:"varint,2,rep,packed,name=metrics,proto3,enum=salary.v1.SalaryMetric" json:"metrics,omitempty"`
+	Groups        []*SalaryGroup         `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateSalariesResponse) Reset() {
+	*x = AggregateSalariesResponse{}
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateSalariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateSalariesResponse) ProtoMessage() {}
+
+func (x *AggregateSalariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateSalariesResponse.ProtoReflect.Descriptor instead.
+func (*AggregateSalariesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AggregateSalariesResponse) GetGroupBy() []SalaryDimension {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateSalariesResponse) GetMetrics() []SalaryMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *AggregateSalariesResponse) GetGroups() []*SalaryGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// SalaryGroup holds the values of the dimensions and metrics of a group, in
+// the order of group_by and metrics. Amounts are in currency.
+type SalaryGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalaryGroup) Reset() {
+	*x = SalaryGroup{}
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalaryGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalaryGroup) ProtoMessage() {}
+
+func (x *SalaryGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalaryGroup.ProtoReflect.Descriptor instead.
+func (*SalaryGroup) Descriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SalaryGroup) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *SalaryGroup) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *SalaryGroup) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// GetDepartmentPayrollRequest selects a department and its sub-departments,
+// or every department when department_id is empty. reporting_currency and
+// as_of work as in GetSalaryStatsByCountryRequest.
+type GetDepartmentPayrollRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DepartmentId      string                 `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	ReportingCurrency string                 `protobuf:"bytes,2,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	AsOf              *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// statuses defaults to active employees only.
+	Statuses      []EmploymentStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=salary.v1.EmploymentStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentPayrollRequest) Reset() {
+	*x = GetDepartmentPayrollRequest{}
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentPayrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentPayrollRequest) ProtoMessage() {}
+
+func (x *GetDepartmentPayrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentPayrollRequest.ProtoReflect.Descriptor instead.
+func (*GetDepartmentPayrollRequest) Descriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetDepartmentPayrollRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *GetDepartmentPayrollRequest) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetDepartmentPayrollRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *GetDepartmentPayrollRequest) GetStatuses() []EmploymentStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// DepartmentPayrollResponse lists departments in tree order, each followed
+// by its sub-departments. Without a department_id, the employees without a
+// department come last, in a row with an empty department_id.
+type DepartmentPayrollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Departments   []*DepartmentPayroll   `protobuf:"bytes,2,rep,name=departments,proto3" json:"departments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartmentPayrollResponse) Reset() {
+	*x = DepartmentPayrollResponse{}
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentPayrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentPayrollResponse) ProtoMessage() {}
+
+func (x *DepartmentPayrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentPayrollResponse.ProtoReflect.Descriptor instead.
+func (*DepartmentPayrollResponse) Descriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DepartmentPayrollResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DepartmentPayrollResponse) GetDepartments() []*DepartmentPayroll {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+// DepartmentPayroll is the yearly gross payroll of a department. headcount
+// and cost count its own employees; total_headcount and total_cost add
+// those of its sub-departments. depth counts the levels below the requested
+// department, or below the top level when none was requested.
+type DepartmentPayroll struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DepartmentId   string                 `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId       string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Depth          int32                  `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	Headcount      int64                  `protobuf:"varint,5,opt,name=headcount,proto3" json:"headcount,omitempty"`
+	Cost           string                 `protobuf:"bytes,6,opt,name=cost,proto3" json:"cost,omitempty"`
+	TotalHeadcount int64                  `protobuf:"varint,7,opt,name=total_headcount,json=totalHeadcount,proto3" json:"total_headcount,omitempty"`
+	TotalCost      string                 `protobuf:"bytes,8,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DepartmentPayroll) Reset() {
+	*x = DepartmentPayroll{}
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentPayroll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentPayroll) ProtoMessage() {}
+
+func (x *DepartmentPayroll) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_salary_v1_salary_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentPayroll.ProtoReflect.Descriptor instead.
+func (*DepartmentPayroll) Descriptor() ([]byte, []int) {
+	return file_proto_salary_v1_salary_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DepartmentPayroll) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *DepartmentPayroll) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DepartmentPayroll) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *DepartmentPayroll) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *DepartmentPayroll) GetHeadcount() int64 {
+	if x != nil {
+		return x.Headcount
+	}
+	return 0
+}
+
+func (x *DepartmentPayroll) GetCost() string {
+	if x != nil {
+		return x.Cost
+	}
+	return ""
+}
+
+func (x *DepartmentPayroll) GetTotalHeadcount() int64 {
+	if x != nil {
+		return x.TotalHeadcount
+	}
+	return 0
+}
+
+func (x *DepartmentPayroll) GetTotalCost() string {
+	if x != nil {
+		return x.TotalCost
+	}
+	return ""
+}
+
+var File_proto_salary_v1_salary_proto protoreflect.FileDescriptor
+
+const file_proto_salary_v1_salary_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/salary/v1/salary.proto\x12\tsalary.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"m\n" +
+	"\x19CalculateNetSalaryRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\xed\x02\n" +
+	"\x1aCalculateNetSalaryResponse\x12!\n" +
+	"\fgross_salary\x18\x01 \x01(\tR\vgrossSalary\x12\x19\n" +
+	"\btax_rate\x18\x02 \x01(\tR\ataxRate\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\x03 \x01(\tR\ttaxAmount\x12\x1d\n" +
+	"\n" +
+	"net_salary\x18\x04 \x01(\tR\tnetSalary\x12-\n" +
+	"\x12standard_deduction\x18\x05 \x01(\tR\x11standardDeduction\x12%\n" +
+	"\x0etaxable_income\x18\x06 \x01(\tR\rtaxableIncome\x12:\n" +
+	"\bbrackets\x18\a \x03(\v2\x1e.salary.v1.TaxBracketBreakdownR\bbrackets\x12%\n" +
+	"\x0ecap_adjustment\x18\b \x01(\tR\rcapAdjustment\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\"\xb1\x01\n" +
+	"\x13TaxBracketBreakdown\x12\x1f\n" +
+	"\vlower_bound\x18\x01 \x01(\tR\n" +
+	"lowerBound\x12\x1f\n" +
+	"\vupper_bound\x18\x02 \x01(\tR\n" +
+	"upperBound\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12%\n" +
+	"\x0etaxable_amount\x18\x04 \x01(\tR\rtaxableAmount\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\x05 \x01(\tR\ttaxAmount\"\xb9\x01\n" +
+	"\x1eGetSalaryStatsByCountryRequest\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12-\n" +
+	"\x12reporting_currency\x18\x02 \x01(\tR\x11reportingCurrency\x12/\n" +
+	"\x05as_of\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x1d\n" +
+	"\n" +
+	"reports_to\x18\x04 \x01(\tR\treportsTo\"\xa4\x01\n" +
+	"\x13SalaryStatsResponse\x12\x1d\n" +
+	"\n" +
+	"min_salary\x18\x01 \x01(\tR\tminSalary\x12\x1d\n" +
+	"\n" +
+	"max_salary\x18\x02 \x01(\tR\tmaxSalary\x12\x1d\n" +
+	"\n" +
+	"avg_salary\x18\x03 \x01(\tR\tavgSalary\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\xbb\x01\n" +
+	"\x1dGetAvgSalaryByJobTitleRequest\x12\x1b\n" +
+	"\tjob_title\x18\x01 \x01(\tR\bjobTitle\x12-\n" +
+	"\x12reporting_currency\x18\x02 \x01(\tR\x11reportingCurrency\x12/\n" +
+	"\x05as_of\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x1d\n" +
+	"\n" +
+	"reports_to\x18\x04 \x01(\tR\treportsTo\"\x8b\x01\n" +
+	"\x1bJobTitleSalaryStatsResponse\x12\x1b\n" +
+	"\tjob_title\x18\x01 \x01(\tR\bjobTitle\x12\x1d\n" +
+	"\n" +
+	"avg_salary\x18\x02 \x01(\tR\tavgSalary\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\xcb\x05\n" +
+	"\x1cGetSalaryDistributionRequest\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x1b\n" +
+	"\tjob_title\x18\x02 \x01(\tR\bjobTitle\x12\x1d\n" +
+	"\n" +
+	"min_salary\x18\x03 \x01(\tR\tminSalary\x12\x1d\n" +
+	"\n" +
+	"max_salary\x18\x04 \x01(\tR\tmaxSalary\x12?\n" +
+	"\rcreated_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12?\n" +
+	"\rupdated_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedAfter\x12A\n" +
+	"\x0eupdated_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rupdatedBefore\x12-\n" +
+	"\x12reporting_currency\x18\t \x01(\tR\x11reportingCurrency\x12/\n" +
+	"\x05as_of\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12+\n" +
+	"\x11histogram_buckets\x18\v \x01(\x05R\x10histogramBuckets\x12\x1d\n" +
+	"\n" +
+	"reports_to\x18\f \x01(\tR\treportsTo\x12#\n" +
+	"\rdepartment_id\x18\r \x01(\tR\fdepartmentId\x12$\n" +
+	"\x0ecost_center_id\x18\x0e \x01(\tR\fcostCenterId\x127\n" +
+	"\bstatuses\x18\x0f \x03(\x0e2\x1b.salary.v1.EmploymentStatusR\bstatuses\"\x9b\x03\n" +
+	"\x1aSalaryDistributionResponse\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x1d\n" +
+	"\n" +
+	"min_salary\x18\x03 \x01(\tR\tminSalary\x12\x1d\n" +
+	"\n" +
+	"max_salary\x18\x04 \x01(\tR\tmaxSalary\x12\x1d\n" +
+	"\n" +
+	"avg_salary\x18\x05 \x01(\tR\tavgSalary\x12#\n" +
+	"\rmedian_salary\x18\x06 \x01(\tR\fmedianSalary\x12\x1d\n" +
+	"\n" +
+	"p10_salary\x18\a \x01(\tR\tp10Salary\x12\x1d\n" +
+	"\n" +
+	"p25_salary\x18\b \x01(\tR\tp25Salary\x12\x1d\n" +
+	"\n" +
+	"p75_salary\x18\t \x01(\tR\tp75Salary\x12\x1d\n" +
+	"\n" +
+	"p90_salary\x18\n" +
+	" \x01(\tR\tp90Salary\x12\x16\n" +
+	"\x06stddev\x18\v \x01(\tR\x06stddev\x125\n" +
+	"\thistogram\x18\f \x03(\v2\x17.salary.v1.SalaryBucketR\thistogram\"f\n" +
+	"\fSalaryBucket\x12\x1f\n" +
+	"\vlower_bound\x18\x01 \x01(\tR\n" +
+	"lowerBound\x12\x1f\n" +
+	"\vupper_bound\x18\x02 \x01(\tR\n" +
+	"upperBound\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\x84\x06\n" +
+	"\x18AggregateSalariesRequest\x125\n" +
+	"\bgroup_by\x18\x01 \x03(\x0e2\x1a.salary.v1.SalaryDimensionR\agroupBy\x121\n" +
+	"\ametrics\x18\x02 \x03(\x0e2\x17.salary.v1.SalaryMetricR\ametrics\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x1b\n" +
+	"\tjob_title\x18\x04 \x01(\tR\bjobTitle\x12\x1d\n" +
+	"\n" +
+	"min_salary\x18\x05 \x01(\tR\tminSalary\x12\x1d\n" +
+	"\n" +
+	"max_salary\x18\x06 \x01(\tR\tmaxSalary\x12?\n" +
+	"\rcreated_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12?\n" +
+	"\rupdated_after\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedAfter\x12A\n" +
+	"\x0eupdated_before\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rupdatedBefore\x12-\n" +
+	"\x12reporting_currency\x18\v \x01(\tR\x11reportingCurrency\x12/\n" +
+	"\x05as_of\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x1d\n" +
+	"\n" +
+	"reports_to\x18\r \x01(\tR\treportsTo\x12#\n" +
+	"\rdepartment_id\x18\x0e \x01(\tR\fdepartmentId\x12$\n" +
+	"\x0ecost_center_id\x18\x0f \x01(\tR\fcostCenterId\x127\n" +
+	"\bstatuses\x18\x10 \x03(\x0e2\x1b.salary.v1.EmploymentStatusR\bstatuses\"\xb5\x01\n" +
+	"\x19AggregateSalariesResponse\x125\n" +
+	"\bgroup_by\x18\x01 \x03(\x0e2\x1a.salary.v1.SalaryDimensionR\agroupBy\x121\n" +
+	"\ametrics\x18\x02 \x03(\x0e2\x17.salary.v1.SalaryMetricR\ametrics\x12.\n" +
+	"\x06groups\x18\x03 \x03(\v2\x16.salary.v1.SalaryGroupR\x06groups\"U\n" +
+	"\vSalaryGroup\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\xdb\x01\n" +
+	"\x1bGetDepartmentPayrollRequest\x12#\n" +
+	"\rdepartment_id\x18\x01 \x01(\tR\fdepartmentId\x12-\n" +
+	"\x12reporting_currency\x18\x02 \x01(\tR\x11reportingCurrency\x12/\n" +
+	"\x05as_of\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x127\n" +
+	"\bstatuses\x18\x04 \x03(\x0e2\x1b.salary.v1.EmploymentStatusR\bstatuses\"w\n" +
+	"\x19DepartmentPayrollResponse\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12>\n" +
+	"\vdepartments\x18\x02 \x03(\v2\x1c.salary.v1.DepartmentPayrollR\vdepartments\"\xf9\x01\n" +
+	"\x11DepartmentPayroll\x12#\n" +
+	"\rdepartment_id\x18\x01 \x01(\tR\fdepartmentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x14\n" +
+	"\x05depth\x18\x04 \x01(\x05R\x05depth\x12\x1c\n" +
+	"\theadcount\x18\x05 \x01(\x03R\theadcount\x12\x12\n" +
+	"\x04cost\x18\x06 \x01(\tR\x04cost\x12'\n" +
+	"\x0ftotal_headcount\x18\a \x01(\x03R\x0etotalHeadcount\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\b \x01(\tR\ttotalCost*\xb4\x01\n" +
+	"\x10EmploymentStatus\x12!\n" +
+	"\x1dEMPLOYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19EMPLOYMENT_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18EMPLOYMENT_STATUS_ACTIVE\x10\x02\x12\x1e\n" +
+	"\x1aEMPLOYMENT_STATUS_ON_LEAVE\x10\x03\x12 \n" +
+	"\x1cEMPLOYMENT_STATUS_TERMINATED\x10\x04*\xf3\x01\n" +
+	"\x0fSalaryDimension\x12 \n" +
+	"\x1cSALARY_DIMENSION_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SALARY_DIMENSION_COUNTRY\x10\x01\x12\x1e\n" +
+	"\x1aSALARY_DIMENSION_JOB_TITLE\x10\x02\x12\x1e\n" +
+	"\x1aSALARY_DIMENSION_HIRE_YEAR\x10\x03\x12\x1d\n" +
+	"\x19SALARY_DIMENSION_CURRENCY\x10\x04\x12\x1f\n" +
+	"\x1bSALARY_DIMENSION_DEPARTMENT\x10\x05\x12 \n" +
+	"\x1cSALARY_DIMENSION_COST_CENTER\x10\x06*\xd6\x01\n" +
+	"\fSalaryMetric\x12\x1d\n" +
+	"\x19SALARY_METRIC_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SALARY_METRIC_COUNT\x10\x01\x12\x15\n" +
+	"\x11SALARY_METRIC_SUM\x10\x02\x12\x15\n" +
+	"\x11SALARY_METRIC_MIN\x10\x03\x12\x15\n" +
+	"\x11SALARY_METRIC_MAX\x10\x04\x12\x15\n" +
+	"\x11SALARY_METRIC_AVG\x10\x05\x12\x18\n" +
+	"\x14SALARY_METRIC_MEDIAN\x10\x06\x12\x18\n" +
+	"\x14SALARY_METRIC_STDDEV\x10\a2\xf3\x04\n" +
 	"\rSalaryService\x12a\n" +
 	"\x12CalculateNetSalary\x12$.salary.v1.CalculateNetSalaryRequest\x1a%.salary.v1.CalculateNetSalaryResponse\x12d\n" +
 	"\x17GetSalaryStatsByCountry\x12).salary.v1.GetSalaryStatsByCountryRequest\x1a\x1e.salary.v1.SalaryStatsResponse\x12j\n" +
-	"\x16GetAvgSalaryByJobTitle\x12(.salary.v1.GetAvgSalaryByJobTitleRequest\x1a&.salary.v1.JobTitleSalaryStatsResponseB.Z,github.com/employee-proto/salary/v1;salaryv1b\x06proto3"
+	"\x16GetAvgSalaryByJobTitle\x12(.salary.v1.GetAvgSalaryByJobTitleRequest\x1a&.salary.v1.JobTitleSalaryStatsResponse\x12g\n" +
+	"\x15GetSalaryDistribution\x12'.salary.v1.GetSalaryDistributionRequest\x1a%.salary.v1.SalaryDistributionResponse\x12^\n" +
+	"\x11AggregateSalaries\x12#.salary.v1.AggregateSalariesRequest\x1a$.salary.v1.AggregateSalariesResponse\x12d\n" +
+	"\x14GetDepartmentPayroll\x12&.salary.v1.GetDepartmentPayrollRequest\x1a$.salary.v1.DepartmentPayrollResponseB.Z,github.com/employee-proto/salary/v1;salaryv1b\x06proto3"
 
 var (
 	file_proto_salary_v1_salary_proto_rawDescOnce sync.Once
@@ -398,27 +1824,73 @@ func file_proto_salary_v1_salary_proto_rawDescGZIP() []byte {
 	return file_proto_salary_v1_salary_proto_rawDescData
 }
 
-var file_proto_salary_v1_salary_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_salary_v1_salary_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_salary_v1_salary_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_proto_salary_v1_salary_proto_goTypes = []any{
-	(*CalculateNetSalaryRequest)(nil),      // 0: salary.v1.CalculateNetSalaryRequest
-	(*CalculateNetSalaryResponse)(nil),     // 1: salary.v1.CalculateNetSalaryResponse
-	(*GetSalaryStatsByCountryRequest)(nil), // 2: salary.v1.GetSalaryStatsByCountryRequest
-	(*SalaryStatsResponse)(nil),            // 3: salary.v1.SalaryStatsResponse
-	(*GetAvgSalaryByJobTitleRequest)(nil),  // 4: salary.v1.GetAvgSalaryByJobTitleRequest
-	(*JobTitleSalaryStatsResponse)(nil),    // 5: salary.v1.JobTitleSalaryStatsResponse
+	(EmploymentStatus)(0),                  // 0: salary.v1.EmploymentStatus
+	(SalaryDimension)(0),                   // 1: salary.v1.SalaryDimension
+	(SalaryMetric)(0),                      // 2: salary.v1.SalaryMetric
+	(*CalculateNetSalaryRequest)(nil),      // 3: salary.v1.CalculateNetSalaryRequest
+	(*CalculateNetSalaryResponse)(nil),     // 4: salary.v1.CalculateNetSalaryResponse
+	(*TaxBracketBreakdown)(nil),            // 5: salary.v1.TaxBracketBreakdown
+	(*GetSalaryStatsByCountryRequest)(nil), // 6: salary.v1.GetSalaryStatsByCountryRequest
+	(*SalaryStatsResponse)(nil),            // 7: salary.v1.SalaryStatsResponse
+	(*GetAvgSalaryByJobTitleRequest)(nil),  // 8: salary.v1.GetAvgSalaryByJobTitleRequest
+	(*JobTitleSalaryStatsResponse)(nil),    // 9: salary.v1.JobTitleSalaryStatsResponse
+	(*GetSalaryDistributionRequest)(nil),   // 10: salary.v1.GetSalaryDistributionRequest
+	(*SalaryDistributionResponse)(nil),     // 11: salary.v1.SalaryDistributionResponse
+	(*SalaryBucket)(nil),                   // 12: salary.v1.SalaryBucket
+	(*AggregateSalariesRequest)(nil),       // 13: salary.v1.AggregateSalariesRequest
+	(*AggregateSalariesResponse)(nil),      // 14: salary.v1.AggregateSalariesResponse
+	(*SalaryGroup)(nil),                    // 15: salary.v1.SalaryGroup
+	(*GetDepartmentPayrollRequest)(nil),    // 16: salary.v1.GetDepartmentPayrollRequest
+	(*DepartmentPayrollResponse)(nil),      // 17: salary.v1.DepartmentPayrollResponse
+	(*DepartmentPayroll)(nil),              // 18: salary.v1.DepartmentPayroll
+	(*timestamppb.Timestamp)(nil),          // 19: google.protobuf.Timestamp
 }
 var file_proto_salary_v1_salary_proto_depIdxs = []int32{
-	0, // 0: salary.v1.SalaryService.CalculateNetSalary:input_type -> salary.v1.CalculateNetSalaryRequest
-	2, // 1: salary.v1.SalaryService.GetSalaryStatsByCountry:input_type -> salary.v1.GetSalaryStatsByCountryRequest
-	4, // 2: salary.v1.SalaryService.GetAvgSalaryByJobTitle:input_type -> salary.v1.GetAvgSalaryByJobTitleRequest
-	1, // 3: salary.v1.SalaryService.CalculateNetSalary:output_type -> salary.v1.CalculateNetSalaryResponse
-	3, // 4: salary.v1.SalaryService.GetSalaryStatsByCountry:output_type -> salary.v1.SalaryStatsResponse
-	5, // 5: salary.v1.SalaryService.GetAvgSalaryByJobTitle:output_type -> salary.v1.JobTitleSalaryStatsResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	19, // 0: salary.v1.CalculateNetSalaryRequest.as_of:type_name -> google.protobuf.Timestamp
+	5,  // 1: salary.v1.CalculateNetSalaryResponse.brackets:type_name -> salary.v1.TaxBracketBreakdown
+	19, // 2: salary.v1.GetSalaryStatsByCountryRequest.as_of:type_name -> google.protobuf.Timestamp
+	19, // 3: salary.v1.GetAvgSalaryByJobTitleRequest.as_of:type_name -> google.protobuf.Timestamp
+	19, // 4: salary.v1.GetSalaryDistributionRequest.created_after:type_name -> google.protobuf.Timestamp
+	19, // 5: salary.v1.GetSalaryDistributionRequest.created_before:type_name -> google.protobuf.Timestamp
+	19, // 6: salary.v1.GetSalaryDistributionRequest.updated_after:type_name -> google.protobuf.Timestamp
+	19, // 7: salary.v1.GetSalaryDistributionRequest.updated_before:type_name -> google.protobuf.Timestamp
+	19, // 8: salary.v1.GetSalaryDistributionRequest.as_of:type_name -> google.protobuf.Timestamp
+	0,  // 9: salary.v1.GetSalaryDistributionRequest.statuses:type_name -> salary.v1.EmploymentStatus
+	12, // 10: salary.v1.SalaryDistributionResponse.histogram:type_name -> salary.v1.SalaryBucket
+	1,  // 11: salary.v1.AggregateSalariesRequest.group_by:type_name -> salary.v1.SalaryDimension
+	2,  // 12: salary.v1.AggregateSalariesRequest.metrics:type_name -> salary.v1.SalaryMetric
+	19, // 13: salary.v1.AggregateSalariesRequest.created_after:type_name -> google.protobuf.Timestamp
+	19, // 14: salary.v1.AggregateSalariesRequest.created_before:type_name -> google.protobuf.Timestamp
+	19, // 15: salary.v1.AggregateSalariesRequest.updated_after:type_name -> google.protobuf.Timestamp
+	19, // 16: salary.v1.AggregateSalariesRequest.updated_before:type_name -> google.protobuf.Timestamp
+	19, // 17: salary.v1.AggregateSalariesRequest.as_of:type_name -> google.protobuf.Timestamp
+	0,  // 18: salary.v1.AggregateSalariesRequest.statuses:type_name -> salary.v1.EmploymentStatus
+	1,  // 19: salary.v1.AggregateSalariesResponse.group_by:type_name -> salary.v1.SalaryDimension
+	2,  // 20: salary.v1.AggregateSalariesResponse.metrics:type_name -> salary.v1.SalaryMetric
+	15, // 21: salary.v1.AggregateSalariesResponse.groups:type_name -> salary.v1.SalaryGroup
+	19, // 22: salary.v1.GetDepartmentPayrollRequest.as_of:type_name -> google.protobuf.Timestamp
+	0,  // 23: salary.v1.GetDepartmentPayrollRequest.statuses:type_name -> salary.v1.EmploymentStatus
+	18, // 24: salary.v1.DepartmentPayrollResponse.departments:type_name -> salary.v1.DepartmentPayroll
+	3,  // 25: salary.v1.SalaryService.CalculateNetSalary:input_type -> salary.v1.CalculateNetSalaryRequest
+	6,  // 26: salary.v1.SalaryService.GetSalaryStatsByCountry:input_type -> salary.v1.GetSalaryStatsByCountryRequest
+	8,  // 27: salary.v1.SalaryService.GetAvgSalaryByJobTitle:input_type -> salary.v1.GetAvgSalaryByJobTitleRequest
+	10, // 28: salary.v1.SalaryService.GetSalaryDistribution:input_type -> salary.v1.GetSalaryDistributionRequest
+	13, // 29: salary.v1.SalaryService.AggregateSalaries:input_type -> salary.v1.AggregateSalariesRequest
+	16, // 30: salary.v1.SalaryService.GetDepartmentPayroll:input_type -> salary.v1.GetDepartmentPayrollRequest
+	4,  // 31: salary.v1.SalaryService.CalculateNetSalary:output_type -> salary.v1.CalculateNetSalaryResponse
+	7,  // 32: salary.v1.SalaryService.GetSalaryStatsByCountry:output_type -> salary.v1.SalaryStatsResponse
+	9,  // 33: salary.v1.SalaryService.GetAvgSalaryByJobTitle:output_type -> salary.v1.JobTitleSalaryStatsResponse
+	11, // 34: salary.v1.SalaryService.GetSalaryDistribution:output_type -> salary.v1.SalaryDistributionResponse
+	14, // 35: salary.v1.SalaryService.AggregateSalaries:output_type -> salary.v1.AggregateSalariesResponse
+	17, // 36: salary.v1.SalaryService.GetDepartmentPayroll:output_type -> salary.v1.DepartmentPayrollResponse
+	31, // [31:37] is the sub-list for method output_type
+	25, // [25:31] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_proto_salary_v1_salary_proto_init() }
@@ -431,13 +1903,14 @@ func file_proto_salary_v1_salary_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_salary_v1_salary_proto_rawDesc), len(file_proto_salary_v1_salary_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_salary_v1_salary_proto_goTypes,
 		DependencyIndexes: file_proto_salary_v1_salary_proto_depIdxs,
+		EnumInfos:         file_proto_salary_v1_salary_proto_enumTypes,
 		MessageInfos:      file_proto_salary_v1_salary_proto_msgTypes,
 	}.Build()
 	File_proto_salary_v1_salary_proto = out.File
@@ -22,6 +22,9 @@ const (
 	SalaryService_CalculateNetSalary_FullMethodName      = "/salary.v1.SalaryService/CalculateNetSalary"
 	SalaryService_GetSalaryStatsByCountry_FullMethodName = "/salary.v1.SalaryService/GetSalaryStatsByCountry"
 	SalaryService_GetAvgSalaryByJobTitle_FullMethodName  = "/salary.v1.SalaryService/GetAvgSalaryByJobTitle"
+	SalaryService_GetSalaryDistribution_FullMethodName   = "/salary.v1.SalaryService/GetSalaryDistribution"
+	SalaryService_AggregateSalaries_FullMethodName       = "/salary.v1.SalaryService/AggregateSalaries"
+	SalaryService_GetDepartmentPayroll_FullMethodName    = "/salary.v1.SalaryService/GetDepartmentPayroll"
 )
 
 // SalaryServiceClient is the client API for SalaryService service.
@@ -32,10 +35,21 @@ const (
 type SalaryServiceClient interface {
 	// CalculateNetSalary calculates the net salary for an employee
 	CalculateNetSalary(ctx context.Context, in *CalculateNetSalaryRequest, opts ...grpc.CallOption) (*CalculateNetSalaryResponse, error)
-	// GetSalaryStatsByCountry returns salary statistics for a country
+	// GetSalaryStatsByCountry returns salary statistics for the active
+	// employees of a country
 	GetSalaryStatsByCountry(ctx context.Context, in *GetSalaryStatsByCountryRequest, opts ...grpc.CallOption) (*SalaryStatsResponse, error)
-	// GetAvgSalaryByJobTitle returns average salary for a job title
+	// GetAvgSalaryByJobTitle returns the average salary of the active
+	// employees with a job title
 	GetAvgSalaryByJobTitle(ctx context.Context, in *GetAvgSalaryByJobTitleRequest, opts ...grpc.CallOption) (*JobTitleSalaryStatsResponse, error)
+	// GetSalaryDistribution returns percentiles, standard deviation and a
+	// histogram of the salaries of the employees matching a filter
+	GetSalaryDistribution(ctx context.Context, in *GetSalaryDistributionRequest, opts ...grpc.CallOption) (*SalaryDistributionResponse, error)
+	// AggregateSalaries groups the salaries of the employees matching a filter
+	// by one or more dimensions and returns the requested metrics per group
+	AggregateSalaries(ctx context.Context, in *AggregateSalariesRequest, opts ...grpc.CallOption) (*AggregateSalariesResponse, error)
+	// GetDepartmentPayroll returns the payroll cost of each department, with
+	// the costs of its sub-departments rolled up
+	GetDepartmentPayroll(ctx context.Context, in *GetDepartmentPayrollRequest, opts ...grpc.CallOption) (*DepartmentPayrollResponse, error)
 }
 
 type salaryServiceClient struct {
@@ -76,6 +90,36 @@ func (c *salaryServiceClient) GetAvgSalaryByJobTitle(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *salaryServiceClient) GetSalaryDistribution(ctx context.Context, in *GetSalaryDistributionRequest, opts ...grpc.CallOption) (*SalaryDistributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SalaryDistributionResponse)
+	err := c.cc.Invoke(ctx, SalaryService_GetSalaryDistribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *salaryServiceClient) AggregateSalaries(ctx context.Context, in *AggregateSalariesRequest, opts ...grpc.CallOption) (*AggregateSalariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AggregateSalariesResponse)
+	err := c.cc.Invoke(ctx, SalaryService_AggregateSalaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *salaryServiceClient) GetDepartmentPayroll(ctx context.Context, in *GetDepartmentPayrollRequest, opts ...grpc.CallOption) (*DepartmentPayrollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepartmentPayrollResponse)
+	err := c.cc.Invoke(ctx, SalaryService_GetDepartmentPayroll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SalaryServiceServer is the server API for SalaryService service.
 // All implementations must embed UnimplementedSalaryServiceServer
 // for forward compatibility.
@@ -84,10 +128,21 @@ func (c *salaryServiceClient) GetAvgSalaryByJobTitle(ctx context.Context, in *Ge
 type SalaryServiceServer interface {
 	// CalculateNetSalary calculates the net salary for an employee
 	CalculateNetSalary(context.Context, *CalculateNetSalaryRequest) (*CalculateNetSalaryResponse, error)
-	// GetSalaryStatsByCountry returns salary statistics for a country
+	// GetSalaryStatsByCountry returns salary statistics for the active
+	// employees of a country
 	GetSalaryStatsByCountry(context.Context, *GetSalaryStatsByCountryRequest) (*SalaryStatsResponse, error)
-	// GetAvgSalaryByJobTitle returns average salary for a job title
+	// GetAvgSalaryByJobTitle returns the average salary of the active
+	// employees with a job title
 	GetAvgSalaryByJobTitle(context.Context, *GetAvgSalaryByJobTitleRequest) (*JobTitleSalaryStatsResponse, error)
+	// GetSalaryDistribution returns percentiles, standard deviation and a
+	// histogram of the salaries of the employees matching a filter
+	GetSalaryDistribution(context.Context, *GetSalaryDistributionRequest) (*SalaryDistributionResponse, error)
+	// AggregateSalaries groups the salaries of the employees matching a filter
+	// by one or more dimensions and returns the requested metrics per group
+	AggregateSalaries(context.Context, *AggregateSalariesRequest) (*AggregateSalariesResponse, error)
+	// GetDepartmentPayroll returns the payroll cost of each department, with
+	// the costs of its sub-departments rolled up
+	GetDepartmentPayroll(context.Context, *GetDepartmentPayrollRequest) (*DepartmentPayrollResponse, error)
 	mustEmbedUnimplementedSalaryServiceServer()
 }
 
@@ -107,6 +162,15 @@ func (UnimplementedSalaryServiceServer) GetSalaryStatsByCountry(context.Context,
 func (UnimplementedSalaryServiceServer) GetAvgSalaryByJobTitle(context.Context, *GetAvgSalaryByJobTitleRequest) (*JobTitleSalaryStatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAvgSalaryByJobTitle not implemented")
 }
+func (UnimplementedSalaryServiceServer) GetSalaryDistribution(context.Context, *GetSalaryDistributionRequest) (*SalaryDistributionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSalaryDistribution not implemented")
+}
+func (UnimplementedSalaryServiceServer) AggregateSalaries(context.Context, *AggregateSalariesRequest) (*AggregateSalariesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AggregateSalaries not implemented")
+}
+func (UnimplementedSalaryServiceServer) GetDepartmentPayroll(context.Context, *GetDepartmentPayrollRequest) (*DepartmentPayrollResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDepartmentPayroll not implemented")
+}
 func (UnimplementedSalaryServiceServer) mustEmbedUnimplementedSalaryServiceServer() {}
 func (UnimplementedSalaryServiceServer) testEmbeddedByValue()                       {}
 
@@ -182,6 +246,60 @@ func _SalaryService_GetAvgSalaryByJobTitle_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SalaryService_GetSalaryDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalaryDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SalaryServiceServer).GetSalaryDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SalaryService_GetSalaryDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SalaryServiceServer).GetSalaryDistribution(ctx, req.(*GetSalaryDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SalaryService_AggregateSalaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateSalariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SalaryServiceServer).AggregateSalaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SalaryService_AggregateSalaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SalaryServiceServer).AggregateSalaries(ctx, req.(*AggregateSalariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SalaryService_GetDepartmentPayroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepartmentPayrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SalaryServiceServer).GetDepartmentPayroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SalaryService_GetDepartmentPayroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SalaryServiceServer).GetDepartmentPayroll(ctx, req.(*GetDepartmentPayrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SalaryService_ServiceDesc is the grpc.ServiceDesc for SalaryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +319,18 @@ var SalaryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvgSalaryByJobTitle",
 			Handler:    _SalaryService_GetAvgSalaryByJobTitle_Handler,
 		},
+		{
+			MethodName: "GetSalaryDistribution",
+			Handler:    _SalaryService_GetSalaryDistribution_Handler,
+		},
+		{
+			MethodName: "AggregateSalaries",
+			Handler:    _SalaryService_AggregateSalaries_Handler,
+		},
+		{
+			MethodName: "GetDepartmentPayroll",
+			Handler:    _SalaryService_GetDepartmentPayroll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/salary/v1/salary.proto",
@@ -0,0 +1,647 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: proto/taxrule/v1/taxrule.proto
+
+package taxrulev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TaxRuleType int32
+
+const (
+	TaxRuleType_TAX_RULE_TYPE_UNSPECIFIED TaxRuleType = 0
+	TaxRuleType_TAX_RULE_TYPE_FLAT        TaxRuleType = 1
+	TaxRuleType_TAX_RULE_TYPE_PROGRESSIVE TaxRuleType = 2
+)
+
+// Enum value maps for TaxRuleType.
+var (
+	TaxRuleType_name = map[int32]string{
+		0: "TAX_RULE_TYPE_UNSPECIFIED",
+		1: "TAX_RULE_TYPE_FLAT",
+		2: "TAX_RULE_TYPE_PROGRESSIVE",
+	}
+	TaxRuleType_value = map[string]int32{
+		"TAX_RULE_TYPE_UNSPECIFIED": 0,
+		"TAX_RULE_TYPE_FLAT":        1,
+		"TAX_RULE_TYPE_PROGRESSIVE": 2,
+	}
+)
+
+func (x TaxRuleType) Enum() *TaxRuleType {
+	p := new(TaxRuleType)
+	*p = x
+	return p
+}
+
+func (x TaxRuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaxRuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_taxrule_v1_taxrule_proto_enumTypes[0].Descriptor()
+}
+
+func (TaxRuleType) Type() protoreflect.EnumType {
+	return &file_proto_taxrule_v1_taxrule_proto_enumTypes[0]
+}
+
+func (x TaxRuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaxRuleType.Descriptor instead.
+func (TaxRuleType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_taxrule_v1_taxrule_proto_rawDescGZIP(), []int{0}
+}
+
+type TaxBand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// up_to is the upper bound of taxable income for the band; empty for the top band.
+	UpTo          string `protobuf:"bytes,1,opt,name=up_to,json=upTo,proto3" json:"up_to,omitempty"`
+	Rate          string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxBand) Reset() {
+	*x = TaxBand{}
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxBand) ProtoMessage() {}
+
+func (x *TaxBand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxBand.ProtoReflect.Descriptor instead.
+func (*TaxBand) Descriptor() ([]byte, []int) {
+	return file_proto_taxrule_v1_taxrule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TaxBand) GetUpTo() string {
+	if x != nil {
+		return x.UpTo
+	}
+	return ""
+}
+
+func (x *TaxBand) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+type TaxRule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Country  string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	RuleType TaxRuleType            `protobuf:"varint,3,opt,name=rule_type,json=ruleType,proto3,enum=taxrule.v1.TaxRuleType" json:"rule_type,omitempty"`
+	// rate applies to flat rules only.
+	Rate string `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	// bands apply to progressive rules only, lowest first.
+	Bands             []*TaxBand `protobuf:"bytes,5,rep,name=bands,proto3" json:"bands,omitempty"`
+	StandardDeduction string     `protobuf:"bytes,6,opt,name=standard_deduction,json=standardDeduction,proto3" json:"standard_deduction,omitempty"`
+	// max_tax caps the total tax due; empty when uncapped.
+	MaxTax        string                 `protobuf:"bytes,7,opt,name=max_tax,json=maxTax,proto3" json:"max_tax,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxRule) Reset() {
+	*x = TaxRule{}
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRule) ProtoMessage() {}
+
+func (x *TaxRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRule.ProtoReflect.Descriptor instead.
+func (*TaxRule) Descriptor() ([]byte, []int) {
+	return file_proto_taxrule_v1_taxrule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TaxRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaxRule) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *TaxRule) GetRuleType() TaxRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return TaxRuleType_TAX_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *TaxRule) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *TaxRule) GetBands() []*TaxBand {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *TaxRule) GetStandardDeduction() string {
+	if x != nil {
+		return x.StandardDeduction
+	}
+	return ""
+}
+
+func (x *TaxRule) GetMaxTax() string {
+	if x != nil {
+		return x.MaxTax
+	}
+	return ""
+}
+
+func (x *TaxRule) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *TaxRule) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+func (x *TaxRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateTaxRuleRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Country           string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	RuleType          TaxRuleType            `protobuf:"varint,2,opt,name=rule_type,json=ruleType,proto3,enum=taxrule.v1.TaxRuleType" json:"rule_type,omitempty"`
+	Rate              string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Bands             []*TaxBand             `protobuf:"bytes,4,rep,name=bands,proto3" json:"bands,omitempty"`
+	StandardDeduction string                 `protobuf:"bytes,5,opt,name=standard_deduction,json=standardDeduction,proto3" json:"standard_deduction,omitempty"`
+	MaxTax            string                 `protobuf:"bytes,6,opt,name=max_tax,json=maxTax,proto3" json:"max_tax,omitempty"`
+	EffectiveFrom     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateTaxRuleRequest) Reset() {
+	*x = CreateTaxRuleRequest{}
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaxRuleRequest) ProtoMessage() {}
+
+func (x *CreateTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_taxrule_v1_taxrule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTaxRuleRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CreateTaxRuleRequest) GetRuleType() TaxRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return TaxRuleType_TAX_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateTaxRuleRequest) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *CreateTaxRuleRequest) GetBands() []*TaxBand {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *CreateTaxRuleRequest) GetStandardDeduction() string {
+	if x != nil {
+		return x.StandardDeduction
+	}
+	return ""
+}
+
+func (x *CreateTaxRuleRequest) GetMaxTax() string {
+	if x != nil {
+		return x.MaxTax
+	}
+	return ""
+}
+
+func (x *CreateTaxRuleRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+type GetTaxRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxRuleRequest) Reset() {
+	*x = GetTaxRuleRequest{}
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxRuleRequest) ProtoMessage() {}
+
+func (x *GetTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_taxrule_v1_taxrule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTaxRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTaxRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRulesRequest) Reset() {
+	*x = ListTaxRulesRequest{}
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRulesRequest) ProtoMessage() {}
+
+func (x *ListTaxRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaxRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_taxrule_v1_taxrule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTaxRulesRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type ListTaxRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRules      []*TaxRule             `protobuf:"bytes,1,rep,name=tax_rules,json=taxRules,proto3" json:"tax_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRulesResponse) Reset() {
+	*x = ListTaxRulesResponse{}
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRulesResponse) ProtoMessage() {}
+
+func (x *ListTaxRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaxRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_taxrule_v1_taxrule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTaxRulesResponse) GetTaxRules() []*TaxRule {
+	if x != nil {
+		return x.TaxRules
+	}
+	return nil
+}
+
+type RetireTaxRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// effective_to defaults to now and cannot be in the past.
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetireTaxRuleRequest) Reset() {
+	*x = RetireTaxRuleRequest{}
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetireTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetireTaxRuleRequest) ProtoMessage() {}
+
+func (x *RetireTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_taxrule_v1_taxrule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetireTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*RetireTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_taxrule_v1_taxrule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RetireTaxRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RetireTaxRuleRequest) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+var File_proto_taxrule_v1_taxrule_proto protoreflect.FileDescriptor
+
+const file_proto_taxrule_v1_taxrule_proto_rawDesc = "" +
+	"\n" +
+	"\x1eproto/taxrule/v1/taxrule.proto\x12\n" +
+	"taxrule.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"2\n" +
+	"\aTaxBand\x12\x13\n" +
+	"\x05up_to\x18\x01 \x01(\tR\x04upTo\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\tR\x04rate\"\xad\x03\n" +
+	"\aTaxRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x124\n" +
+	"\trule_type\x18\x03 \x01(\x0e2\x17.taxrule.v1.TaxRuleTypeR\bruleType\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\tR\x04rate\x12)\n" +
+	"\x05bands\x18\x05 \x03(\v2\x13.taxrule.v1.TaxBandR\x05bands\x12-\n" +
+	"\x12standard_deduction\x18\x06 \x01(\tR\x11standardDeduction\x12\x17\n" +
+	"\amax_tax\x18\a \x01(\tR\x06maxTax\x12A\n" +
+	"\x0eeffective_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12=\n" +
+	"\feffective_to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveTo\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb0\x02\n" +
+	"\x14CreateTaxRuleRequest\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x124\n" +
+	"\trule_type\x18\x02 \x01(\x0e2\x17.taxrule.v1.TaxRuleTypeR\bruleType\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12)\n" +
+	"\x05bands\x18\x04 \x03(\v2\x13.taxrule.v1.TaxBandR\x05bands\x12-\n" +
+	"\x12standard_deduction\x18\x05 \x01(\tR\x11standardDeduction\x12\x17\n" +
+	"\amax_tax\x18\x06 \x01(\tR\x06maxTax\x12A\n" +
+	"\x0eeffective_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"#\n" +
+	"\x11GetTaxRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x13ListTaxRulesRequest\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\"H\n" +
+	"\x14ListTaxRulesResponse\x120\n" +
+	"\ttax_rules\x18\x01 \x03(\v2\x13.taxrule.v1.TaxRuleR\btaxRules\"e\n" +
+	"\x14RetireTaxRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\feffective_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveTo*c\n" +
+	"\vTaxRuleType\x12\x1d\n" +
+	"\x19TAX_RULE_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TAX_RULE_TYPE_FLAT\x10\x01\x12\x1d\n" +
+	"\x19TAX_RULE_TYPE_PROGRESSIVE\x10\x022\xb5\x02\n" +
+	"\x0eTaxRuleService\x12F\n" +
+	"\rCreateTaxRule\x12 .taxrule.v1.CreateTaxRuleRequest\x1a\x13.taxrule.v1.TaxRule\x12@\n" +
+	"\n" +
+	"GetTaxRule\x12\x1d.taxrule.v1.GetTaxRuleRequest\x1a\x13.taxrule.v1.TaxRule\x12Q\n" +
+	"\fListTaxRules\x12\x1f.taxrule.v1.ListTaxRulesRequest\x1a .taxrule.v1.ListTaxRulesResponse\x12F\n" +
+	"\rRetireTaxRule\x12 .taxrule.v1.RetireTaxRuleRequest\x1a\x13.taxrule.v1.TaxRuleB4Z2github.com/employee-api/proto/taxrule/v1;taxrulev1b\x06proto3"
+
+var (
+	file_proto_taxrule_v1_taxrule_proto_rawDescOnce sync.Once
+	file_proto_taxrule_v1_taxrule_proto_rawDescData []byte
+)
+
+func file_proto_taxrule_v1_taxrule_proto_rawDescGZIP() []byte {
+	file_proto_taxrule_v1_taxrule_proto_rawDescOnce.Do(func() {
+		file_proto_taxrule_v1_taxrule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_taxrule_v1_taxrule_proto_rawDesc), len(file_proto_taxrule_v1_taxrule_proto_rawDesc)))
+	})
+	return file_proto_taxrule_v1_taxrule_proto_rawDescData
+}
+
+var file_proto_taxrule_v1_taxrule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_taxrule_v1_taxrule_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_taxrule_v1_taxrule_proto_goTypes = []any{
+	(TaxRuleType)(0),              // 0: taxrule.v1.TaxRuleType
+	(*TaxBand)(nil),               // 1: taxrule.v1.TaxBand
+	(*TaxRule)(nil),               // 2: taxrule.v1.TaxRule
+	(*CreateTaxRuleRequest)(nil),  // 3: taxrule.v1.CreateTaxRuleRequest
+	(*GetTaxRuleRequest)(nil),     // 4: taxrule.v1.GetTaxRuleRequest
+	(*ListTaxRulesRequest)(nil),   // 5: taxrule.v1.ListTaxRulesRequest
+	(*ListTaxRulesResponse)(nil),  // 6: taxrule.v1.ListTaxRulesResponse
+	(*RetireTaxRuleRequest)(nil),  // 7: taxrule.v1.RetireTaxRuleRequest
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+}
+var file_proto_taxrule_v1_taxrule_proto_depIdxs = []int32{
+	0,  // 0: taxrule.v1.TaxRule.rule_type:type_name -> taxrule.v1.TaxRuleType
+	1,  // 1: taxrule.v1.TaxRule.bands:type_name -> taxrule.v1.TaxBand
+	8,  // 2: taxrule.v1.TaxRule.effective_from:type_name -> google.protobuf.Timestamp
+	8,  // 3: taxrule.v1.TaxRule.effective_to:type_name -> google.protobuf.Timestamp
+	8,  // 4: taxrule.v1.TaxRule.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: taxrule.v1.CreateTaxRuleRequest.rule_type:type_name -> taxrule.v1.TaxRuleType
+	1,  // 6: taxrule.v1.CreateTaxRuleRequest.bands:type_name -> taxrule.v1.TaxBand
+	8,  // 7: taxrule.v1.CreateTaxRuleRequest.effective_from:type_name -> google.protobuf.Timestamp
+	2,  // 8: taxrule.v1.ListTaxRulesResponse.tax_rules:type_name -> taxrule.v1.TaxRule
+	8,  // 9: taxrule.v1.RetireTaxRuleRequest.effective_to:type_name -> google.protobuf.Timestamp
+	3,  // 10: taxrule.v1.TaxRuleService.CreateTaxRule:input_type -> taxrule.v1.CreateTaxRuleRequest
+	4,  // 11: taxrule.v1.TaxRuleService.GetTaxRule:input_type -> taxrule.v1.GetTaxRuleRequest
+	5,  // 12: taxrule.v1.TaxRuleService.ListTaxRules:input_type -> taxrule.v1.ListTaxRulesRequest
+	7,  // 13: taxrule.v1.TaxRuleService.RetireTaxRule:input_type -> taxrule.v1.RetireTaxRuleRequest
+	2,  // 14: taxrule.v1.TaxRuleService.CreateTaxRule:output_type -> taxrule.v1.TaxRule
+	2,  // 15: taxrule.v1.TaxRuleService.GetTaxRule:output_type -> taxrule.v1.TaxRule
+	6,  // 16: taxrule.v1.TaxRuleService.ListTaxRules:output_type -> taxrule.v1.ListTaxRulesResponse
+	2,  // 17: taxrule.v1.TaxRuleService.RetireTaxRule:output_type -> taxrule.v1.TaxRule
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_proto_taxrule_v1_taxrule_proto_init() }
+func file_proto_taxrule_v1_taxrule_proto_init() {
+	if File_proto_taxrule_v1_taxrule_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_taxrule_v1_taxrule_proto_rawDesc), len(file_proto_taxrule_v1_taxrule_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_taxrule_v1_taxrule_proto_goTypes,
+		DependencyIndexes: file_proto_taxrule_v1_taxrule_proto_depIdxs,
+		EnumInfos:         file_proto_taxrule_v1_taxrule_proto_enumTypes,
+		MessageInfos:      file_proto_taxrule_v1_taxrule_proto_msgTypes,
+	}.Build()
+	File_proto_taxrule_v1_taxrule_proto = out.File
+	file_proto_taxrule_v1_taxrule_proto_goTypes = nil
+	file_proto_taxrule_v1_taxrule_proto_depIdxs = nil
+}
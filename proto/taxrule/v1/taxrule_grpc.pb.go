@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.4
+// source: proto/taxrule/v1/taxrule.proto
+
+package taxrulev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TaxRuleService_CreateTaxRule_FullMethodName = "/taxrule.v1.TaxRuleService/CreateTaxRule"
+	TaxRuleService_GetTaxRule_FullMethodName    = "/taxrule.v1.TaxRuleService/GetTaxRule"
+	TaxRuleService_ListTaxRules_FullMethodName  = "/taxrule.v1.TaxRuleService/ListTaxRules"
+	TaxRuleService_RetireTaxRule_FullMethodName = "/taxrule.v1.TaxRuleService/RetireTaxRule"
+)
+
+// TaxRuleServiceClient is the client API for TaxRuleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TaxRuleService administers the effective-dated tax rule catalog
+type TaxRuleServiceClient interface {
+	// CreateTaxRule adds a rule, closing the country's current rule when the new one takes effect
+	CreateTaxRule(ctx context.Context, in *CreateTaxRuleRequest, opts ...grpc.CallOption) (*TaxRule, error)
+	// GetTaxRule returns a single rule
+	GetTaxRule(ctx context.Context, in *GetTaxRuleRequest, opts ...grpc.CallOption) (*TaxRule, error)
+	// ListTaxRules returns the rule history, optionally for one country
+	ListTaxRules(ctx context.Context, in *ListTaxRulesRequest, opts ...grpc.CallOption) (*ListTaxRulesResponse, error)
+	// RetireTaxRule ends a rule from a given time onwards
+	RetireTaxRule(ctx context.Context, in *RetireTaxRuleRequest, opts ...grpc.CallOption) (*TaxRule, error)
+}
+
+type taxRuleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaxRuleServiceClient(cc grpc.ClientConnInterface) TaxRuleServiceClient {
+	return &taxRuleServiceClient{cc}
+}
+
+func (c *taxRuleServiceClient) CreateTaxRule(ctx context.Context, in *CreateTaxRuleRequest, opts ...grpc.CallOption) (*TaxRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxRule)
+	err := c.cc.Invoke(ctx, TaxRuleService_CreateTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxRuleServiceClient) GetTaxRule(ctx context.Context, in *GetTaxRuleRequest, opts ...grpc.CallOption) (*TaxRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxRule)
+	err := c.cc.Invoke(ctx, TaxRuleService_GetTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxRuleServiceClient) ListTaxRules(ctx context.Context, in *ListTaxRulesRequest, opts ...grpc.CallOption) (*ListTaxRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaxRulesResponse)
+	err := c.cc.Invoke(ctx, TaxRuleService_ListTaxRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxRuleServiceClient) RetireTaxRule(ctx context.Context, in *RetireTaxRuleRequest, opts ...grpc.CallOption) (*TaxRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxRule)
+	err := c.cc.Invoke(ctx, TaxRuleService_RetireTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaxRuleServiceServer is the server API for TaxRuleService service.
+// All implementations must embed UnimplementedTaxRuleServiceServer
+// for forward compatibility.
+//
+// TaxRuleService administers the effective-dated tax rule catalog
+type TaxRuleServiceServer interface {
+	// CreateTaxRule adds a rule, closing the country's current rule when the new one takes effect
+	CreateTaxRule(context.Context, *CreateTaxRuleRequest) (*TaxRule, error)
+	// GetTaxRule returns a single rule
+	GetTaxRule(context.Context, *GetTaxRuleRequest) (*TaxRule, error)
+	// ListTaxRules returns the rule history, optionally for one country
+	ListTaxRules(context.Context, *ListTaxRulesRequest) (*ListTaxRulesResponse, error)
+	// RetireTaxRule ends a rule from a given time onwards
+	RetireTaxRule(context.Context, *RetireTaxRuleRequest) (*TaxRule, error)
+	mustEmbedUnimplementedTaxRuleServiceServer()
+}
+
+// UnimplementedTaxRuleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaxRuleServiceServer struct{}
+
+func (UnimplementedTaxRuleServiceServer) CreateTaxRule(context.Context, *CreateTaxRuleRequest) (*TaxRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTaxRule not implemented")
+}
+func (UnimplementedTaxRuleServiceServer) GetTaxRule(context.Context, *GetTaxRuleRequest) (*TaxRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTaxRule not implemented")
+}
+func (UnimplementedTaxRuleServiceServer) ListTaxRules(context.Context, *ListTaxRulesRequest) (*ListTaxRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaxRules not implemented")
+}
+func (UnimplementedTaxRuleServiceServer) RetireTaxRule(context.Context, *RetireTaxRuleRequest) (*TaxRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetireTaxRule not implemented")
+}
+func (UnimplementedTaxRuleServiceServer) mustEmbedUnimplementedTaxRuleServiceServer() {}
+func (UnimplementedTaxRuleServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeTaxRuleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaxRuleServiceServer will
+// result in compilation errors.
+type UnsafeTaxRuleServiceServer interface {
+	mustEmbedUnimplementedTaxRuleServiceServer()
+}
+
+func RegisterTaxRuleServiceServer(s grpc.ServiceRegistrar, srv TaxRuleServiceServer) {
+	// If the following call panics, it indicates UnimplementedTaxRuleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaxRuleService_ServiceDesc, srv)
+}
+
+func _TaxRuleService_CreateTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxRuleServiceServer).CreateTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxRuleService_CreateTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxRuleServiceServer).CreateTaxRule(ctx, req.(*CreateTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxRuleService_GetTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxRuleServiceServer).GetTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxRuleService_GetTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxRuleServiceServer).GetTaxRule(ctx, req.(*GetTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxRuleService_ListTaxRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaxRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxRuleServiceServer).ListTaxRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxRuleService_ListTaxRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxRuleServiceServer).ListTaxRules(ctx, req.(*ListTaxRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxRuleService_RetireTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetireTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxRuleServiceServer).RetireTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxRuleService_RetireTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxRuleServiceServer).RetireTaxRule(ctx, req.(*RetireTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaxRuleService_ServiceDesc is the grpc.ServiceDesc for TaxRuleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaxRuleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taxrule.v1.TaxRuleService",
+	HandlerType: (*TaxRuleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTaxRule",
+			Handler:    _TaxRuleService_CreateTaxRule_Handler,
+		},
+		{
+			MethodName: "GetTaxRule",
+			Handler:    _TaxRuleService_GetTaxRule_Handler,
+		},
+		{
+			MethodName: "ListTaxRules",
+			Handler:    _TaxRuleService_ListTaxRules_Handler,
+		},
+		{
+			MethodName: "RetireTaxRule",
+			Handler:    _TaxRuleService_RetireTaxRule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/taxrule/v1/taxrule.proto",
+}